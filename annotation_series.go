@@ -3,6 +3,7 @@ package chart
 import (
 	"fmt"
 	"math"
+	"sort"
 )
 
 // Interface Assertions.
@@ -16,6 +17,28 @@ type AnnotationSeries struct {
 	Style       Style
 	YAxis       YAxisType
 	Annotations []Value2
+
+	// AllowOverflow keeps labels to the right of their points even past the edge of the canvas,
+	// which the chart then shrinks to make room for them, instead of flipping or moving them onto the canvas.
+	AllowOverflow bool
+
+	// AlignRight draws the labels at the right edge of the canvas, level with their points,
+	// like final values next to the y axis. Overflow is allowed for aligned labels.
+	AlignRight bool
+
+	// LabelFormatter, if set, returns the label text for each annotation from its values,
+	// e.g. a percent change for a last value annotation, instead of its Label.
+	LabelFormatter func(x, y float64) string
+
+	// ShowConnectors draws a line from each point to its label when the label isn't next to it,
+	// i.e. it's aligned right or has been moved so it doesn't overlap another label.
+	ShowConnectors bool
+	// ConnectorStyle styles the connectors. It defaults to the stroke of the series;
+	// set a StrokeDashArray for a dashed connector.
+	ConnectorStyle Style
+
+	// lastValueOf is set for last value annotations, so charts with an x window can label the last visible value.
+	lastValueOf *lastValueSource
 }
 
 // GetName returns the name of the time series.
@@ -35,7 +58,7 @@ func (as AnnotationSeries) GetYAxis() YAxisType {
 
 func (as AnnotationSeries) annotationStyleDefaults(defaults Style) Style {
 	return Style{
-		FontColor:   DefaultTextColor,
+		FontColor:   defaults.GetFontColor(DefaultTextColor),
 		Font:        defaults.Font,
 		FillColor:   DefaultAnnotationFillColor,
 		FontSize:    DefaultAnnotationFontSize,
@@ -53,33 +76,62 @@ func (as AnnotationSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Ran
 		Right:  0,
 		Bottom: 0,
 	}
-	if !as.Style.Hidden {
-		seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
-		for _, a := range as.Annotations {
-			style := a.Style.InheritFrom(seriesStyle)
-			lx := canvasBox.Left + xrange.Translate(a.XValue)
-			ly := canvasBox.Bottom - yrange.Translate(a.YValue)
-			ab := Draw.MeasureAnnotation(r, canvasBox, style, lx, ly, a.Label)
-			box.Top = MinInt(box.Top, ab.Top)
-			box.Left = MinInt(box.Left, ab.Left)
-			box.Right = MaxInt(box.Right, ab.Right)
-			box.Bottom = MaxInt(box.Bottom, ab.Bottom)
-		}
+	for _, p := range as.getPlacements(r, canvasBox, xrange, yrange, defaults) {
+		box.Top = MinInt(box.Top, p.Box.Top)
+		box.Left = MinInt(box.Left, p.Box.Left)
+		box.Right = MaxInt(box.Right, p.Box.Right)
+		box.Bottom = MaxInt(box.Bottom, p.Box.Bottom)
 	}
 	return box
 }
 
 // Render draws the series.
 func (as AnnotationSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
-	if !as.Style.Hidden {
-		seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
-		for _, a := range as.Annotations {
-			style := a.Style.InheritFrom(seriesStyle)
-			lx := canvasBox.Left + xrange.Translate(a.XValue)
-			ly := canvasBox.Bottom - yrange.Translate(a.YValue)
-			Draw.Annotation(r, canvasBox, style, lx, ly, a.Label)
+	for _, p := range as.getPlacements(r, canvasBox, xrange, yrange, defaults) {
+		p.Render(r, canvasBox)
+	}
+}
+
+// getPlacements returns the annotations of the series resolved to canvas coordinates.
+// Unless overflow is allowed, labels that would run off the right of the canvas are flipped to the left
+// of their point, and labels that would run off the top or bottom are moved back onto the canvas.
+func (as AnnotationSeries) getPlacements(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) []annotationPlacement {
+	if as.Style.Hidden {
+		return nil
+	}
+
+	var placements []annotationPlacement
+	seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
+	for _, a := range as.Annotations {
+		style := a.Style.InheritFrom(seriesStyle)
+		lx := canvasBox.Left + xrange.Translate(a.XValue)
+		ly := canvasBox.Bottom - yrange.Translate(a.YValue)
+		label := a.Label
+		if as.LabelFormatter != nil {
+			label = as.LabelFormatter(a.XValue, a.YValue)
 		}
+		labelX := lx
+		if as.AlignRight {
+			labelX = canvasBox.Right
+		}
+		p := annotationPlacement{
+			Label:         label,
+			Style:         style,
+			Connector:     as.ConnectorStyle.InheritFrom(style.GetStrokeOptions()),
+			ShowConnector: as.ShowConnectors,
+			Final:         as.lastValueOf != nil,
+			X:             lx,
+			Y:             ly,
+			LabelX:        labelX,
+			LabelY:        ly,
+			Box:           Draw.MeasureAnnotation(r, canvasBox, style, labelX, ly, label),
+		}
+		if !as.AllowOverflow && !as.AlignRight {
+			p.keepWithin(canvasBox)
+		}
+		placements = append(placements, p)
 	}
+	return placements
 }
 
 // Validate validates the series.
@@ -89,3 +141,102 @@ func (as AnnotationSeries) Validate() error {
 	}
 	return nil
 }
+
+// annotationPlacement is an annotation resolved to canvas coordinates.
+// `LabelX` and `LabelY` are where the label points to, which can differ from the data point `X` and `Y`
+// when the label is aligned to the right, or has been moved so it doesn't overlap another or stays on the canvas.
+// `Flipped` labels are drawn to the left of their point.
+type annotationPlacement struct {
+	Label         string
+	Style         Style
+	Connector     Style
+	ShowConnector bool
+	X, Y          int
+	LabelX        int
+	LabelY        int
+	Box           Box
+	Flipped       bool
+	// Final is set if the label is of the last value of a series, i.e. a final label.
+	Final bool
+}
+
+// keepWithin flips the label to the left of its point if it runs off the right of the box,
+// and moves it if it runs off the top or bottom.
+func (ap *annotationPlacement) keepWithin(box Box) {
+	if ap.Box.Right > box.Right && ap.LabelX-ap.Box.Width() >= box.Left {
+		ap.Flipped = true
+		ap.Box = Box{Top: ap.Box.Top, Left: ap.LabelX - ap.Box.Width(), Right: ap.LabelX, Bottom: ap.Box.Bottom}
+	}
+	if ap.Box.Top < box.Top {
+		ap.shift(box.Top - ap.Box.Top)
+	} else if ap.Box.Bottom > box.Bottom && ap.Box.Height() <= box.Height() {
+		ap.shift(box.Bottom - ap.Box.Bottom)
+	}
+}
+
+func (ap *annotationPlacement) shift(dy int) {
+	ap.LabelY += dy
+	ap.Box = ap.Box.Shift(0, dy)
+}
+
+func (ap annotationPlacement) overlapsHorizontally(other annotationPlacement) bool {
+	return ap.Box.Left < other.Box.Right && other.Box.Left < ap.Box.Right
+}
+
+// Render draws the annotation, with a connector back to the data point if it has one.
+func (ap annotationPlacement) Render(r Renderer, canvasBox Box) {
+	if ap.ShowConnector {
+		ap.drawConnector(r, canvasBox)
+	}
+
+	box := Draw.annotationLabelBox(r, ap.Style, ap.LabelX, ap.LabelY, ap.Label)
+	if ap.Flipped {
+		box.Left, box.Right = (ap.LabelX<<1)-box.Right, (ap.LabelX<<1)-box.Left
+	}
+	Draw.Callout(r, ap.Style, ap.LabelX, ap.LabelY, box, ap.Label)
+}
+
+// drawConnector draws a line from the data point to where the label points to.
+// It is clipped to the left of the canvas, and skipped if the label is within a couple of pixels of the point.
+func (ap annotationPlacement) drawConnector(r Renderer, canvasBox Box) {
+	x := MaxInt(ap.X, canvasBox.Left)
+	if AbsInt(ap.LabelX-x) <= DefaultAnnotationConnectorMinLength && AbsInt(ap.LabelY-ap.Y) <= DefaultAnnotationConnectorMinLength {
+		return
+	}
+
+	ap.Connector.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(x, ap.Y)
+	r.LineTo(ap.LabelX, ap.LabelY)
+	r.Stroke()
+	r.ResetStyle()
+}
+
+// resolveAnnotationOverlaps nudges annotation labels vertically so they don't overlap,
+// keeping them within the canvas box.
+func resolveAnnotationOverlaps(placements []annotationPlacement, canvasBox Box) {
+	sort.SliceStable(placements, func(i, j int) bool {
+		return placements[i].Y < placements[j].Y
+	})
+
+	// push overlapping labels down.
+	for i := 1; i < len(placements); i++ {
+		for j := 0; j < i; j++ {
+			if placements[i].overlapsHorizontally(placements[j]) && placements[i].Box.Top < placements[j].Box.Bottom {
+				placements[i].shift(placements[j].Box.Bottom - placements[i].Box.Top)
+			}
+		}
+	}
+
+	// then pull labels that were pushed off the canvas back up.
+	for i := len(placements) - 1; i >= 0; i-- {
+		limit := canvasBox.Bottom
+		for j := i + 1; j < len(placements); j++ {
+			if placements[i].overlapsHorizontally(placements[j]) {
+				limit = MinInt(limit, placements[j].Box.Top)
+			}
+		}
+		if placements[i].Box.Bottom > limit {
+			placements[i].shift(limit - placements[i].Box.Bottom)
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package chart
 
 import (
+	"bytes"
 	"image/color"
+	"strings"
 	"testing"
 
 	"github.com/blend/go-sdk/assert"
@@ -51,10 +53,11 @@ func TestAnnotationSeriesMeasure(t *testing.T) {
 
 	box := as.Measure(r, cb, xrange, yrange, sd)
 	assert.False(box.IsZero())
-	assert.Equal(-5.0, box.Top)
+	// the annotations at the edges are flipped and moved to stay on the canvas.
+	assert.Equal(5.0, box.Top)
 	assert.Equal(5.0, box.Left)
-	assert.Equal(146.0, box.Right) //the top,left annotation sticks up 5px and out ~44px.
-	assert.Equal(115.0, box.Bottom)
+	assert.Equal(105.0, box.Right)
+	assert.Equal(105.0, box.Bottom)
 }
 
 func TestAnnotationSeriesRender(t *testing.T) {
@@ -114,3 +117,274 @@ func TestAnnotationSeriesRender(t *testing.T) {
 	assert.Equal(0, converted.G)
 	assert.Equal(0, converted.B)
 }
+
+func TestResolveAnnotationOverlaps(t *testing.T) {
+	assert := assert.New(t)
+
+	canvasBox := NewBox(0, 0, 100, 100)
+	placements := []annotationPlacement{
+		{Label: "a", X: 90, Y: 50, LabelY: 50, Box: NewBox(40, 90, 130, 60)},
+		{Label: "b", X: 90, Y: 52, LabelY: 52, Box: NewBox(42, 90, 130, 62)},
+		{Label: "c", X: 90, Y: 48, LabelY: 48, Box: NewBox(38, 90, 130, 58)},
+		{Label: "d", X: 10, Y: 50, LabelY: 50, Box: NewBox(40, 10, 50, 60)},
+	}
+	resolveAnnotationOverlaps(placements, canvasBox)
+
+	for i := range placements {
+		assert.True(placements[i].Box.Top >= canvasBox.Top)
+		assert.True(placements[i].Box.Bottom <= canvasBox.Bottom)
+		for j := range placements {
+			if i != j && placements[i].overlapsHorizontally(placements[j]) {
+				assert.False(placements[i].Box.Top < placements[j].Box.Bottom && placements[j].Box.Top < placements[i].Box.Bottom)
+			}
+		}
+	}
+
+	// labels that don't overlap anything stay on their data point.
+	for _, p := range placements {
+		if p.Label == "d" || p.Label == "c" {
+			assert.Equal(p.Y, p.LabelY)
+		}
+	}
+}
+
+func TestResolveAnnotationOverlapsKeepsWithinCanvas(t *testing.T) {
+	assert := assert.New(t)
+
+	canvasBox := NewBox(0, 0, 100, 100)
+	placements := []annotationPlacement{
+		{Label: "a", X: 90, Y: 95, LabelY: 95, Box: NewBox(90, 90, 130, 100)},
+		{Label: "b", X: 90, Y: 96, LabelY: 96, Box: NewBox(91, 90, 130, 101)},
+	}
+	resolveAnnotationOverlaps(placements, canvasBox)
+
+	assert.Equal("a", placements[0].Label)
+	assert.Equal(90, placements[1].Box.Top)
+	assert.Equal(100, placements[1].Box.Bottom)
+	assert.Equal(80, placements[0].Box.Top)
+	assert.Equal(90, placements[0].Box.Bottom)
+	assert.Equal(85, placements[0].LabelY)
+}
+
+func TestAnnotationSeriesMeasureAllowOverflow(t *testing.T) {
+	assert := assert.New(t)
+
+	as := AnnotationSeries{
+		AllowOverflow: true,
+		Annotations: []Value2{
+			{XValue: 1.0, YValue: 1.0, Label: "1.0"},
+			{XValue: 4.0, YValue: 4.0, Label: "4.0"},
+		},
+	}
+
+	r, err := PNG(110, 110)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	cb := Box{Top: 5, Left: 5, Right: 105, Bottom: 105}
+
+	box := as.Measure(r, cb, xrange, yrange, Style{FontSize: 10.0, Font: f})
+	assert.Equal(-5.0, box.Top)
+	assert.Equal(5.0, box.Left)
+	assert.Equal(146.0, box.Right) //the top,left annotation sticks up 5px and out ~44px.
+	assert.Equal(115.0, box.Bottom)
+
+	assert.True(LastValueAnnotationSeries(ContinuousSeries{XValues: []float64{1}, YValues: []float64{1}}).AllowOverflow)
+}
+
+func TestAnnotationSeriesPlacementsFlipAtEdges(t *testing.T) {
+	assert := assert.New(t)
+
+	as := AnnotationSeries{
+		Annotations: []Value2{
+			{XValue: 1.0, YValue: 2.0, Label: "left"},
+			{XValue: 4.0, YValue: 2.0, Label: "right"},
+			{XValue: 2.0, YValue: 4.0, Label: "top"},
+		},
+	}
+
+	r, err := PNG(110, 110)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	cb := Box{Top: 5, Left: 5, Right: 105, Bottom: 105}
+
+	placements := as.getPlacements(r, cb, xrange, yrange, Style{FontSize: 10.0, Font: f})
+	assert.Len(placements, 3)
+
+	assert.False(placements[0].Flipped)
+	assert.Equal(placements[0].X, placements[0].Box.Left)
+
+	assert.True(placements[1].Flipped)
+	assert.Equal(placements[1].X, placements[1].Box.Right)
+	assert.Equal(placements[1].Y, placements[1].LabelY)
+
+	assert.False(placements[2].Flipped)
+	assert.Equal(cb.Top, placements[2].Box.Top)
+	assert.True(placements[2].LabelY > placements[2].Y)
+}
+
+func TestAnnotationSeriesRenderFlipped(t *testing.T) {
+	assert := assert.New(t)
+
+	as := AnnotationSeries{
+		Style: Style{
+			FillColor:   drawing.ColorWhite,
+			StrokeColor: drawing.ColorBlack,
+		},
+		Annotations: []Value2{
+			{XValue: 4.0, YValue: 2.5, Label: "4.0"},
+		},
+	}
+
+	r, err := PNG(110, 110)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	cb := Box{Top: 5, Left: 5, Right: 105, Bottom: 105}
+
+	as.Render(r, cb, xrange, yrange, Style{FontSize: 10.0, Font: f})
+
+	rr := r.(*rasterRenderer)
+	// the callout is drawn left of the point at the right edge, nothing is drawn beyond it.
+	for x := 106; x < 110; x++ {
+		converted := color.RGBAModel.Convert(rr.i.At(x, 55)).(color.RGBA)
+		assert.Equal(0, converted.A)
+	}
+	converted := color.RGBAModel.Convert(rr.i.At(80, 55)).(color.RGBA)
+	assert.NotZero(converted.A)
+}
+
+func TestAnnotationSeriesAlignRight(t *testing.T) {
+	assert := assert.New(t)
+
+	as := AnnotationSeries{
+		AlignRight: true,
+		Annotations: []Value2{
+			{XValue: 2.0, YValue: 2.0, Label: "2.0"},
+			{XValue: 4.0, YValue: 4.0, Label: "4.0"},
+		},
+	}
+
+	r, err := PNG(110, 110)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	cb := Box{Top: 5, Left: 5, Right: 105, Bottom: 105}
+
+	placements := as.getPlacements(r, cb, xrange, yrange, Style{FontSize: 10.0, Font: f})
+	for _, p := range placements {
+		assert.Equal(cb.Right, p.LabelX)
+		assert.Equal(p.Y, p.LabelY)
+		assert.Equal(cb.Right, p.Box.Left)
+		assert.False(p.Flipped)
+	}
+}
+
+func TestAnnotationPlacementConnector(t *testing.T) {
+	assert := assert.New(t)
+
+	canvasBox := NewBox(0, 10, 100, 110)
+	connector := Style{StrokeColor: drawing.ColorBlack, StrokeWidth: 1, StrokeDashArray: []float64{2, 2}}
+
+	render := func(p annotationPlacement) string {
+		r, err := SVG(120, 120)
+		assert.Nil(err)
+		p.drawConnector(r, canvasBox)
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(r.Save(buffer))
+		return buffer.String()
+	}
+
+	aligned := render(annotationPlacement{Connector: connector, X: 50, Y: 50, LabelX: 110, LabelY: 50})
+	assert.True(strings.Contains(aligned, "M 50 50\nL 110 50"))
+	assert.True(strings.Contains(aligned, "stroke-dasharray=\"2.0, 2.0\""))
+
+	// connectors are clipped to the left of the canvas.
+	clipped := render(annotationPlacement{Connector: connector, X: 2, Y: 50, LabelX: 110, LabelY: 50})
+	assert.True(strings.Contains(clipped, "M 10 50\nL 110 50"))
+
+	// and not drawn if the point is right next to the label.
+	close := render(annotationPlacement{Connector: connector, X: 109, Y: 50, LabelX: 110, LabelY: 50})
+	assert.False(strings.Contains(close, "<path"))
+}
+
+func TestAnnotationSeriesShowConnectors(t *testing.T) {
+	assert := assert.New(t)
+
+	series := ContinuousSeries{
+		XValues: []float64{1.0, 2.0, 3.0, 4.0},
+		YValues: []float64{1.0, 2.0, 3.0, 2.0},
+	}
+	render := func(as AnnotationSeries) string {
+		as.Style = Style{StrokeColor: drawing.ColorRed, FillColor: drawing.ColorWhite}
+		c := Chart{
+			XAxis:  XAxis{Range: &ContinuousRange{Min: 1, Max: 8}},
+			Series: []Series{series, as},
+		}
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(SVG, buffer))
+		return buffer.String()
+	}
+
+	// labels moved away from their point don't get a connector unless asked for one.
+	aligned := LastValueAnnotationSeries(series)
+	aligned.AlignRight = true
+	connected := ConnectedLastValueAnnotationSeries(series)
+	assert.True(connected.ShowConnectors)
+
+	// the label box is stroked in the series color either way; the connector is the extra path.
+	red := "stroke-width:1;stroke:#ff0000"
+	assert.Equal(strings.Count(render(aligned), red)+1, strings.Count(render(connected), red))
+}
+
+func TestAnnotationSeriesLabelFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	series := ContinuousSeries{
+		XValues: []float64{1.0, 2.0, 3.0, 4.0},
+		YValues: []float64{1.0, 2.0, 3.0, 2.0},
+	}
+	lva := LastValueAnnotationSeries(series)
+	lva.LabelFormatter = func(x, y float64) string {
+		return "+3.2%"
+	}
+
+	r, err := PNG(200, 200)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	cb := Box{Top: 5, Left: 5, Right: 105, Bottom: 105}
+	sd := Style{FontSize: 10.0, Font: f}
+
+	placements := lva.getPlacements(r, cb, xrange, yrange, sd)
+	assert.Len(placements, 1)
+	assert.Equal("+3.2%", placements[0].Label)
+
+	// the label text is also used to reserve room for the label.
+	unformatted := LastValueAnnotationSeries(series).Measure(r, cb, xrange, yrange, sd)
+	formatted := lva.Measure(r, cb, xrange, yrange, sd)
+	assert.True(formatted.Right > unformatted.Right)
+
+	c := Chart{
+		Series: []Series{series, lva},
+	}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	assert.True(strings.Contains(buffer.String(), "+3.2%"))
+}
@@ -12,6 +12,33 @@ const (
 	TickPositionUnderTick TickPosition = 2
 )
 
+// TickMarkDirection is the side of the axis line tick marks are drawn on.
+type TickMarkDirection int
+
+const (
+	// TickMarkDirectionUnset means to use the default direction, outside.
+	TickMarkDirectionUnset TickMarkDirection = 0
+	// TickMarkDirectionOutside draws tick marks away from the canvas, towards the labels.
+	TickMarkDirectionOutside TickMarkDirection = 1
+	// TickMarkDirectionInside draws tick marks into the canvas.
+	TickMarkDirectionInside TickMarkDirection = 2
+)
+
+// getTickMarkExtent returns how far tick marks of a given length reach outside and inside the canvas.
+// A length of zero uses the default length; a negative length, i.e. `Disabled`, draws no tick marks.
+func getTickMarkExtent(length, defaultLength int, direction TickMarkDirection) (outside, inside int) {
+	if length == 0 {
+		length = defaultLength
+	}
+	if length < 0 {
+		return 0, 0
+	}
+	if direction == TickMarkDirectionInside {
+		return 0, length
+	}
+	return length, 0
+}
+
 // YAxisType is a type of y-axis; it can either be primary or secondary.
 type YAxisType int
 
@@ -22,6 +49,19 @@ const (
 	YAxisSecondary YAxisType = 1
 )
 
+// YAxisPosition is the side of the canvas a y-axis is drawn on.
+type YAxisPosition int
+
+const (
+	// YAxisPositionUnset means to use the default position for the axis type;
+	// right for the primary axis and left for the secondary axis.
+	YAxisPositionUnset YAxisPosition = 0
+	// YAxisPositionRight draws the axis and its labels to the right of the canvas.
+	YAxisPositionRight YAxisPosition = 1
+	// YAxisPositionLeft draws the axis and its labels to the left of the canvas.
+	YAxisPositionLeft YAxisPosition = 2
+)
+
 // Axis is a chart feature detailing what values happen where.
 type Axis interface {
 	GetName() string
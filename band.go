@@ -0,0 +1,42 @@
+package chart
+
+// Band is a shaded interval of the canvas between two values,
+// for example a maintenance window on the x axis or a target range on the y axis.
+type Band struct {
+	From  float64
+	To    float64
+	Style Style
+}
+
+// Render renders the band as a filled rectangle spanning the canvas.
+// Bands that extend beyond the canvas box are clipped to it, and bands entirely outside it are skipped.
+func (b Band) Render(r Renderer, canvasBox Box, ra Range, isVertical bool, defaults Style) {
+	style := b.Style.InheritFrom(defaults)
+	if style.Hidden {
+		return
+	}
+
+	box := canvasBox
+	if isVertical {
+		from := canvasBox.Left + ra.Translate(b.From)
+		to := canvasBox.Left + ra.Translate(b.To)
+		box.Left = MaxInt(canvasBox.Left, MinInt(from, to))
+		box.Right = MinInt(canvasBox.Right, MaxInt(from, to))
+	} else {
+		from := canvasBox.Bottom - ra.Translate(b.From)
+		to := canvasBox.Bottom - ra.Translate(b.To)
+		box.Top = MaxInt(canvasBox.Top, MinInt(from, to))
+		box.Bottom = MinInt(canvasBox.Bottom, MaxInt(from, to))
+	}
+	if box.Left >= box.Right || box.Top >= box.Bottom {
+		return
+	}
+
+	style.GetFillOptions().WriteToRenderer(r)
+	r.MoveTo(box.Left, box.Top)
+	r.LineTo(box.Right, box.Top)
+	r.LineTo(box.Right, box.Bottom)
+	r.LineTo(box.Left, box.Bottom)
+	r.Close()
+	r.Fill()
+}
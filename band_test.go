@@ -0,0 +1,153 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func renderBand(t *testing.T, b Band, isVertical bool) string {
+	assert := assert.New(t)
+
+	r, err := SVG(200, 100)
+	assert.Nil(err)
+
+	ra := &ContinuousRange{Min: 0, Max: 10, Domain: 80}
+	canvasBox := Box{Top: 10, Left: 10, Right: 90, Bottom: 90}
+	b.Render(r, canvasBox, ra, isVertical, Style{FillColor: drawing.ColorRed})
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+	return buffer.String()
+}
+
+func TestBandRenderVertical(t *testing.T) {
+	assert := assert.New(t)
+
+	svg := renderBand(t, Band{From: 2, To: 5}, true)
+	assert.True(strings.Contains(svg, "M 26 10\nL 50 10\nL 50 90\nL 26 90"))
+
+	// bands are drawn the same regardless of the order of from and to.
+	assert.Equal(svg, renderBand(t, Band{From: 5, To: 2}, true))
+}
+
+func TestBandRenderClipped(t *testing.T) {
+	assert := assert.New(t)
+
+	svg := renderBand(t, Band{From: 5, To: 20}, true)
+	assert.True(strings.Contains(svg, "M 50 10\nL 90 10\nL 90 90\nL 50 90"))
+
+	svg = renderBand(t, Band{From: 15, To: 20}, true)
+	assert.False(strings.Contains(svg, "<path"))
+}
+
+func TestChartXBands(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:          100,
+		Height:         100,
+		TitleStyle:     Hidden(),
+		Background:     Style{FillColor: drawing.ColorWhite},
+		Canvas:         Style{FillColor: drawing.ColorWhite},
+		XAxis:          HideXAxis(),
+		YAxis:          HideYAxis(),
+		YAxisSecondary: HideYAxis(),
+		Series: []Series{
+			ContinuousSeries{
+				Style:   Style{StrokeWidth: Disabled},
+				XValues: []float64{0, 10},
+				YValues: []float64{0, 10},
+			},
+		},
+		XBands: []Band{
+			{From: 2, To: 6, Style: Style{FillColor: drawing.ColorBlack.WithAlpha(64)}},
+			{From: 4, To: 8, Style: Style{FillColor: drawing.ColorBlack.WithAlpha(64)}},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	i, err := png.Decode(buffer)
+	assert.Nil(err)
+
+	cb := c.Box()
+	xAt := func(v float64) int {
+		return cb.Left + int(float64(cb.Width())*v/10)
+	}
+
+	// overlapping bands composite, so the overlap is darker than either band alone.
+	outside := at(i, xAt(1), 50)
+	single := at(i, xAt(3), 50)
+	overlap := at(i, xAt(5), 50)
+	assert.Equal(drawing.ColorWhite, outside)
+	assert.True(single.R < outside.R)
+	assert.True(overlap.R < single.R)
+}
+
+func TestBandRenderHorizontal(t *testing.T) {
+	assert := assert.New(t)
+
+	svg := renderBand(t, Band{From: 2, To: 5}, false)
+	assert.True(strings.Contains(svg, "M 10 50\nL 90 50\nL 90 74\nL 10 74"))
+
+	svg = renderBand(t, Band{From: -5, To: 5}, false)
+	assert.True(strings.Contains(svg, "M 10 50\nL 90 50\nL 90 90\nL 10 90"))
+
+	svg = renderBand(t, Band{From: 11, To: 12}, false)
+	assert.False(strings.Contains(svg, "<path"))
+}
+
+func TestChartYBandsUnderSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:          100,
+		Height:         100,
+		TitleStyle:     Hidden(),
+		Background:     Style{FillColor: drawing.ColorWhite},
+		Canvas:         Style{FillColor: drawing.ColorWhite},
+		XAxis:          HideXAxis(),
+		YAxis:          HideYAxis(),
+		YAxisSecondary: HideYAxis(),
+		Series: []Series{
+			ContinuousSeries{
+				Style:   Style{StrokeColor: drawing.ColorRed, StrokeWidth: 5},
+				XValues: []float64{0, 10},
+				YValues: []float64{5, 5},
+			},
+			ContinuousSeries{
+				Style:   Style{StrokeWidth: Disabled},
+				XValues: []float64{0, 10},
+				YValues: []float64{0, 10},
+			},
+		},
+		YBands: []Band{
+			{From: 4, To: 6, Style: Style{FillColor: drawing.ColorGreen}},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	svg := buffer.String()
+	band := strings.Index(svg, drawing.ColorGreen.Hex())
+	line := strings.Index(svg, drawing.ColorRed.Hex())
+	assert.True(band > 0)
+	assert.True(band < line)
+
+	buffer.Reset()
+	assert.Nil(c.Render(PNG, buffer))
+	i, err := png.Decode(buffer)
+	assert.Nil(err)
+
+	// the line is drawn over the band.
+	cb := c.Box()
+	x, y := cb.Center()
+	assert.Equal(drawing.ColorRed, at(i, x, y))
+	assert.Equal(drawing.ColorGreen, at(i, x, y-cb.Height()/20-3))
+	assert.Equal(drawing.ColorWhite, at(i, x, cb.Top+5))
+}
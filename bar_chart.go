@@ -3,8 +3,10 @@ package chart
 import (
 	"errors"
 	"fmt"
+	"image"
 	"io"
 	"math"
+	"strings"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -15,6 +17,10 @@ type BarChart struct {
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme sets the colors and axis font size of the chart; it defaults to `GetDefaultTheme()`.
+	// Bars keep the `AlternateColorPalette` colors unless the theme has series colors, and the chart keeps
+	// its own padding, which leaves room for the bar labels. A ColorPalette takes precedence over the theme colors.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -33,6 +39,13 @@ type BarChart struct {
 	UseBaseValue bool
 	BaseValue    float64
 
+	// IsHorizontal draws the bars growing rightward, with the category labels to the left
+	// and the value axis (still configured by YAxis) along the bottom.
+	IsHorizontal bool
+	// ShowValueLabels draws the formatted value past the far end of each bar, i.e. below or left of the bars
+	// that fall below the base value. Room is made for them within the chart.
+	ShowValueLabels bool
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -88,6 +101,12 @@ func (bc BarChart) GetBarWidth() int {
 	return bc.BarWidth
 }
 
+// RenderImage renders the chart with a raster renderer provider, like PNG, and returns the image
+// without encoding it.
+func (bc BarChart) RenderImage(rp RendererProvider) (image.Image, error) {
+	return renderImage(rp, bc.Render)
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 	if len(bc.Bars) == 0 {
@@ -115,7 +134,7 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 	var yr Range
 	var yf ValueFormatter
 
-	canvasBox = bc.getDefaultCanvasBox()
+	canvasBox = bc.getTitleAdjustedCanvasBox(r, bc.getDefaultCanvasBox())
 	yr = bc.getRanges()
 	if yr.GetMax()-yr.GetMin() == 0 {
 		return fmt.Errorf("invalid data range; cannot be zero")
@@ -123,15 +142,33 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 	yr = bc.setRangeDomains(canvasBox, yr)
 	yf = bc.getValueFormatters()
 
-	if bc.hasAxes() {
+	if bc.IsHorizontal {
 		yt = bc.getAxesTicks(r, yr, yf)
-		canvasBox = bc.getAdjustedCanvasBox(r, canvasBox, yr, yt)
+		canvasBox = bc.getHorizontalAdjustedCanvasBox(r, canvasBox, yr, yt, yf)
 		yr = bc.setRangeDomains(canvasBox, yr)
+		bc.extendRangeForValueLabels(r, yr, yf)
+		yt = bc.getAxesTicks(r, yr, yf)
+
+		bc.drawCanvas(r, canvasBox)
+		bc.drawHorizontalBars(r, canvasBox, yr, yf)
+		bc.drawHorizontalXAxis(r, canvasBox)
+		bc.drawHorizontalYAxis(r, canvasBox, yr, yt)
+	} else {
+		if bc.hasAxes() {
+			yt = bc.getAxesTicks(r, yr, yf)
+			canvasBox = bc.getAdjustedCanvasBox(r, canvasBox, yr, yt)
+			yr = bc.setRangeDomains(canvasBox, yr)
+		}
+		if bc.ShowValueLabels {
+			canvasBox = bc.getValueLabelAdjustedCanvasBox(r, canvasBox, yf)
+			yr = bc.setRangeDomains(canvasBox, yr)
+			bc.extendRangeForValueLabels(r, yr, yf)
+		}
+		bc.drawCanvas(r, canvasBox)
+		bc.drawBars(r, canvasBox, yr, yf)
+		bc.drawXAxis(r, canvasBox)
+		bc.drawYAxis(r, canvasBox, yr, yt)
 	}
-	bc.drawCanvas(r, canvasBox)
-	bc.drawBars(r, canvasBox, yr)
-	bc.drawXAxis(r, canvasBox)
-	bc.drawYAxis(r, canvasBox, yr, yt)
 
 	bc.drawTitle(r)
 	for _, a := range bc.Elements {
@@ -148,7 +185,7 @@ func (bc BarChart) drawCanvas(r Renderer, canvasBox Box) {
 func (bc BarChart) getRanges() Range {
 	var yrange Range
 	if bc.YAxis.Range != nil && !bc.YAxis.Range.IsZero() {
-		yrange = bc.YAxis.Range
+		yrange = copyRange(bc.YAxis.Range)
 	} else {
 		yrange = &ContinuousRange{}
 	}
@@ -187,7 +224,7 @@ func (bc BarChart) drawBackground(r Renderer) {
 	}, bc.getBackgroundStyle())
 }
 
-func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
+func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range, yf ValueFormatter) {
 	xoffset := canvasBox.Left
 
 	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
@@ -219,10 +256,58 @@ func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
 
 		Draw.Box(r, barBox, bar.Style.InheritFrom(bc.styleDefaultsBar(index)))
 
+		if bc.ShowValueLabels {
+			label := yf(bar.Value)
+			valueStyle := bc.styleDefaultsValueLabels()
+			tb := Draw.MeasureText(r, label, valueStyle)
+			ly := MinInt(barBox.Top, barBox.Bottom) - DefaultBarValueLabelPadding
+			if bc.isBelowBase(bar) {
+				ly = MaxInt(barBox.Top, barBox.Bottom) + DefaultBarValueLabelPadding + tb.Height()
+			}
+			Draw.Text(r, label, bxl+((width-tb.Width())>>1), ly, valueStyle)
+		}
+
 		xoffset += width + spacing
 	}
 }
 
+// drawHorizontalBars draws the bars rightward from the left of the canvas, or from the base value.
+func (bc BarChart) drawHorizontalBars(r Renderer, canvasBox Box, yr Range, yf ValueFormatter) {
+	yoffset := canvasBox.Top
+
+	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+	bs2 := spacing >> 1
+
+	for index, bar := range bc.Bars {
+		left := canvasBox.Left
+		if bc.UseBaseValue {
+			left = canvasBox.Left + yr.Translate(bc.BaseValue)
+		}
+		right := canvasBox.Left + yr.Translate(bar.Value)
+
+		barBox := Box{
+			Top:    yoffset + bs2,
+			Left:   MinInt(left, right),
+			Right:  MaxInt(left, right),
+			Bottom: yoffset + bs2 + width,
+		}
+		Draw.Box(r, barBox, bar.Style.InheritFrom(bc.styleDefaultsBar(index)))
+
+		if bc.ShowValueLabels {
+			label := yf(bar.Value)
+			valueStyle := bc.styleDefaultsValueLabels()
+			tb := Draw.MeasureText(r, label, valueStyle)
+			lx := barBox.Right + DefaultBarValueLabelPadding
+			if bc.isBelowBase(bar) {
+				lx = barBox.Left - DefaultBarValueLabelPadding - tb.Width()
+			}
+			Draw.Text(r, label, lx, barBox.Top+((width+tb.Height())>>1), valueStyle)
+		}
+
+		yoffset += width + spacing
+	}
+}
+
 func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
 	if !bc.XAxis.Hidden {
 		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
@@ -248,7 +333,7 @@ func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
 			}
 
 			if len(bar.Label) > 0 {
-				Draw.TextWithin(r, bar.Label, barLabelBox, axisStyle)
+				Draw.TextLinesWithin(r, bc.getBarLabelLines(r, bar.Label, barLabelBox.Width(), axisStyle), barLabelBox, axisStyle)
 			}
 
 			axisStyle.WriteToRenderer(r)
@@ -293,6 +378,64 @@ func (bc BarChart) drawYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick)
 	}
 }
 
+// drawHorizontalXAxis draws the category axis along the left of the canvas, with the labels right aligned.
+func (bc BarChart) drawHorizontalXAxis(r Renderer, canvasBox Box) {
+	if !bc.XAxis.Hidden {
+		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Top)
+		r.LineTo(canvasBox.Left, canvasBox.Bottom)
+		r.Stroke()
+
+		width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+		labelRight := canvasBox.Left - DefaultYAxisMargin
+		labelWidth := labelRight - bc.box().Left
+
+		cursor := canvasBox.Top
+		for index, bar := range bc.Bars {
+			if len(bar.Label) > 0 {
+				label := Text.Truncate(r, bar.Label, labelWidth, axisStyle)
+				tb := r.MeasureText(label)
+				r.Text(label, labelRight-tb.Width(), cursor+((width+spacing+tb.Height())>>1))
+			}
+
+			axisStyle.WriteToRenderer(r)
+			if index < len(bc.Bars)-1 {
+				r.MoveTo(canvasBox.Left, cursor+width+spacing)
+				r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, cursor+width+spacing)
+				r.Stroke()
+			}
+			cursor += width + spacing
+		}
+	}
+}
+
+// drawHorizontalYAxis draws the value axis along the bottom of the canvas.
+func (bc BarChart) drawHorizontalYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick) {
+	if !bc.YAxis.Style.Hidden {
+		axisStyle := bc.YAxis.Style.InheritFrom(bc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+
+		for _, t := range ticks {
+			tx := canvasBox.Left + yr.Translate(t.Value)
+
+			axisStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(tx, canvasBox.Bottom)
+			r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
+			r.Stroke()
+
+			axisStyle.GetTextOptions().WriteToRenderer(r)
+			tb := r.MeasureText(t.Label)
+			r.Text(t.Label, tx-(tb.Width()>>1), canvasBox.Bottom+DefaultXAxisMargin+tb.Height())
+		}
+	}
+}
+
 func (bc BarChart) drawTitle(r Renderer) {
 	if len(bc.Title) > 0 && !bc.TitleStyle.Hidden {
 		r.SetFont(bc.TitleStyle.GetFont(bc.GetFont()))
@@ -312,6 +455,21 @@ func (bc BarChart) drawTitle(r Renderer) {
 	}
 }
 
+// getTitleAdjustedCanvasBox moves the top of the canvas below the title so a large title font
+// doesn't overlap the bars.
+func (bc BarChart) getTitleAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	if len(bc.Title) == 0 || bc.TitleStyle.Hidden {
+		return canvasBox
+	}
+	textBox := Draw.MeasureText(r, bc.Title, bc.TitleStyle.InheritFrom(Style{
+		Font:     bc.GetFont(),
+		FontSize: bc.getTitleFontSize(),
+	}))
+	titleBottom := bc.TitleStyle.Padding.GetTop(DefaultTitleTop) + textBox.Height()
+	canvasBox.Top = MaxInt(canvasBox.Top, titleBottom+bc.TitleStyle.Padding.GetBottom(DefaultTitleBottom))
+	return canvasBox
+}
+
 func (bc BarChart) getCanvasStyle() Style {
 	return bc.Canvas.InheritFrom(bc.styleDefaultsCanvas())
 }
@@ -329,7 +487,11 @@ func (bc BarChart) hasAxes() bool {
 }
 
 func (bc BarChart) setRangeDomains(canvasBox Box, yr Range) Range {
-	yr.SetDomain(canvasBox.Height())
+	if bc.IsHorizontal {
+		yr.SetDomain(canvasBox.Width())
+	} else {
+		yr.SetDomain(canvasBox.Height())
+	}
 	return yr
 }
 
@@ -346,15 +508,31 @@ func (bc BarChart) getValueFormatters() ValueFormatter {
 
 func (bc BarChart) getAxesTicks(r Renderer, yr Range, yf ValueFormatter) (yticks []Tick) {
 	if !bc.YAxis.Style.Hidden {
-		yticks = bc.YAxis.GetTicks(r, yr, bc.styleDefaultsAxes(), yf)
+		if bc.IsHorizontal {
+			// the value axis runs along the bottom, so space the ticks like an x axis.
+			xa := XAxis{Style: bc.YAxis.Style, Range: bc.YAxis.Range, Ticks: bc.YAxis.Ticks, EvenTicks: bc.YAxis.EvenTicks}
+			xa.extendRange(r, yr, bc.styleDefaultsAxes(), yf)
+			yticks = xa.GetTicks(r, yr, bc.styleDefaultsAxes(), yf)
+		} else {
+			bc.YAxis.extendRange(r, yr, bc.styleDefaultsAxes(), yf)
+			yticks = bc.YAxis.GetTicks(r, yr, bc.styleDefaultsAxes(), yf)
+		}
 	}
 	return
 }
 
+// getCategoryLength returns the length of the canvas the bars are laid out along.
+func (bc BarChart) getCategoryLength(canvasBox Box) int {
+	if bc.IsHorizontal {
+		return canvasBox.Height()
+	}
+	return canvasBox.Width()
+}
+
 func (bc BarChart) calculateEffectiveBarSpacing(canvasBox Box) int {
 	totalWithBaseSpacing := bc.calculateTotalBarWidth(bc.GetBarWidth(), bc.GetBarSpacing())
-	if totalWithBaseSpacing > canvasBox.Width() {
-		lessBarWidths := canvasBox.Width() - (len(bc.Bars) * bc.GetBarWidth())
+	if totalWithBaseSpacing > bc.getCategoryLength(canvasBox) {
+		lessBarWidths := bc.getCategoryLength(canvasBox) - (len(bc.Bars) * bc.GetBarWidth())
 		if lessBarWidths > 0 {
 			return int(math.Ceil(float64(lessBarWidths) / float64(len(bc.Bars))))
 		}
@@ -365,8 +543,8 @@ func (bc BarChart) calculateEffectiveBarSpacing(canvasBox Box) int {
 
 func (bc BarChart) calculateEffectiveBarWidth(canvasBox Box, spacing int) int {
 	totalWithBaseWidth := bc.calculateTotalBarWidth(bc.GetBarWidth(), spacing)
-	if totalWithBaseWidth > canvasBox.Width() {
-		totalLessBarSpacings := canvasBox.Width() - (len(bc.Bars) * spacing)
+	if totalWithBaseWidth > bc.getCategoryLength(canvasBox) {
+		totalLessBarSpacings := bc.getCategoryLength(canvasBox) - (len(bc.Bars) * spacing)
 		if totalLessBarSpacings > 0 {
 			return int(math.Ceil(float64(totalLessBarSpacings) / float64(len(bc.Bars))))
 		}
@@ -397,19 +575,13 @@ func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range,
 		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
 
-		cursor := canvasBox.Left
+		width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
 		for _, bar := range bc.Bars {
 			if len(bar.Label) > 0 {
-				barLabelBox := Box{
-					Top:    canvasBox.Bottom + DefaultXAxisMargin,
-					Left:   cursor,
-					Right:  cursor + bc.GetBarWidth() + bc.GetBarSpacing(),
-					Bottom: bc.GetHeight(),
-				}
-				lines := Text.WrapFit(r, bar.Label, barLabelBox.Width(), axisStyle)
+				lines := bc.getBarLabelLines(r, bar.Label, width+spacing, axisStyle)
 				linesBox := Text.MeasureLines(r, lines, axisStyle)
 
-				xaxisHeight = MinInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
+				xaxisHeight = MaxInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
 			}
 		}
 
@@ -431,17 +603,129 @@ func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range,
 	return canvasBox.OuterConstrain(bc.box(), axesOuterBox)
 }
 
-// box returns the chart bounds as a box.
-func (bc BarChart) box() Box {
-	dpr := bc.Background.Padding.GetRight(10)
-	dpb := bc.Background.Padding.GetBottom(50)
+// getHorizontalAdjustedCanvasBox reserves room left of the canvas for the widest category label
+// (up to a third of the canvas), below it for the value axis, and right of it for the value labels.
+func (bc BarChart) getHorizontalAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range, yticks []Tick, yf ValueFormatter) Box {
+	adjusted := canvasBox.Clone()
+
+	if !bc.XAxis.Hidden {
+		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
+		axisStyle.WriteTextOptionsToRenderer(r)
 
-	return Box{
-		Top:    bc.Background.Padding.GetTop(20),
-		Left:   bc.Background.Padding.GetLeft(20),
-		Right:  bc.GetWidth() - dpr,
-		Bottom: bc.GetHeight() - dpb,
+		var labelWidth int
+		for _, bar := range bc.Bars {
+			labelWidth = MaxInt(labelWidth, r.MeasureText(bar.Label).Width())
+		}
+		labelWidth = MinInt(labelWidth, canvasBox.Width()/3)
+		adjusted.Left = canvasBox.Left + labelWidth + DefaultYAxisMargin
 	}
+
+	if !bc.YAxis.Style.Hidden {
+		axisStyle := bc.YAxis.Style.InheritFrom(bc.styleDefaultsAxes())
+		axisStyle.WriteTextOptionsToRenderer(r)
+
+		var labelHeight int
+		for _, t := range yticks {
+			labelHeight = MaxInt(labelHeight, r.MeasureText(t.Label).Height())
+		}
+		adjusted.Bottom = MinInt(canvasBox.Bottom, bc.GetHeight()-(labelHeight+2*DefaultXAxisMargin))
+	}
+
+	if _, above := bc.getBarDirections(); bc.ShowValueLabels && above {
+		valueWidth, _ := bc.measureValueLabels(r, yf)
+		adjusted.Right = canvasBox.Right - (valueWidth + DefaultBarValueLabelPadding)
+	}
+
+	return adjusted
+}
+
+// getValueLabelAdjustedCanvasBox reserves room above the canvas for the value labels of the bars that rise from the base.
+func (bc BarChart) getValueLabelAdjustedCanvasBox(r Renderer, canvasBox Box, yf ValueFormatter) Box {
+	if _, above := bc.getBarDirections(); !above {
+		return canvasBox
+	}
+	_, valueHeight := bc.measureValueLabels(r, yf)
+	adjusted := canvasBox.Clone()
+	adjusted.Top = canvasBox.Top + valueHeight + DefaultBarValueLabelPadding
+	return adjusted
+}
+
+// extendRangeForValueLabels extends the range past the least value by enough for the value labels of the bars that fall
+// below the base, so they're drawn within the canvas rather than over the axis labels. The range must have its domain set.
+func (bc BarChart) extendRangeForValueLabels(r Renderer, yr Range, yf ValueFormatter) {
+	if below, _ := bc.getBarDirections(); !below {
+		return
+	}
+	valueWidth, valueHeight := bc.measureValueLabels(r, yf)
+	room := valueHeight + DefaultBarValueLabelPadding
+	if bc.IsHorizontal {
+		room = valueWidth + DefaultBarValueLabelPadding
+	}
+	domain := yr.GetDomain()
+	if domain <= room {
+		return
+	}
+
+	least := math.MaxFloat64
+	for _, bar := range bc.Bars {
+		least = math.Min(least, bar.Value)
+	}
+	// the min that puts the least value `room` pixels into the domain, keeping the max where it is.
+	min := least - float64(room)*(yr.GetMax()-least)/float64(domain-room)
+	yr.SetMin(math.Min(yr.GetMin(), min))
+}
+
+// getBarDirections returns if any of the bars fall below the base value, and if any rise from it.
+func (bc BarChart) getBarDirections() (below, above bool) {
+	for _, bar := range bc.Bars {
+		if bc.isBelowBase(bar) {
+			below = true
+		} else {
+			above = true
+		}
+	}
+	return
+}
+
+// isBelowBase returns if a bar falls below the base value, i.e. leftward of it on a horizontal chart.
+func (bc BarChart) isBelowBase(bar Value) bool {
+	return bc.UseBaseValue && bar.Value < bc.BaseValue
+}
+
+// measureValueLabels returns the width and height of the widest and tallest value labels.
+func (bc BarChart) measureValueLabels(r Renderer, yf ValueFormatter) (width, height int) {
+	valueStyle := bc.styleDefaultsValueLabels()
+	for _, bar := range bc.Bars {
+		tb := Draw.MeasureText(r, yf(bar.Value), valueStyle)
+		width = MaxInt(width, tb.Width())
+		height = MaxInt(height, tb.Height())
+	}
+	return
+}
+
+// getBarLabelLines wraps a category label to the width of its bar, truncating lines that still don't fit
+// and any text past the maximum number of lines.
+func (bc BarChart) getBarLabelLines(r Renderer, label string, width int, style Style) []string {
+	var lines []string
+	for _, line := range Text.WrapFit(r, label, width, style) {
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > DefaultBarLabelMaxLines {
+		lines[DefaultBarLabelMaxLines-1] = strings.Join(lines[DefaultBarLabelMaxLines-1:], " ")
+		lines = lines[:DefaultBarLabelMaxLines]
+	}
+	for index := range lines {
+		lines[index] = Text.Truncate(r, lines[index], width, style)
+	}
+	return lines
+}
+
+// box returns the chart bounds as a box.
+func (bc BarChart) box() Box {
+	padding := bc.Background.InheritFrom(Style{Padding: Box{Top: 20, Left: 20, Right: 10, Bottom: 50}}).Padding
+	return Box{Right: bc.GetWidth(), Bottom: bc.GetHeight()}.Inset(padding)
 }
 
 func (bc BarChart) getBackgroundStyle() Style {
@@ -464,6 +748,14 @@ func (bc BarChart) styleDefaultsBar(index int) Style {
 	}
 }
 
+func (bc BarChart) styleDefaultsValueLabels() Style {
+	return Style{
+		Font:      bc.GetFont(),
+		FontSize:  bc.GetTheme().GetAxisFontSize(),
+		FontColor: bc.GetColorPalette().TextColor(),
+	}
+}
+
 func (bc BarChart) styleDefaultsTitle() Style {
 	return bc.TitleStyle.InheritFrom(Style{
 		FontColor:           bc.GetColorPalette().TextColor(),
@@ -493,7 +785,7 @@ func (bc BarChart) styleDefaultsAxes() Style {
 	return Style{
 		StrokeColor:         bc.GetColorPalette().AxisStrokeColor(),
 		Font:                bc.GetFont(),
-		FontSize:            DefaultAxisFontSize,
+		FontSize:            bc.GetTheme().GetAxisFontSize(),
 		FontColor:           bc.GetColorPalette().TextColor(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
@@ -507,10 +799,12 @@ func (bc BarChart) styleDefaultsElements() Style {
 	}
 }
 
+// GetTheme returns the chart theme or the default theme; nil means the package defaults.
+func (bc BarChart) GetTheme() *Theme {
+	return getTheme(bc.Theme)
+}
+
 // GetColorPalette returns the color palette for the chart.
 func (bc BarChart) GetColorPalette() ColorPalette {
-	if bc.ColorPalette != nil {
-		return bc.ColorPalette
-	}
-	return AlternateColorPalette
+	return getColorPalette(bc.ColorPalette, bc.GetTheme(), AlternateColorPalette)
 }
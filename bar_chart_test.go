@@ -3,6 +3,8 @@ package chart
 import (
 	"bytes"
 	"math"
+	"strconv"
+	"strings"
 	"testing"
 
 	assert "github.com/blend/go-sdk/assert"
@@ -308,3 +310,176 @@ func TestBarChatGetTitleFontSize(t *testing.T) {
 	size = BarChart{Width: 128, Height: 128}.getTitleFontSize()
 	assert.Equal(10, size)
 }
+
+func TestBarChartGetBarLabelLines(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	bc := BarChart{}
+	style := bc.styleDefaultsAxes()
+	style.Font = f
+
+	assert.Equal([]string{"short"}, bc.getBarLabelLines(r, "short", 100, style))
+
+	lines := bc.getBarLabelLines(r, "a very long category label that wraps onto many lines", 60, style)
+	assert.Len(lines, DefaultBarLabelMaxLines)
+	assert.True(strings.HasSuffix(lines[len(lines)-1], DefaultTextEllipsis))
+	for _, line := range lines {
+		assert.NotEmpty(line)
+		assert.True(r.MeasureText(line).Width() <= 60)
+	}
+}
+
+func TestBarChartRenderHorizontal(t *testing.T) {
+	assert := assert.New(t)
+
+	bc := BarChart{
+		Width:           1024,
+		Title:           "Test Title",
+		IsHorizontal:    true,
+		ShowValueLabels: true,
+		Bars: []Value{
+			{Value: 1.0, Label: "One"},
+			{Value: 2.0, Label: "Two"},
+			{Value: 3.0, Label: "Three"},
+			{Value: 4.0, Label: "Four"},
+			{Value: 5.0, Label: "A much longer category label"},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	err := bc.Render(PNG, buf)
+	assert.Nil(err)
+	assert.NotZero(buf.Len())
+
+	buf = bytes.NewBuffer([]byte{})
+	err = bc.Render(SVG, buf)
+	assert.Nil(err)
+	assert.True(strings.Contains(buf.String(), "A much longer category label"))
+}
+
+func TestBarChartHorizontalAdjustedCanvasBox(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	bc := BarChart{
+		Font:         f,
+		IsHorizontal: true,
+		Bars: []Value{
+			{Value: 1.0, Label: "Short"},
+			{Value: 2.0, Label: "A considerably longer label"},
+		},
+	}
+	cb := bc.box()
+	yr := bc.getRanges()
+	yf := bc.getValueFormatters()
+	yr = bc.setRangeDomains(cb, yr)
+	yt := bc.getAxesTicks(r, yr, yf)
+
+	adjusted := bc.getHorizontalAdjustedCanvasBox(r, cb, yr, yt, yf)
+	assert.True(adjusted.Left > cb.Left)
+	assert.True(adjusted.Left-cb.Left <= cb.Width()/3+DefaultYAxisMargin)
+	assert.True(adjusted.Bottom <= cb.Bottom)
+	assert.Equal(cb.Right, adjusted.Right)
+
+	bc.ShowValueLabels = true
+	withValues := bc.getHorizontalAdjustedCanvasBox(r, cb, yr, yt, yf)
+	assert.True(withValues.Right < cb.Right)
+}
+
+func TestBarChartSetRangeDomainsHorizontal(t *testing.T) {
+	assert := assert.New(t)
+
+	bc := BarChart{Width: 800, Height: 400, IsHorizontal: true}
+	cb := bc.box()
+	yr := bc.setRangeDomains(cb, bc.getRanges())
+	assert.Equal(cb.Width(), yr.GetDomain())
+}
+
+func TestBarChartGetTitleAdjustedCanvasBox(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	bc := BarChart{Font: f}
+	cb := bc.getDefaultCanvasBox()
+	assert.Equal(cb, bc.getTitleAdjustedCanvasBox(r, cb))
+
+	bc.Title = "Title"
+	bc.TitleStyle = Hidden()
+	assert.Equal(cb, bc.getTitleAdjustedCanvasBox(r, cb))
+
+	bc.TitleStyle = Style{FontSize: 48}
+	adjusted := bc.getTitleAdjustedCanvasBox(r, cb)
+	assert.True(adjusted.Top > cb.Top)
+	assert.Equal(cb.Bottom, adjusted.Bottom)
+
+	bc.TitleStyle = Style{FontSize: 48, Padding: Box{Bottom: 30}}
+	padded := bc.getTitleAdjustedCanvasBox(r, cb)
+	assert.Equal(adjusted.Top-DefaultTitleBottom+30, padded.Top)
+}
+
+// renderBarChartTexts renders a bar chart as svg and returns where each text is drawn, by its text.
+func renderBarChartTexts(assert *assert.Assertions, bc BarChart) map[string][2]int {
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(bc.Render(SVG, buffer))
+	texts := map[string][2]int{}
+	for _, match := range markerTextPattern.FindAllStringSubmatch(buffer.String(), -1) {
+		x, _ := strconv.Atoi(match[1])
+		y, _ := strconv.Atoi(match[2])
+		texts[match[3]] = [2]int{x, y}
+	}
+	return texts
+}
+
+func TestBarChartValueLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := SVG(10, 10)
+	assert.Nil(err)
+
+	bc := BarChart{
+		Width:           400,
+		Height:          300,
+		Font:            f,
+		UseBaseValue:    true,
+		ShowValueLabels: true,
+		// without the value axis, so its labels aren't mistaken for value labels.
+		YAxis: YAxis{Style: Hidden()},
+		Bars: []Value{
+			{Value: 5, Label: "rise"},
+			{Value: 0, Label: "base"},
+			{Value: -3, Label: "fall"},
+		},
+	}
+	valueStyle := bc.styleDefaultsValueLabels()
+	labelHeight := Draw.MeasureText(r, "5.00", valueStyle).Height()
+
+	// the label of the tallest bar has room above it within the canvas, and the label of the bar that falls
+	// from the base is below it, above the category labels.
+	texts := renderBarChartTexts(assert, bc)
+	assert.True(texts["5.00"][1]-labelHeight >= bc.getDefaultCanvasBox().Top)
+	assert.True(texts["-3.00"][1]-labelHeight > texts["0.00"][1]+DefaultBarValueLabelPadding)
+	assert.True(texts["-3.00"][1] < texts["fall"][1]-labelHeight)
+
+	// on a horizontal chart it's left of the bar, right of the category labels.
+	bc.IsHorizontal = true
+	texts = renderBarChartTexts(assert, bc)
+	fallWidth := Draw.MeasureText(r, "fall", bc.XAxis.InheritFrom(bc.styleDefaultsAxes())).Width()
+	assert.True(texts["-3.00"][0]+Draw.MeasureText(r, "-3.00", valueStyle).Width() < texts["0.00"][0]-DefaultBarValueLabelPadding)
+	assert.True(texts["-3.00"][0] > texts["fall"][0]+fallWidth)
+	assert.True(texts["5.00"][0]+Draw.MeasureText(r, "5.00", valueStyle).Width() <= bc.Width)
+}
@@ -0,0 +1,263 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+const (
+	// DefaultHistogramBinCount is the default number of bins for a binned histogram.
+	DefaultHistogramBinCount = 10
+)
+
+// Interface Assertions.
+var (
+	_ Series                 = (*BinnedHistogramSeries)(nil)
+	_ BoundedValuesProvider  = (*BinnedHistogramSeries)(nil)
+	_ BoundsProvider         = (*BinnedHistogramSeries)(nil)
+	_ ValueFormatterProvider = (*BinnedHistogramSeries)(nil)
+)
+
+// BinnedHistogramSeries counts raw observations into bins and draws the counts as adjacent bars.
+// The bins are either BinEdges, which `Validate` requires to be sorted ascending, or BinCount bins of equal width
+// spanning the finite values. Values outside the edges are not counted, and each bin includes its
+// left edge; the last bin also includes its right edge.
+// Use `GetTicks` as the x axis ticks to label the bin edges.
+//
+// The bins are counted once, and again only if the values are replaced or change length, or the edges or count change;
+// the series is locked while they are, so charts rendered concurrently can share it. Changing values in place
+// needs a call to `ResetBins`.
+type BinnedHistogramSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Values   []float64
+	BinCount int
+	BinEdges []float64
+
+	lock   sync.Mutex
+	binned *binnedHistogram
+}
+
+// binnedHistogram is the bins a binned histogram series counted, and what it counted them from.
+type binnedHistogram struct {
+	values    *float64
+	valuesLen int
+	binCount  int
+	binEdges  []float64
+
+	edges, counts []float64
+}
+
+// GetName returns the name of the series.
+func (bhs *BinnedHistogramSeries) GetName() string {
+	return bhs.Name
+}
+
+// GetStyle returns the series style.
+func (bhs *BinnedHistogramSeries) GetStyle() Style {
+	return bhs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bhs *BinnedHistogramSeries) GetYAxis() YAxisType {
+	return bhs.YAxis
+}
+
+// GetBinCount returns the number of equal width bins.
+func (bhs *BinnedHistogramSeries) GetBinCount() int {
+	if bhs.BinCount == 0 {
+		return DefaultHistogramBinCount
+	}
+	return bhs.BinCount
+}
+
+// Len returns the number of bin edges, which is one more than the number of bins.
+func (bhs *BinnedHistogramSeries) Len() int {
+	edges, _ := bhs.GetBins()
+	return len(edges)
+}
+
+// GetBoundedValues returns the left edge and the count of a bin, bounded below by zero.
+// The final index is the right edge of the last bin, with a count of zero; indexes past it are all zero.
+func (bhs *BinnedHistogramSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	edges, counts := bhs.GetBins()
+	if index < 0 || index >= len(edges) {
+		return
+	}
+	x = edges[index]
+	if index < len(counts) {
+		y1 = counts[index]
+	}
+	return
+}
+
+// GetBins returns the bin edges and the count for each bin, counting the values into them if they've changed.
+// The slices are shared by every call until the bins are counted again, so they mustn't be changed.
+func (bhs *BinnedHistogramSeries) GetBins() (edges, counts []float64) {
+	bhs.lock.Lock()
+	defer bhs.lock.Unlock()
+
+	var values *float64
+	if len(bhs.Values) > 0 {
+		values = &bhs.Values[0]
+	}
+	if b := bhs.binned; b != nil && b.values == values && b.valuesLen == len(bhs.Values) &&
+		b.binCount == bhs.BinCount && floatsEqual(b.binEdges, bhs.BinEdges) {
+		return b.edges, b.counts
+	}
+	edges, counts = bhs.computeBins()
+	bhs.binned = &binnedHistogram{
+		values:    values,
+		valuesLen: len(bhs.Values),
+		binCount:  bhs.BinCount,
+		binEdges:  append([]float64(nil), bhs.BinEdges...),
+		edges:     edges,
+		counts:    counts,
+	}
+	return
+}
+
+// ResetBins forgets the bins counted, so they're counted again; e.g. after changing values in place.
+func (bhs *BinnedHistogramSeries) ResetBins() {
+	bhs.lock.Lock()
+	defer bhs.lock.Unlock()
+	bhs.binned = nil
+}
+
+// GetBounds returns the left edge of the first bin, the right edge of the last, zero and the greatest count.
+func (bhs *BinnedHistogramSeries) GetBounds() (minx, maxx, miny, maxy float64) {
+	edges, counts := bhs.GetBins()
+	minx, maxx = edges[0], edges[len(edges)-1]
+	for _, count := range counts {
+		maxy = math.Max(maxy, count)
+	}
+	return
+}
+
+// GetTicks returns a tick for each bin edge.
+func (bhs *BinnedHistogramSeries) GetTicks(vf ValueFormatter) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	edges, _ := bhs.GetBins()
+	ticks := make([]Tick, len(edges))
+	for index, edge := range edges {
+		ticks[index] = Tick{Value: edge, Label: vf(edge)}
+	}
+	return ticks
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (bhs *BinnedHistogramSeries) GetValueFormatters() (x, y ValueFormatter) {
+	x = FloatValueFormatter
+	y = IntValueFormatter
+	return
+}
+
+// Render renders the series.
+// Every bin is drawn, so empty bins are zero height bars rather than gaps.
+func (bhs *BinnedHistogramSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	edges, counts := bhs.GetBins()
+	style := bhs.Style.InheritFrom(defaults.InheritFrom(Style{
+		FillColor: defaults.StrokeColor,
+	}))
+
+	cb := canvasBox.Bottom
+	cl := canvasBox.Left
+	y0 := cb - yrange.Translate(0)
+	for index, count := range counts {
+		if bin, ok := clipBox(canvasBox, Box{
+			Top:    cb - yrange.Translate(count),
+			Left:   cl + xrange.Translate(edges[index]),
+			Right:  cl + xrange.Translate(edges[index+1]),
+			Bottom: y0,
+		}); ok {
+			Draw.Box(r, bin, style)
+		}
+	}
+}
+
+// Validate validates the series.
+func (bhs *BinnedHistogramSeries) Validate() error {
+	if len(bhs.BinEdges) == 1 {
+		return fmt.Errorf("binned histogram series requires at least (2) bin edges")
+	}
+	if !sort.Float64sAreSorted(bhs.BinEdges) {
+		return fmt.Errorf("binned histogram series requires bin edges to be sorted")
+	}
+	if bhs.BinCount < 0 {
+		return fmt.Errorf("binned histogram series requires a positive bin count")
+	}
+	return nil
+}
+
+// computeBins counts the values into bins; edges that aren't sorted are counted into as if they were.
+func (bhs *BinnedHistogramSeries) computeBins() (edges, counts []float64) {
+	edges = bhs.getEdges()
+	counts = make([]float64, len(edges)-1)
+
+	first, last := edges[0], edges[len(edges)-1]
+	for _, v := range bhs.Values {
+		if !isFinite(v) || v < first || v > last {
+			continue
+		}
+		// the bin is the last edge that is less than or equal to the value.
+		bin := sort.Search(len(edges), func(i int) bool { return edges[i] > v }) - 1
+		counts[MinInt(bin, len(counts)-1)]++
+	}
+	return
+}
+
+// floatsEqual returns if two slices have the same values; NaNs are equal to each other.
+func floatsEqual(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if a[index] != b[index] && !(math.IsNaN(a[index]) && math.IsNaN(b[index])) {
+			return false
+		}
+	}
+	return true
+}
+
+// getEdges returns a sorted copy of the finite bin edges, or the edges of equal width bins spanning the values
+// if there aren't at least two of them.
+func (bhs *BinnedHistogramSeries) getEdges() []float64 {
+	var edges []float64
+	for _, edge := range bhs.BinEdges {
+		if isFinite(edge) {
+			edges = append(edges, edge)
+		}
+	}
+	if len(edges) > 1 {
+		sort.Float64s(edges)
+		return edges
+	}
+
+	min, max := math.MaxFloat64, -math.MaxFloat64
+	for _, v := range bhs.Values {
+		if isFinite(v) {
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+		}
+	}
+	if min > max {
+		min, max = 0, 1
+	} else if min == max {
+		min, max = min-0.5, max+0.5
+	}
+
+	binCount := MaxInt(bhs.GetBinCount(), 1)
+	width := (max - min) / float64(binCount)
+	edges = make([]float64, binCount+1)
+	for index := range edges {
+		edges[index] = min + float64(index)*width
+	}
+	edges[binCount] = max
+	return edges
+}
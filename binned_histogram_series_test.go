@@ -0,0 +1,144 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestBinnedHistogramSeriesBinCount(t *testing.T) {
+	assert := assert.New(t)
+
+	bhs := &BinnedHistogramSeries{
+		Values:   []float64{0, 1, 1, 2, 9, 10, math.NaN()},
+		BinCount: 5,
+	}
+
+	edges, counts := bhs.GetBins()
+	assert.Equal([]float64{0, 2, 4, 6, 8, 10}, edges)
+	// empty bins are kept so the following bins stay in place.
+	assert.Equal([]float64{3, 1, 0, 0, 2}, counts)
+	assert.Equal(6, bhs.Len())
+
+	x, y1, y2 := bhs.GetBoundedValues(1)
+	assert.Equal(2.0, x)
+	assert.Equal(1.0, y1)
+	assert.Zero(y2)
+
+	x, y1, _ = bhs.GetBoundedValues(5)
+	assert.Equal(10.0, x)
+	assert.Zero(y1)
+}
+
+func TestBinnedHistogramSeriesBinEdges(t *testing.T) {
+	assert := assert.New(t)
+
+	bhs := &BinnedHistogramSeries{
+		Values:   []float64{-5, 0, 1, 5, 50, 100, 101},
+		BinEdges: []float64{0, 1, 10, 100},
+	}
+	_, counts := bhs.GetBins()
+	assert.Equal([]float64{1, 2, 2}, counts)
+
+	ticks := bhs.GetTicks(nil)
+	assert.Len(ticks, 4)
+	assert.Equal("10.00", ticks[2].Label)
+
+	// adding values recomputes the bins.
+	bhs.Values = append(bhs.Values, 2)
+	_, counts = bhs.GetBins()
+	assert.Equal([]float64{1, 3, 2}, counts)
+
+	// the bins are kept until the values change length, so changing them in place needs a reset.
+	first, _ := bhs.GetBins()
+	again, _ := bhs.GetBins()
+	assert.True(&first[0] == &again[0])
+	bhs.Values[0] = 0
+	_, counts = bhs.GetBins()
+	assert.Equal([]float64{1, 3, 2}, counts)
+	bhs.ResetBins()
+	_, counts = bhs.GetBins()
+	assert.Equal([]float64{2, 3, 2}, counts)
+
+	// changing the edges, in place or not, recounts them.
+	bhs.BinEdges[3] = 50
+	_, counts = bhs.GetBins()
+	assert.Equal([]float64{2, 3, 1}, counts)
+
+	// or the number of bins.
+	bhs.BinEdges = nil
+	bhs.BinCount = 2
+	edges, counts := bhs.GetBins()
+	assert.Len(edges, 3)
+	assert.Len(counts, 2)
+}
+
+func TestBinnedHistogramSeriesUnsortedBinEdges(t *testing.T) {
+	assert := assert.New(t)
+
+	// edges that aren't sorted are counted into as if they were, even though they don't validate.
+	bhs := &BinnedHistogramSeries{
+		Values:   []float64{0.5, 5, 50, 50},
+		BinEdges: []float64{100, 0, math.NaN(), 10, 1},
+	}
+	assert.NotNil(bhs.Validate())
+	edges, counts := bhs.GetBins()
+	assert.Equal([]float64{0, 1, 10, 100}, edges)
+	assert.Equal([]float64{1, 1, 2}, counts)
+	assert.Equal([]float64{100, 0}, bhs.BinEdges[:2])
+
+	minx, maxx, miny, maxy := bhs.GetBounds()
+	assert.Equal([]float64{0, 100, 0, 2}, []float64{minx, maxx, miny, maxy})
+
+	// indexes past the last edge are zero.
+	x, y1, _ := bhs.GetBoundedValues(4)
+	assert.Equal([]float64{0, 0}, []float64{x, y1})
+	x, y1, _ = bhs.GetBoundedValues(-1)
+	assert.Equal([]float64{0, 0}, []float64{x, y1})
+}
+
+func TestBinnedHistogramSeriesDegenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	bhs := &BinnedHistogramSeries{
+		Values:   []float64{3, 3, 3},
+		BinCount: 1,
+	}
+	edges, counts := bhs.GetBins()
+	assert.Equal([]float64{2.5, 3.5}, edges)
+	assert.Equal([]float64{3}, counts)
+}
+
+func TestBinnedHistogramSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil((&BinnedHistogramSeries{}).Validate())
+	assert.NotNil((&BinnedHistogramSeries{BinEdges: []float64{1}}).Validate())
+	assert.NotNil((&BinnedHistogramSeries{BinEdges: []float64{2, 1}}).Validate())
+	assert.NotNil((&BinnedHistogramSeries{BinCount: -1}).Validate())
+}
+
+func TestBinnedHistogramSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	bhs := &BinnedHistogramSeries{
+		Values:   []float64{1, 2, 2, 3, 3, 3, 8},
+		BinCount: 4,
+	}
+	c := Chart{
+		XAxis:  XAxis{Ticks: bhs.GetTicks(nil)},
+		Series: []Series{bhs},
+	}
+
+	xr, yr, _ := c.getRanges()
+	assert.Equal(1.0, xr.GetMin())
+	assert.Equal(8.0, xr.GetMax())
+	assert.Equal(0.0, yr.GetMin())
+	assert.Equal(3.0, yr.GetMax())
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
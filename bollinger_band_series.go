@@ -2,15 +2,21 @@ package chart
 
 import (
 	"fmt"
+	"sync"
 )
 
 // Interface Assertions.
 var (
-	_ Series = (*BollingerBandsSeries)(nil)
+	_ Series                    = (*BollingerBandsSeries)(nil)
+	_ BoundedValuesProvider     = (*BollingerBandsSeries)(nil)
+	_ BoundedLastValuesProvider = (*BollingerBandsSeries)(nil)
+	_ bandSeries                = (*BollingerBandsSeries)(nil)
 )
 
 // BollingerBandsSeries draws bollinger bands for an inner series.
 // Bollinger bands are defined by two lines, one at SMA+k*stddev, one at SMA-k*stdev.
+// The bands start at the first full window of the inner series, so they have Period-1 fewer values.
+// The outlines can be turned off by setting the style StrokeWidth to `Disabled`.
 type BollingerBandsSeries struct {
 	Name  string
 	Style Style
@@ -20,26 +26,29 @@ type BollingerBandsSeries struct {
 	K           float64
 	InnerSeries ValuesProvider
 
-	valueBuffer *ValueBuffer
+	lock     sync.Mutex
+	xvalues  []float64
+	y1values []float64
+	y2values []float64
 }
 
 // GetName returns the name of the time series.
-func (bbs BollingerBandsSeries) GetName() string {
+func (bbs *BollingerBandsSeries) GetName() string {
 	return bbs.Name
 }
 
 // GetStyle returns the line style.
-func (bbs BollingerBandsSeries) GetStyle() Style {
+func (bbs *BollingerBandsSeries) GetStyle() Style {
 	return bbs.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (bbs BollingerBandsSeries) GetYAxis() YAxisType {
+func (bbs *BollingerBandsSeries) GetYAxis() YAxisType {
 	return bbs.YAxis
 }
 
 // GetPeriod returns the window size.
-func (bbs BollingerBandsSeries) GetPeriod() int {
+func (bbs *BollingerBandsSeries) GetPeriod() int {
 	if bbs.Period == 0 {
 		return DefaultSimpleMovingAveragePeriod
 	}
@@ -49,7 +58,7 @@ func (bbs BollingerBandsSeries) GetPeriod() int {
 // GetK returns the K value, or the number of standard deviations above and below
 // to band the simple moving average with.
 // Typical K value is 2.0.
-func (bbs BollingerBandsSeries) GetK(defaults ...float64) float64 {
+func (bbs *BollingerBandsSeries) GetK(defaults ...float64) float64 {
 	if bbs.K == 0 {
 		if len(defaults) > 0 {
 			return defaults[0]
@@ -59,9 +68,12 @@ func (bbs BollingerBandsSeries) GetK(defaults ...float64) float64 {
 	return bbs.K
 }
 
-// Len returns the number of elements in the series.
-func (bbs BollingerBandsSeries) Len() int {
-	return bbs.InnerSeries.Len()
+// Len returns the number of elements in the series, one per full window of the inner series.
+func (bbs *BollingerBandsSeries) Len() int {
+	if bbs.InnerSeries == nil {
+		return 0
+	}
+	return MaxInt(0, bbs.InnerSeries.Len()-bbs.GetPeriod()+1)
 }
 
 // GetBoundedValues gets the bounded value for the series.
@@ -69,21 +81,13 @@ func (bbs *BollingerBandsSeries) GetBoundedValues(index int) (x, y1, y2 float64)
 	if bbs.InnerSeries == nil {
 		return
 	}
-	if bbs.valueBuffer == nil || index == 0 {
-		bbs.valueBuffer = NewValueBufferWithCapacity(bbs.GetPeriod())
-	}
-	if bbs.valueBuffer.Len() >= bbs.GetPeriod() {
-		bbs.valueBuffer.Dequeue()
+	xvalues, y1values, y2values := bbs.getCachedValues()
+	if index < 0 || index >= len(xvalues) {
+		return
 	}
-	px, py := bbs.InnerSeries.GetValues(index)
-	bbs.valueBuffer.Enqueue(py)
-	x = px
-
-	ay := Seq{bbs.valueBuffer}.Average()
-	std := Seq{bbs.valueBuffer}.StdDev()
-
-	y1 = ay + (bbs.GetK() * std)
-	y2 = ay - (bbs.GetK() * std)
+	x = xvalues[index]
+	y1 = y1values[index]
+	y2 = y2values[index]
 	return
 }
 
@@ -92,42 +96,69 @@ func (bbs *BollingerBandsSeries) GetBoundedLastValues() (x, y1, y2 float64) {
 	if bbs.InnerSeries == nil {
 		return
 	}
-	period := bbs.GetPeriod()
-	seriesLength := bbs.InnerSeries.Len()
-	startAt := seriesLength - period
-	if startAt < 0 {
-		startAt = 0
+	return bbs.GetBoundedValues(bbs.Len() - 1)
+}
+
+// getCachedValues returns the rolling bands, computed once, and again only if the length of the inner series changes.
+// The series is locked while they're computed, as charts rendered concurrently can share it.
+func (bbs *BollingerBandsSeries) getCachedValues() (xvalues, y1values, y2values []float64) {
+	bbs.lock.Lock()
+	defer bbs.lock.Unlock()
+
+	seriesLength := bbs.Len()
+	if bbs.xvalues != nil && len(bbs.xvalues) == seriesLength {
+		return bbs.xvalues, bbs.y1values, bbs.y2values
 	}
 
+	period := bbs.GetPeriod()
+	k := bbs.GetK()
+	xvalues = make([]float64, seriesLength)
+	y1values = make([]float64, seriesLength)
+	y2values = make([]float64, seriesLength)
+
 	vb := NewValueBufferWithCapacity(period)
-	for index := startAt; index < seriesLength; index++ {
-		xn, yn := bbs.InnerSeries.GetValues(index)
-		vb.Enqueue(yn)
-		x = xn
-	}
+	for index := 0; index < bbs.InnerSeries.Len(); index++ {
+		if vb.Len() >= period {
+			vb.Dequeue()
+		}
+		px, py := bbs.InnerSeries.GetValues(index)
+		vb.Enqueue(py)
 
-	ay := Seq{vb}.Average()
-	std := Seq{vb}.StdDev()
+		bandIndex := index - period + 1
+		if bandIndex < 0 {
+			continue
+		}
 
-	y1 = ay + (bbs.GetK() * std)
-	y2 = ay - (bbs.GetK() * std)
+		ay := Seq{vb}.Average()
+		std := Seq{vb}.StdDev()
 
+		xvalues[bandIndex] = px
+		y1values[bandIndex] = ay + (k * std)
+		y2values[bandIndex] = ay - (k * std)
+	}
+	bbs.xvalues, bbs.y1values, bbs.y2values = xvalues, y1values, y2values
 	return
 }
 
 // Render renders the series.
 func (bbs *BollingerBandsSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if bbs.Len() == 0 {
+		return
+	}
 	s := bbs.Style.InheritFrom(defaults.InheritFrom(Style{
 		StrokeWidth: 1.0,
 		StrokeColor: DefaultAxisColor.WithAlpha(64),
-		FillColor:   DefaultAxisColor.WithAlpha(32),
+		FillColor:   DefaultAxisColor.WithAlpha(DefaultBandAlpha),
 	}))
 
-	Draw.BoundedSeries(r, canvasBox, xrange, yrange, s, bbs, bbs.GetPeriod())
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, s, bbs)
 }
 
+// band marks the series as a band, which charts fill in the axis color of their theme.
+func (bbs *BollingerBandsSeries) band() {}
+
 // Validate validates the series.
-func (bbs BollingerBandsSeries) Validate() error {
+func (bbs *BollingerBandsSeries) Validate() error {
 	if bbs.InnerSeries == nil {
 		return fmt.Errorf("bollinger bands series requires InnerSeries to be set")
 	}
@@ -20,19 +20,72 @@ func TestBollingerBandSeries(t *testing.T) {
 		InnerSeries: s1,
 	}
 
-	xvalues := make([]float64, 100)
-	y1values := make([]float64, 100)
-	y2values := make([]float64, 100)
+	// the values before the first full window are dropped.
+	assert.Equal(100-bbs.GetPeriod()+1, bbs.Len())
 
-	for x := 0; x < 100; x++ {
+	xvalues := make([]float64, bbs.Len())
+	y1values := make([]float64, bbs.Len())
+	y2values := make([]float64, bbs.Len())
+
+	for x := 0; x < bbs.Len(); x++ {
 		xvalues[x], y1values[x], y2values[x] = bbs.GetBoundedValues(x)
 	}
 
-	for x := bbs.GetPeriod(); x < 100; x++ {
-		assert.True(y1values[x] > y2values[x], fmt.Sprintf("%v vs. %v", y1values[x], y2values[x]))
+	assert.Equal(float64(bbs.GetPeriod()), xvalues[0])
+	for x := 0; x < bbs.Len(); x++ {
+		assert.True(y1values[x] >= y2values[x], fmt.Sprintf("%v vs. %v", y1values[x], y2values[x]))
 	}
 }
 
+func TestBollingerBandSeriesValues(t *testing.T) {
+	assert := assert.New(t)
+
+	bbs := &BollingerBandsSeries{
+		InnerSeries: mockValuesProvider{
+			X: []float64{1, 2, 3, 4, 5},
+			Y: []float64{2, 4, 4, 4, 5},
+		},
+		Period: 4,
+		K:      2,
+	}
+	assert.Equal(2, bbs.Len())
+
+	// the windows are [2,4,4,4] with mean 3.5 and stddev sqrt(0.75),
+	// and [4,4,4,5] with mean 4.25 and stddev sqrt(0.1875).
+	// reading them out of order exercises the memoized values.
+	x, y1, y2 := bbs.GetBoundedValues(1)
+	assert.Equal(5.0, x)
+	assert.InDelta(5.1160254, y1, 0.0000001)
+	assert.InDelta(3.3839746, y2, 0.0000001)
+
+	x, y1, y2 = bbs.GetBoundedValues(0)
+	assert.Equal(4.0, x)
+	assert.InDelta(5.2320508, y1, 0.0000001)
+	assert.InDelta(1.7679492, y2, 0.0000001)
+
+	lx, ly1, ly2 := bbs.GetBoundedLastValues()
+	assert.Equal(5.0, lx)
+	assert.InDelta(5.1160254, ly1, 0.0000001)
+	assert.InDelta(3.3839746, ly2, 0.0000001)
+}
+
+func TestBollingerBandSeriesShortInner(t *testing.T) {
+	assert := assert.New(t)
+
+	bbs := &BollingerBandsSeries{
+		InnerSeries: mockValuesProvider{
+			X: []float64{1, 2},
+			Y: []float64{1, 2},
+		},
+		Period: 4,
+	}
+	assert.Zero(bbs.Len())
+	x, y1, y2 := bbs.GetBoundedLastValues()
+	assert.Zero(x)
+	assert.Zero(y1)
+	assert.Zero(y2)
+}
+
 func TestBollingerBandLastValue(t *testing.T) {
 	assert := assert.New(t)
 
@@ -32,5 +32,6 @@ func BoundedLastValuesAnnotationSeries(innerSeries FullBoundedValuesProvider, vf
 			{XValue: lvx, YValue: lvy1, Label: label1},
 			{XValue: lvx, YValue: lvy2, Label: label2},
 		},
+		AllowOverflow: true,
 	}
 }
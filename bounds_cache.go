@@ -0,0 +1,45 @@
+package chart
+
+import "sync"
+
+// boundsCache remembers the bounds of the values of a series, so they're only scanned again when the values change.
+// The values are taken to have changed when either slice is replaced or changes length; changes made in place
+// need a call to `reset`.
+// A nil cache scans the values every time.
+type boundsCache struct {
+	lock                   sync.Mutex
+	valid                  bool
+	key                    boundsCacheKey
+	minx, maxx, miny, maxy float64
+}
+
+// boundsCacheKey identifies the values a series had when its bounds were cached,
+// by the address of the first element and the length of each slice.
+type boundsCacheKey struct {
+	x, y       interface{}
+	xlen, ylen int
+}
+
+// get returns the cached bounds for the key, or scans for them if the values have changed.
+func (bc *boundsCache) get(key boundsCacheKey, scan func() (minx, maxx, miny, maxy float64)) (minx, maxx, miny, maxy float64) {
+	if bc == nil {
+		return scan()
+	}
+	bc.lock.Lock()
+	defer bc.lock.Unlock()
+	if !bc.valid || bc.key != key {
+		bc.minx, bc.maxx, bc.miny, bc.maxy = scan()
+		bc.valid, bc.key = true, key
+	}
+	return bc.minx, bc.maxx, bc.miny, bc.maxy
+}
+
+// reset forgets the cached bounds.
+func (bc *boundsCache) reset() {
+	if bc == nil {
+		return
+	}
+	bc.lock.Lock()
+	defer bc.lock.Unlock()
+	bc.valid = false
+}
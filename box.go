@@ -99,8 +99,7 @@ func (b Box) Height() int {
 
 // Center returns the center of the box
 func (b Box) Center() (x, y int) {
-	w2, h2 := b.Width()>>1, b.Height()>>1
-	return b.Left + w2, b.Top + h2
+	return (b.Left + b.Right) >> 1, (b.Top + b.Bottom) >> 1
 }
 
 // Aspect returns the aspect ratio of the box.
@@ -153,6 +152,36 @@ func (b Box) Grow(other Box) Box {
 	}
 }
 
+// Expand moves every side of a box out by n.
+func (b Box) Expand(n int) Box {
+	return b.Shrink(-n)
+}
+
+// Shrink moves every side of a box in by n.
+// A box smaller than that collapses to its center instead of turning inside out.
+func (b Box) Shrink(n int) Box {
+	return b.Inset(Box{Top: n, Left: n, Right: n, Bottom: n})
+}
+
+// Inset moves each side of a box in by the matching side of the padding, e.g. to get the content box of a padded box.
+// A box smaller than the padding collapses to its center instead of turning inside out.
+func (b Box) Inset(padding Box) Box {
+	inset := Box{
+		Top:    b.Top + padding.Top,
+		Left:   b.Left + padding.Left,
+		Right:  b.Right - padding.Right,
+		Bottom: b.Bottom - padding.Bottom,
+	}
+	x, y := b.Center()
+	if inset.Right < inset.Left {
+		inset.Left, inset.Right = x, x
+	}
+	if inset.Bottom < inset.Top {
+		inset.Top, inset.Bottom = y, y
+	}
+	return inset
+}
+
 // Shift pushes a box by x,y.
 func (b Box) Shift(x, y int) Box {
 	return Box{
@@ -163,6 +192,12 @@ func (b Box) Shift(x, y int) Box {
 	}
 }
 
+// Intersects returns if the box overlaps another box.
+func (b Box) Intersects(other Box) bool {
+	return b.Left < other.Right && other.Left < b.Right &&
+		b.Top < other.Bottom && other.Top < b.Bottom
+}
+
 // Corners returns the box as a set of corners.
 func (b Box) Corners() BoxCorners {
 	return BoxCorners{
@@ -176,7 +211,11 @@ func (b Box) Corners() BoxCorners {
 // Fit is functionally the inverse of grow.
 // Fit maintains the original aspect ratio of the `other` box,
 // but constrains it to the bounds of the target box.
+// A box without area, or an `other` box without an aspect ratio, is returned as is.
 func (b Box) Fit(other Box) Box {
+	if b.Width() == 0 || b.Height() == 0 || other.Width() == 0 || other.Height() == 0 {
+		return b.Clone()
+	}
 	ba := b.Aspect()
 	oa := other.Aspect()
 
@@ -349,3 +388,8 @@ func (p Point) Equals(other Point) bool {
 func (p Point) String() string {
 	return fmt.Sprintf("P{%d,%d}", p.X, p.Y)
 }
+
+// floatPoint is an X,Y pair at a fractional position, i.e. between pixels.
+type floatPoint struct {
+	X, Y float64
+}
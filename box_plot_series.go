@@ -0,0 +1,176 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*BoxPlotSeries)(nil)
+	_ BoxPlotValuesProvider = (*BoxPlotSeries)(nil)
+	_ BoundedValuesProvider = (*BoxPlotSeries)(nil)
+	_ ValuesProvider        = (*BoxPlotSeries)(nil)
+)
+
+// BoxPlotSeries draws a box and whiskers per x value from the five number summary of a distribution.
+// The y range spans the whiskers and any outliers; as a values provider it yields the median.
+type BoxPlotSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// MedianStyle is applied on top of Style to the median line.
+	MedianStyle Style
+
+	// BoxWidth is the box width in pixels.
+	BoxWidth int
+	// BoxWidthX is the box width in x units, used if BoxWidth is not set.
+	// If neither is set the width is a fraction of the smallest spacing between the x values.
+	BoxWidthX float64
+
+	XValueFormatter ValueFormatter
+	YValueFormatter ValueFormatter
+
+	XValues       []float64
+	Min           []float64
+	LowerQuartile []float64
+	Median        []float64
+	UpperQuartile []float64
+	Max           []float64
+
+	// Outliers are optional values beyond the whiskers, drawn as dots, indexed like the x values.
+	Outliers [][]float64
+}
+
+// GetName returns the name of the series.
+func (bps BoxPlotSeries) GetName() string {
+	return bps.Name
+}
+
+// GetStyle returns the series style.
+func (bps BoxPlotSeries) GetStyle() Style {
+	return bps.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bps BoxPlotSeries) GetYAxis() YAxisType {
+	return bps.YAxis
+}
+
+// Len returns the number of elements in the series.
+// If the value slices differ in length, the shortest is used.
+func (bps BoxPlotSeries) Len() int {
+	return MinInt(
+		MinInt(len(bps.XValues), MinInt(len(bps.Min), len(bps.LowerQuartile))),
+		MinInt(len(bps.Median), MinInt(len(bps.UpperQuartile), len(bps.Max))),
+	)
+}
+
+// GetBoxPlotValues gets the x value and five number summary at a given index.
+// It returns zero values if the index is out of bounds.
+func (bps BoxPlotSeries) GetBoxPlotValues(index int) (x, min, lowerQuartile, median, upperQuartile, max float64) {
+	if index < 0 || index >= bps.Len() {
+		return
+	}
+	return bps.XValues[index], bps.Min[index], bps.LowerQuartile[index], bps.Median[index], bps.UpperQuartile[index], bps.Max[index]
+}
+
+// GetBoundedValues gets the x value and the extent of the whiskers and outliers at a given index.
+func (bps BoxPlotSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x, y2, _, _, _, y1 = bps.GetBoxPlotValues(index)
+	if index < len(bps.Outliers) {
+		for _, outlier := range bps.Outliers[index] {
+			if isFinite(outlier) {
+				y1 = math.Max(y1, outlier)
+				y2 = math.Min(y2, outlier)
+			}
+		}
+	}
+	return
+}
+
+// GetValues gets the x and median values at a given index.
+func (bps BoxPlotSeries) GetValues(index int) (x, y float64) {
+	x, _, _, y, _, _ = bps.GetBoxPlotValues(index)
+	return
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (bps BoxPlotSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if bps.XValueFormatter != nil {
+		x = bps.XValueFormatter
+	} else {
+		x = FloatValueFormatter
+	}
+	if bps.YValueFormatter != nil {
+		y = bps.YValueFormatter
+	} else {
+		y = defaultValueFormatter
+	}
+	return
+}
+
+// Render renders the series.
+func (bps BoxPlotSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := bps.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: DefaultAxisLineWidth,
+	}))
+	if style.FillColor.IsZero() {
+		style.FillColor = style.GetStrokeColor().WithAlpha(64)
+	}
+	medianStyle := bps.MedianStyle.InheritFrom(style)
+	medianStyle.StrokeWidth = bps.MedianStyle.GetStrokeWidth(style.GetStrokeWidth() * 2)
+
+	Draw.BoxPlotSeries(r, canvasBox, xrange, yrange, style, medianStyle, bps, bps.getBoxWidth(xrange))
+	bps.drawOutliers(r, canvasBox, xrange, yrange, style)
+}
+
+// Validate validates the series.
+func (bps BoxPlotSeries) Validate() error {
+	count := len(bps.XValues)
+	if count == 0 {
+		return fmt.Errorf("box plot series; must have xvalues set")
+	}
+	if len(bps.Min) != count || len(bps.LowerQuartile) != count || len(bps.Median) != count || len(bps.UpperQuartile) != count || len(bps.Max) != count {
+		return fmt.Errorf("box plot series; must have the same number of min, quartile, median and max values as xvalues")
+	}
+	for index := 0; index < count; index++ {
+		_, min, q1, median, q3, max := bps.GetBoxPlotValues(index)
+		if min > q1 || q1 > median || median > q3 || q3 > max {
+			return fmt.Errorf("box plot series; value %d must have min <= lower quartile <= median <= upper quartile <= max", index)
+		}
+	}
+	if len(bps.Outliers) > count {
+		return fmt.Errorf("box plot series; must not have more outlier sets than xvalues")
+	}
+	return nil
+}
+
+// getBoxWidth returns the box width in pixels.
+func (bps BoxPlotSeries) getBoxWidth(xrange Range) int {
+	if bps.BoxWidth > 0 {
+		return bps.BoxWidth
+	}
+	if bps.BoxWidthX > 0 {
+		return MaxInt(AbsInt(xrange.Translate(xrange.GetMin()+bps.BoxWidthX)-xrange.Translate(xrange.GetMin())), 1)
+	}
+	return Draw.bodyWidth(xrange, bps)
+}
+
+func (bps BoxPlotSeries) drawOutliers(r Renderer, canvasBox Box, xrange, yrange Range, style Style) {
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	for index := 0; index < len(bps.Outliers) && index < bps.Len(); index++ {
+		vx, _ := bps.GetValues(index)
+		x := canvasBox.Left + xrange.Translate(vx)
+		for _, outlier := range bps.Outliers[index] {
+			if !isFinite(outlier) {
+				continue
+			}
+			r.Circle(DefaultBoxPlotOutlierRadius, x, canvasBox.Bottom-yrange.Translate(outlier))
+			r.FillStroke()
+		}
+	}
+}
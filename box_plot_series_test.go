@@ -0,0 +1,112 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func testBoxPlotSeries() BoxPlotSeries {
+	return BoxPlotSeries{
+		Name:          "Latency",
+		XValues:       []float64{1, 2, 3},
+		Min:           []float64{2, 3, 1},
+		LowerQuartile: []float64{4, 5, 3},
+		Median:        []float64{5, 6, 4},
+		UpperQuartile: []float64{7, 8, 6},
+		Max:           []float64{9, 12, 8},
+	}
+}
+
+func TestBoxPlotSeriesValues(t *testing.T) {
+	assert := assert.New(t)
+
+	bps := testBoxPlotSeries()
+	assert.Equal(3, bps.Len())
+
+	x, min, q1, median, q3, max := bps.GetBoxPlotValues(1)
+	assert.Equal(2.0, x)
+	assert.Equal(3.0, min)
+	assert.Equal(5.0, q1)
+	assert.Equal(6.0, median)
+	assert.Equal(8.0, q3)
+	assert.Equal(12.0, max)
+
+	x, y := bps.GetValues(2)
+	assert.Equal(3.0, x)
+	assert.Equal(4.0, y)
+
+	x, y1, y2 := bps.GetBoundedValues(0)
+	assert.Equal(1.0, x)
+	assert.Equal(9.0, y1)
+	assert.Equal(2.0, y2)
+}
+
+func TestBoxPlotSeriesRangeSpansOutliers(t *testing.T) {
+	assert := assert.New(t)
+
+	bps := testBoxPlotSeries()
+	bps.Outliers = [][]float64{{20}, nil, {-1, 0.5}}
+
+	c := Chart{
+		Series: []Series{bps},
+	}
+	_, yr, _ := c.getRanges()
+	assert.Equal(-1.0, yr.GetMin())
+	assert.Equal(20.0, yr.GetMax())
+}
+
+func TestBoxPlotSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(testBoxPlotSeries().Validate())
+	assert.NotNil(BoxPlotSeries{}.Validate())
+
+	short := testBoxPlotSeries()
+	short.Median = short.Median[:1]
+	assert.NotNil(short.Validate())
+
+	unordered := testBoxPlotSeries()
+	unordered.Median[0] = 8
+	assert.NotNil(unordered.Validate())
+
+	outliers := testBoxPlotSeries()
+	outliers.Outliers = make([][]float64, 4)
+	assert.NotNil(outliers.Validate())
+}
+
+func TestBoxPlotSeriesGetBoxWidth(t *testing.T) {
+	assert := assert.New(t)
+
+	xr := &ContinuousRange{Min: 1, Max: 3, Domain: 100}
+
+	bps := testBoxPlotSeries()
+	assert.Equal(35, bps.getBoxWidth(xr))
+
+	bps.BoxWidthX = 0.5
+	assert.Equal(25, bps.getBoxWidth(xr))
+
+	bps.BoxWidth = 12
+	assert.Equal(12, bps.getBoxWidth(xr))
+}
+
+func TestBoxPlotSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	bps := testBoxPlotSeries()
+	bps.Style = Style{StrokeColor: ColorBlue}
+	bps.Outliers = [][]float64{{15}}
+
+	c := Chart{
+		Series: []Series{bps},
+	}
+
+	buf := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buf))
+	svg := buf.String()
+	assert.True(strings.Contains(svg, ColorBlue.Hex()))
+	assert.True(strings.Contains(svg, "fill:"+ColorBlue.Hex()+";fill-opacity:0.251"))
+	assert.Equal(1, strings.Count(svg, "<circle"))
+}
@@ -158,6 +158,56 @@ func TestBoxCenter(t *testing.T) {
 	assert.Equal(20, cy)
 }
 
+func TestBoxCenterInverted(t *testing.T) {
+	assert := assert.New(t)
+
+	// an inside out box still has its center between its sides.
+	cx, cy := Box{Top: 30, Left: 20, Right: 10, Bottom: 10}.Center()
+	assert.Equal(15, cx)
+	assert.Equal(20, cy)
+
+	cx, cy = Box{Top: -10, Left: -20, Right: -10, Bottom: 10}.Center()
+	assert.Equal(-15, cx)
+	assert.Equal(0, cy)
+}
+
+func TestBoxShrinkExpand(t *testing.T) {
+	assert := assert.New(t)
+
+	b := Box{Top: 10, Left: 10, Right: 50, Bottom: 30}
+	assert.Equal(Box{Top: 15, Left: 15, Right: 45, Bottom: 25}, b.Shrink(5))
+	assert.Equal(Box{Top: 5, Left: 5, Right: 55, Bottom: 35}, b.Expand(5))
+	assert.Equal(b, b.Shrink(5).Expand(5))
+
+	// too small boxes collapse to their center.
+	assert.Equal(Box{Top: 20, Left: 21, Right: 39, Bottom: 20}, b.Shrink(11))
+	assert.Equal(Box{Top: 20, Left: 30, Right: 30, Bottom: 20}, b.Shrink(100))
+	assert.Zero(b.Shrink(100).Width())
+}
+
+func TestBoxInset(t *testing.T) {
+	assert := assert.New(t)
+
+	b := Box{Right: 100, Bottom: 50}
+	assert.Equal(Box{Top: 1, Left: 2, Right: 97, Bottom: 46}, b.Inset(Box{Top: 1, Left: 2, Right: 3, Bottom: 4}))
+	assert.Equal(b, b.Inset(Box{}))
+
+	// a padding bigger than the box, as on a tiny chart, doesn't turn the box inside out.
+	tiny := Box{Right: 10, Bottom: 10}.Inset(DefaultBackgroundPadding)
+	assert.Equal(Box{Top: 5, Left: 5, Right: 5, Bottom: 5}, tiny)
+}
+
+func TestBoxFitDegenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	a := Box{Top: 10, Left: 10, Right: 110, Bottom: 60}
+	assert.Equal(a, a.Fit(Box{}))
+	assert.Equal(a, a.Fit(Box{Right: 10}))
+
+	flat := Box{Top: 10, Left: 10, Right: 110, Bottom: 10}
+	assert.Equal(flat, flat.Fit(Box{Right: 10, Bottom: 10}))
+}
+
 func TestBoxCornersCenter(t *testing.T) {
 	assert := assert.New(t)
 
@@ -186,3 +236,13 @@ func TestBoxCornersRotate(t *testing.T) {
 	rotated := bc.Rotate(45)
 	assert.True(rotated.TopLeft.Equals(Point{10, 3}), rotated.String())
 }
+
+func TestBoxIntersects(t *testing.T) {
+	assert := assert.New(t)
+
+	b := Box{Top: 10, Left: 10, Right: 20, Bottom: 20}
+	assert.True(b.Intersects(Box{Top: 15, Left: 15, Right: 25, Bottom: 25}))
+	assert.True(b.Intersects(Box{Top: 0, Left: 0, Right: 30, Bottom: 30}))
+	assert.False(b.Intersects(Box{Top: 20, Left: 10, Right: 20, Bottom: 30}))
+	assert.False(b.Intersects(Box{Top: 10, Left: 25, Right: 30, Bottom: 20}))
+}
@@ -3,8 +3,10 @@ package chart
 import (
 	"errors"
 	"fmt"
+	"image"
 	"io"
 	"math"
+	"strings"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -14,14 +16,29 @@ type Chart struct {
 	Title      string
 	TitleStyle Style
 
+	// Subtitle is drawn beneath the title in a smaller font.
+	Subtitle      string
+	SubtitleStyle Style
+
 	ColorPalette ColorPalette
+	// Theme sets the colors, font sizes and padding the chart falls back to; it defaults to `GetDefaultTheme()`.
+	// A ColorPalette takes precedence over the theme colors.
+	Theme *Theme
 
 	Width  int
 	Height int
 	DPI    float64
+	// ScaleFactor draws raster images at a multiple of the width and height, e.g. 2 for high resolution displays,
+	// with everything in proportion; the layout is in the units of the width and height either way.
+	// Vector images are the width and height. It defaults to 1.
+	ScaleFactor float64
 
+	// Background is the style of the whole chart; a transparent fill leaves the background out,
+	// e.g. so the chart can be composited onto a page; make the canvas fill transparent too to see through it.
 	Background Style
-	Canvas     Style
+	// BackgroundImage is drawn over the background fill, scaled to the size of the chart, before everything else.
+	BackgroundImage image.Image
+	Canvas          Style
 
 	XAxis          XAxis
 	YAxis          YAxis
@@ -29,10 +46,27 @@ type Chart struct {
 
 	Font        *truetype.Font
 	defaultFont *truetype.Font
+	// canvasEdges overrides the left and right of the canvas, so charts drawn together line up; see `SubPlots`.
+	canvasEdges *Box
 
 	Series   []Series
 	Elements []Renderable
 
+	// XBands are shaded intervals of the x axis drawn under the grid lines and series.
+	XBands []Band
+	// YBands are shaded intervals of the primary y axis drawn under the grid lines and series.
+	YBands []Band
+	// XMarkers are vertical reference lines drawn over the grid lines.
+	XMarkers []XMarker
+	// YMarkers are horizontal reference lines drawn over the series.
+	YMarkers []YMarker
+	// Frame is a border around the canvas drawn over the series.
+	Frame Frame
+	// Watermark is faint text across the canvas drawn beneath the series.
+	Watermark Watermark
+	// Images are raster images, e.g. logos, drawn under the series or, if they're above them, over the series.
+	Images []ImageElement
+
 	Log Logger
 }
 
@@ -47,6 +81,14 @@ func (c Chart) GetDPI(defaults ...float64) float64 {
 	return c.DPI
 }
 
+// GetScaleFactor returns the scale factor or the default value.
+func (c Chart) GetScaleFactor() float64 {
+	if c.ScaleFactor == 0 {
+		return 1
+	}
+	return c.ScaleFactor
+}
+
 // GetFont returns the text font.
 func (c Chart) GetFont() *truetype.Font {
 	if c.Font == nil {
@@ -55,6 +97,64 @@ func (c Chart) GetFont() *truetype.Font {
 	return c.Font
 }
 
+// needsDefaultFont returns if any text the chart draws falls back to the default font, i.e. has no font
+// in its style and the chart has none either; the default font is only loaded if it does.
+// Elements, like legends, draw text with the chart font, so they always need one.
+func (c Chart) needsDefaultFont() bool {
+	if c.Font != nil {
+		return false
+	}
+	if len(c.Elements) > 0 {
+		return true
+	}
+	hasFont := func(styles ...Style) bool {
+		for _, s := range styles {
+			if s.GetFont() != nil {
+				return true
+			}
+		}
+		return false
+	}
+	hasText := func(text string, style Style) bool {
+		return len(text) > 0 && !style.Hidden
+	}
+
+	if hasText(c.Title, c.TitleStyle) && !hasFont(c.TitleStyle) {
+		return true
+	}
+	if hasText(c.Subtitle, c.SubtitleStyle) && !hasFont(c.SubtitleStyle, c.TitleStyle) {
+		return true
+	}
+	axisNeedsFont := func(shown bool, style, tickStyle Style, name string, nameStyle Style) bool {
+		return shown && (!hasFont(tickStyle, style) || (hasText(name, nameStyle) && !hasFont(nameStyle)))
+	}
+	if axisNeedsFont(!c.XAxis.Style.Hidden, c.XAxis.Style, c.XAxis.TickStyle, c.XAxis.Name, c.XAxis.NameStyle) ||
+		axisNeedsFont(!c.YAxis.Style.Hidden, c.YAxis.Style, c.YAxis.TickStyle, c.YAxis.Name, c.YAxis.NameStyle) ||
+		axisNeedsFont(c.hasSecondaryAxis(), c.YAxisSecondary.Style, c.YAxisSecondary.TickStyle, c.YAxisSecondary.Name, c.YAxisSecondary.NameStyle) {
+		return true
+	}
+	for _, s := range c.Series {
+		style := s.GetStyle()
+		if style.Hidden || hasFont(style) {
+			continue
+		}
+		if _, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries || style.ShowValues {
+			return true
+		}
+	}
+	for _, xm := range c.XMarkers {
+		if hasText(xm.Label, xm.Style) && !hasFont(xm.Style) {
+			return true
+		}
+	}
+	for _, ym := range c.YMarkers {
+		if hasText(ym.Label, ym.Style) && !hasFont(ym.Style) {
+			return true
+		}
+	}
+	return hasText(c.Watermark.Text, c.Watermark.Style) && !hasFont(c.Watermark.Style)
+}
+
 // GetWidth returns the chart width or the default value.
 func (c Chart) GetWidth() int {
 	if c.Width == 0 {
@@ -71,23 +171,98 @@ func (c Chart) GetHeight() int {
 	return c.Height
 }
 
+// RenderImage renders the chart with a raster renderer provider, like PNG, and returns the image
+// without encoding it.
+func (c Chart) RenderImage(rp RendererProvider) (image.Image, error) {
+	return renderImage(rp, c.Render)
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
+// The chart is drawn in the same order every time: the background, canvas, watermark, images beneath the series,
+// bands, axes, x markers beneath the series, series by index, x markers and images above the series, frame,
+// y markers, annotations, title and then the elements.
+//
+// Rendering doesn't change the chart; the ranges are laid out as copies, and the series in this package that work out
+// their values on first use, like `EMASeries`, lock while they do. So a chart can be rendered from many goroutines at once,
+// each with a renderer of its own, as long as nothing changes it meanwhile; the methods that do, like `Chart.SetRollingXRange`,
+// say so. Series and elements of your own need to be safe to read concurrently too. `RollingSeries` can be pushed to while it renders.
 func (c Chart) Render(rp RendererProvider, w io.Writer) error {
-	if len(c.Series) == 0 {
-		return errors.New("please provide at least one series")
+	if err := c.Validate(); err != nil {
+		return err
 	}
-	if err := c.checkHasVisibleSeries(); err != nil {
+
+	r, err := rp(c.GetWidth(), c.GetHeight())
+	if err != nil {
 		return err
 	}
+	if typed, isTyped := r.(ScaledRenderer); isTyped && c.GetScaleFactor() != 1 {
+		typed.SetScale(c.GetScaleFactor())
+	}
+	return c.renderTo(r, w)
+}
 
-	c.YAxisSecondary.AxisType = YAxisSecondary
+// renderTo draws the chart with a renderer, and saves it to the given io.Writer.
+// The renderer must be at least the size of the chart; it can be a part of a bigger image, like a cell of a `Grid`.
+func (c Chart) renderTo(r Renderer, w io.Writer) error {
+	if err := c.prepare(r); err != nil {
+		return err
+	}
 
-	r, err := rp(c.GetWidth(), c.GetHeight())
+	drawGroup(r, func() { c.drawBackground(r) }, "chart-background")
+
+	canvasBox, xr, yr, yra, xt, yt, yta, err := c.layout(r)
 	if err != nil {
+		r.Save(w)
 		return err
 	}
 
-	if c.Font == nil {
+	drawGroup(r, func() { c.drawCanvas(r, canvasBox) }, "chart-canvas")
+	if len(c.Watermark.Text) > 0 {
+		drawGroup(r, func() { c.drawWatermark(r, canvasBox) }, "watermark")
+	}
+	c.drawImages(r, false)
+	if len(c.XBands) > 0 || len(c.YBands) > 0 {
+		drawGroup(r, func() { c.drawBands(r, canvasBox, xr, yr) }, "bands")
+	}
+	c.drawAxes(r, canvasBox, xr, yr, yra, xt, yt, yta)
+	c.drawXMarkers(r, canvasBox, xr, false)
+	// annotations are laid out across all series before they're drawn
+	// so labels from different series don't overlap.
+	var annotations []annotationPlacement
+	for index, series := range c.Series {
+		if as, isAnnotationSeries := series.(AnnotationSeries); isAnnotationSeries {
+			annotations = append(annotations, c.getAnnotationPlacements(r, canvasBox, xr, yr, yra, as, index)...)
+			continue
+		}
+		c.drawSeries(r, canvasBox, xr, yr, yra, series, index)
+	}
+	c.drawXMarkers(r, canvasBox, xr, true)
+	c.drawImages(r, true)
+	if c.Frame.Show {
+		drawGroup(r, func() { c.drawFrame(r, canvasBox, xr, yr, xt, yt) }, "frame")
+	}
+	resolveAnnotationOverlaps(annotations, canvasBox)
+	c.drawYMarkers(r, canvasBox, yr, yra, annotations)
+	if len(annotations) > 0 {
+		drawGroup(r, func() { c.drawAnnotations(r, canvasBox, annotations) }, "annotations")
+	}
+
+	if len(c.Title) > 0 || len(c.Subtitle) > 0 {
+		drawGroup(r, func() { c.drawTitle(r) }, "title")
+	}
+
+	for index, a := range c.Elements {
+		drawGroup(r, func() { a(r, canvasBox, c.styleDefaultsElements()) }, fmt.Sprintf("element-%d", index), "element")
+	}
+
+	return r.Save(w)
+}
+
+// prepare sets up the chart and renderer to lay out and draw the chart.
+func (c *Chart) prepare(r Renderer) error {
+	c.YAxisSecondary.AxisType = YAxisSecondary
+
+	if c.needsDefaultFont() {
 		defaultFont, err := GetDefaultFont()
 		if err != nil {
 			return err
@@ -95,22 +270,22 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 		c.defaultFont = defaultFont
 	}
 	r.SetDPI(c.GetDPI(DefaultDPI))
+	return nil
+}
 
-	c.drawBackground(r)
-
-	var xt, yt, yta []Tick
-	xr, yr, yra := c.getRanges()
-	canvasBox := c.getDefaultCanvasBox()
+// layout returns the box the chart draws its series in, i.e. the canvas, and the ranges and ticks fit to it.
+// The canvas is what's left of the chart box after the title, axes and annotations take the space they need.
+func (c Chart) layout(r Renderer) (canvasBox Box, xr, yr, yra Range, xt, yt, yta []Tick, err error) {
+	xr, yr, yra = c.getRanges()
+	canvasBox = c.getTitleAdjustedCanvasBox(r, c.getDefaultCanvasBox())
 	xf, yf, yfa := c.getValueFormatters()
 
 	Debugf(c.Log, "chart; canvas box: %v", canvasBox)
 
 	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
 
-	err = c.checkRanges(xr, yr, yra)
-	if err != nil {
-		r.Save(w)
-		return err
+	if err = c.checkRanges(xr, yr, yra); err != nil {
+		return
 	}
 
 	if c.hasAxes() {
@@ -134,19 +309,105 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 		Debugf(c.Log, "chart; annotation adjusted canvas box: %v", canvasBox)
 	}
 
-	c.drawCanvas(r, canvasBox)
-	c.drawAxes(r, canvasBox, xr, yr, yra, xt, yt, yta)
-	for index, series := range c.Series {
-		c.drawSeries(r, canvasBox, xr, yr, yra, series, index)
+	if c.canvasEdges != nil {
+		canvasBox.Left, canvasBox.Right = c.canvasEdges.Left, c.canvasEdges.Right
+		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+
+		Debugf(c.Log, "chart; canvas edges set: %v", canvasBox)
 	}
 
-	c.drawTitle(r)
+	if c.hasAxes() && !c.XAxis.Style.Hidden {
+		// the canvas makes room for the x axis labels, but the ticks can still move after, e.g. with annotations
+		// or shared canvas edges, so labels at the ends that would cross the sides of the chart are left out.
+		xt = c.XAxis.dropOverflowingLabels(r, Box{Right: c.GetWidth(), Bottom: c.GetHeight()}, canvasBox, xr, c.styleDefaultsAxes(), xt)
+	}
+	return
+}
 
-	for _, a := range c.Elements {
-		a(r, canvasBox, c.styleDefaultsElements())
+// Validate checks the chart can be rendered, and returns an error describing every problem it finds.
+// A zero width or height is valid and uses `DefaultChartWidth` or `DefaultChartHeight`.
+// Each visible series is checked with its own `Validate`, except series without any finite values:
+// they draw nothing and are left out of the ranges, so they're only logged, as they were before charts were validated.
+func (c Chart) Validate() error {
+	var errs errorList
+	if c.Width < 0 {
+		errs = append(errs, fmt.Errorf("chart; width must not be negative, got %d", c.Width))
+	}
+	if c.Height < 0 {
+		errs = append(errs, fmt.Errorf("chart; height must not be negative, got %d", c.Height))
+	}
+	if c.ScaleFactor < 0 {
+		errs = append(errs, fmt.Errorf("chart; scale factor must not be negative, got %v", c.ScaleFactor))
 	}
 
-	return r.Save(w)
+	if len(c.Series) == 0 {
+		errs = append(errs, errors.New("please provide at least one series"))
+	}
+	for index, s := range c.Series {
+		if s == nil {
+			errs = append(errs, fmt.Errorf("chart; series %d is nil", index))
+			continue
+		}
+		if s.GetStyle().Hidden {
+			continue
+		}
+		if _, isValuesProvider := s.(ValuesProvider); isValuesProvider && !hasFiniteValues(s) {
+			Infof(c.Log, "chart; series %d (%q) has no finite values and isn't drawn", index, s.GetName())
+			continue
+		}
+		if err := s.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("chart; series %d (%q); %v", index, s.GetName(), err))
+		}
+	}
+	if len(c.Series) > 0 && len(errs) == 0 {
+		if err := c.checkHasVisibleSeries(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for index, ie := range c.Images {
+		if ie.Image == nil {
+			errs = append(errs, fmt.Errorf("chart; image %d has no image", index))
+		}
+	}
+
+	errs = append(errs, validateAxisRange("x axis", c.XAxis.Range)...)
+	errs = append(errs, validateAxisRange("y axis", c.YAxis.Range)...)
+	errs = append(errs, validateAxisRange("secondary y axis", c.YAxisSecondary.Range)...)
+	return errs.errorOrNil()
+}
+
+// validateAxisRange checks a user supplied axis range is usable.
+func validateAxisRange(name string, r Range) (errs []error) {
+	if r == nil || r.IsZero() {
+		return
+	}
+	if r.GetMin() > r.GetMax() {
+		errs = append(errs, fmt.Errorf("%s; range min %v must not be greater than its max %v", name, r.GetMin(), r.GetMax()))
+	}
+	if lr, isLogarithmic := r.(*LogarithmicRange); isLogarithmic {
+		if err := lr.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s; %v", name, err))
+		}
+	}
+	return
+}
+
+// hasFiniteValues returns if a series has at least one point with finite values.
+func hasFiniteValues(s Series) bool {
+	if bp, isBoundsProvider := s.(BoundsProvider); isBoundsProvider {
+		minx, _, miny, _ := bp.GetBounds()
+		return isFinite(minx) && isFinite(miny)
+	}
+	if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider {
+		for index := 0; index < vp.Len(); index++ {
+			if vx, vy := vp.GetValues(index); isFinite(vx) && isFinite(vy) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (c Chart) checkHasVisibleSeries() error {
@@ -178,70 +439,90 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 
 	seriesMappedToSecondaryAxis := false
 
+	// extend grows the ranges of the given y axis to include the given bounds.
+	extend := func(seriesAxis YAxisType, x1, x2, y1, y2 float64) {
+		minx = math.Min(minx, math.Min(x1, x2))
+		maxx = math.Max(maxx, math.Max(x1, x2))
+
+		if seriesAxis == YAxisPrimary {
+			miny = math.Min(miny, math.Min(y1, y2))
+			maxy = math.Max(maxy, math.Max(y1, y2))
+		} else if seriesAxis == YAxisSecondary {
+			minya = math.Min(minya, math.Min(y1, y2))
+			maxya = math.Max(maxya, math.Max(y1, y2))
+			seriesMappedToSecondaryAxis = true
+		}
+	}
+
+	// extendWithin grows the ranges to include the values within the x window, and where
+	// the lines between two values cross the edge of the window. Values are an x value
+	// and two y values; series with a single y value repeat it.
+	windowMin, windowMax, hasWindow := c.getXWindow()
+	extendWithin := func(seriesAxis YAxisType, length int, values func(int) (x, y1, y2 float64)) {
+		px, py1, py2 := math.NaN(), math.NaN(), math.NaN()
+		for index := 0; index < length; index++ {
+			vx, vy1, vy2 := values(index)
+			if !isFinite(vx) || !isFinite(vy1) || !isFinite(vy2) {
+				px, py1, py2 = math.NaN(), math.NaN(), math.NaN()
+				continue
+			}
+			if vx >= windowMin && vx <= windowMax {
+				extend(seriesAxis, vx, vx, vy1, vy2)
+			}
+			if isFinite(px) {
+				for _, edge := range []float64{windowMin, windowMax} {
+					if (px < edge) != (vx < edge) {
+						t := (edge - px) / (vx - px)
+						extend(seriesAxis, edge, edge, py1+(vy1-py1)*t, py2+(vy2-py2)*t)
+					}
+				}
+			}
+			px, py1, py2 = vx, vy1, vy2
+		}
+	}
+
 	// note: a possible future optimization is to not scan the series values if
 	// all axis are represented by either custom ticks or custom ranges.
+	// non-finite values are missing data and are left out of the ranges.
 	for _, s := range c.Series {
-		if !s.GetStyle().Hidden {
-			seriesAxis := s.GetYAxis()
-			if bvp, isBoundedValuesProvider := s.(BoundedValuesProvider); isBoundedValuesProvider {
-				seriesLength := bvp.Len()
-				for index := 0; index < seriesLength; index++ {
-					vx, vy1, vy2 := bvp.GetBoundedValues(index)
-
-					minx = math.Min(minx, vx)
-					maxx = math.Max(maxx, vx)
-
-					if seriesAxis == YAxisPrimary {
-						miny = math.Min(miny, vy1)
-						miny = math.Min(miny, vy2)
-						maxy = math.Max(maxy, vy1)
-						maxy = math.Max(maxy, vy2)
-					} else if seriesAxis == YAxisSecondary {
-						minya = math.Min(minya, vy1)
-						minya = math.Min(minya, vy2)
-						maxya = math.Max(maxya, vy1)
-						maxya = math.Max(maxya, vy2)
-						seriesMappedToSecondaryAxis = true
-					}
+		if s.GetStyle().Hidden {
+			continue
+		}
+		seriesAxis := s.GetYAxis()
+		if bvp, isBoundedValuesProvider := s.(BoundedValuesProvider); isBoundedValuesProvider && hasWindow {
+			extendWithin(seriesAxis, bvp.Len(), bvp.GetBoundedValues)
+		} else if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider && hasWindow {
+			extendWithin(seriesAxis, vp.Len(), func(index int) (float64, float64, float64) {
+				vx, vy := vp.GetValues(index)
+				return vx, vy, vy
+			})
+		} else if bp, isBoundsProvider := s.(BoundsProvider); isBoundsProvider {
+			vminx, vmaxx, vminy, vmaxy := bp.GetBounds()
+			if isFinite(vminx) && isFinite(vmaxx) && isFinite(vminy) && isFinite(vmaxy) {
+				extend(seriesAxis, vminx, vmaxx, vminy, vmaxy)
+			}
+		} else if bvp, isBoundedValuesProvider := s.(BoundedValuesProvider); isBoundedValuesProvider {
+			seriesLength := bvp.Len()
+			for index := 0; index < seriesLength; index++ {
+				vx, vy1, vy2 := bvp.GetBoundedValues(index)
+				if isFinite(vx) && isFinite(vy1) && isFinite(vy2) {
+					extend(seriesAxis, vx, vx, vy1, vy2)
 				}
-			} else if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider {
-				seriesLength := vp.Len()
-				for index := 0; index < seriesLength; index++ {
-					vx, vy := vp.GetValues(index)
-
-					minx = math.Min(minx, vx)
-					maxx = math.Max(maxx, vx)
-
-					if seriesAxis == YAxisPrimary {
-						miny = math.Min(miny, vy)
-						maxy = math.Max(maxy, vy)
-					} else if seriesAxis == YAxisSecondary {
-						minya = math.Min(minya, vy)
-						maxya = math.Max(maxya, vy)
-						seriesMappedToSecondaryAxis = true
-					}
+			}
+		} else if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider {
+			seriesLength := vp.Len()
+			for index := 0; index < seriesLength; index++ {
+				vx, vy := vp.GetValues(index)
+				if isFinite(vx) && isFinite(vy) {
+					extend(seriesAxis, vx, vx, vy, vy)
 				}
 			}
 		}
 	}
 
-	if c.XAxis.Range == nil {
-		xrange = &ContinuousRange{}
-	} else {
-		xrange = c.XAxis.Range
-	}
-
-	if c.YAxis.Range == nil {
-		yrange = &ContinuousRange{}
-	} else {
-		yrange = c.YAxis.Range
-	}
-
-	if c.YAxisSecondary.Range == nil {
-		yrangeAlt = &ContinuousRange{}
-	} else {
-		yrangeAlt = c.YAxisSecondary.Range
-	}
+	xrange = copyRange(c.XAxis.Range)
+	yrange = copyRange(c.YAxis.Range)
+	yrangeAlt = copyRange(c.YAxisSecondary.Range)
 
 	if len(c.XAxis.Ticks) > 0 {
 		tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
@@ -251,9 +532,12 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		}
 		xrange.SetMin(tickMin)
 		xrange.SetMax(tickMax)
+		expandDegenerateRange(xrange)
 	} else if xrange.IsZero() {
 		xrange.SetMin(minx)
 		xrange.SetMax(maxx)
+		expandDegenerateRange(xrange)
+		padRange(xrange, c.XAxis.RangePaddingPercent)
 	}
 
 	if len(c.YAxis.Ticks) > 0 {
@@ -264,44 +548,104 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		}
 		yrange.SetMin(tickMin)
 		yrange.SetMax(tickMax)
+		expandDegenerateRange(yrange)
 	} else if yrange.IsZero() {
 		yrange.SetMin(miny)
 		yrange.SetMax(maxy)
+		expandDegenerateRange(yrange)
+		padRange(yrange, c.YAxis.RangePaddingPercent)
+		if c.YAxis.IncludeZero {
+			includeZero(yrange, miny, maxy)
+		}
 
 		if !c.YAxis.Style.Hidden {
-			delta := yrange.GetDelta()
-			roundTo := GetRoundToForDelta(delta)
-			rmin, rmax := RoundDown(yrange.GetMin(), roundTo), RoundUp(yrange.GetMax(), roundTo)
-
-			yrange.SetMin(rmin)
-			yrange.SetMax(rmax)
+			roundRange(yrange)
 		}
 	}
 
 	if len(c.YAxisSecondary.Ticks) > 0 {
 		tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
-		for _, t := range c.YAxis.Ticks {
+		for _, t := range c.YAxisSecondary.Ticks {
 			tickMin = math.Min(tickMin, t.Value)
 			tickMax = math.Max(tickMax, t.Value)
 		}
 		yrangeAlt.SetMin(tickMin)
 		yrangeAlt.SetMax(tickMax)
+		expandDegenerateRange(yrangeAlt)
 	} else if seriesMappedToSecondaryAxis && yrangeAlt.IsZero() {
 		yrangeAlt.SetMin(minya)
 		yrangeAlt.SetMax(maxya)
+		expandDegenerateRange(yrangeAlt)
+		padRange(yrangeAlt, c.YAxisSecondary.RangePaddingPercent)
+		if c.YAxisSecondary.IncludeZero {
+			includeZero(yrangeAlt, minya, maxya)
+		}
 
 		if !c.YAxisSecondary.Style.Hidden {
-			delta := yrangeAlt.GetDelta()
-			roundTo := GetRoundToForDelta(delta)
-			rmin, rmax := RoundDown(yrangeAlt.GetMin(), roundTo), RoundUp(yrangeAlt.GetMax(), roundTo)
-			yrangeAlt.SetMin(rmin)
-			yrangeAlt.SetMax(rmax)
+			roundRange(yrangeAlt)
 		}
 	}
 
 	return
 }
 
+// roundRange rounds a computed range out so its bounds make for tidy tick labels.
+func roundRange(ra Range) {
+	if lr, isLogarithmic := ra.(*LogarithmicRange); isLogarithmic {
+		lr.Round()
+		return
+	}
+
+	delta := ra.GetDelta()
+	roundTo := GetRoundToForDelta(delta)
+	rmin, rmax := RoundDown(ra.GetMin(), roundTo), RoundUp(ra.GetMax(), roundTo)
+	ra.SetMin(rmin)
+	ra.SetMax(rmax)
+}
+
+// padRange widens a range on each side by a percent (as a fraction, i.e. 0.05 is 5%) of its delta.
+// Logarithmic ranges are not padded.
+func padRange(ra Range, percent float64) {
+	if percent <= 0 {
+		return
+	}
+	if _, isLogarithmic := ra.(*LogarithmicRange); isLogarithmic {
+		return
+	}
+	padding := math.Abs(ra.GetDelta()) * percent
+	ra.SetMin(ra.GetMin() - padding)
+	ra.SetMax(ra.GetMax() + padding)
+}
+
+// includeZero extends a range to zero, i.e. down to zero for all positive data
+// or up to zero for all negative data, replacing any padding past zero.
+// Logarithmic ranges can't include zero and are left as is.
+func includeZero(ra Range, dataMin, dataMax float64) {
+	if _, isLogarithmic := ra.(*LogarithmicRange); isLogarithmic {
+		return
+	}
+	if dataMin >= 0 {
+		ra.SetMin(0)
+	} else if dataMax <= 0 {
+		ra.SetMax(0)
+	}
+}
+
+// expandDegenerateRange widens a range whose min and max are equal, i.e. of a flat or single point series,
+// or a single tick, so the values aren't all at the middle of the axis. Ranges that are set explicitly are left as they are.
+func expandDegenerateRange(ra Range) {
+	if ra.GetDelta() != 0 {
+		return
+	}
+	value := ra.GetMin()
+	delta := math.Abs(value) * DefaultDegenerateRangeExpansion
+	if delta == 0 {
+		delta = 1
+	}
+	ra.SetMin(value - delta)
+	ra.SetMax(value + delta)
+}
+
 func (c Chart) checkRanges(xr, yr, yra Range) error {
 	Debugf(c.Log, "checking xrange: %v", xr)
 	xDelta := xr.GetDelta()
@@ -314,6 +658,11 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 	if xDelta == 0 {
 		return errors.New("zero x-range delta; there needs to be at least (2) values")
 	}
+	if lr, isLogarithmic := xr.(*LogarithmicRange); isLogarithmic {
+		if err := lr.Validate(); err != nil {
+			return err
+		}
+	}
 
 	Debugf(c.Log, "checking yrange: %v", yr)
 	yDelta := yr.GetDelta()
@@ -323,6 +672,11 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 	if math.IsNaN(yDelta) {
 		return errors.New("nan y-range delta")
 	}
+	if lr, isLogarithmic := yr.(*LogarithmicRange); isLogarithmic {
+		if err := lr.Validate(); err != nil {
+			return err
+		}
+	}
 
 	if c.hasSecondarySeries() {
 		Debugf(c.Log, "checking secondary yrange: %v", yra)
@@ -333,6 +687,11 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 		if math.IsNaN(yraDelta) {
 			return errors.New("nan secondary y-range delta")
 		}
+		if lr, isLogarithmic := yra.(*LogarithmicRange); isLogarithmic {
+			if err := lr.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -355,6 +714,13 @@ func (c Chart) getValueFormatters() (x, y, ya ValueFormatter) {
 			}
 		}
 	}
+	// without a formatter of their own, the y axes pick a precision from their tick step.
+	if isDefaultValueFormatter(y) {
+		y = nil
+	}
+	if isDefaultValueFormatter(ya) {
+		ya = nil
+	}
 	if c.XAxis.ValueFormatter != nil {
 		x = c.XAxis.GetValueFormatter()
 	}
@@ -371,14 +737,19 @@ func (c Chart) hasAxes() bool {
 	return !c.XAxis.Style.Hidden || !c.YAxis.Style.Hidden || !c.YAxisSecondary.Style.Hidden
 }
 
+// getAxesTicks returns the ticks of the axes, extending the ranges worked out from the values to the nice numbers
+// around them first, so the ticks start and end at the ends of the axes.
 func (c Chart) getAxesTicks(r Renderer, xr, yr, yar Range, xf, yf, yfa ValueFormatter) (xticks, yticks, yticksAlt []Tick) {
 	if !c.XAxis.Style.Hidden {
+		c.XAxis.extendRange(r, xr, c.styleDefaultsAxes(), xf)
 		xticks = c.XAxis.GetTicks(r, xr, c.styleDefaultsAxes(), xf)
 	}
 	if !c.YAxis.Style.Hidden {
+		c.YAxis.extendRange(r, yr, c.styleDefaultsAxes(), yf)
 		yticks = c.YAxis.GetTicks(r, yr, c.styleDefaultsAxes(), yf)
 	}
-	if !c.YAxisSecondary.Style.Hidden {
+	if c.hasSecondaryAxis() {
+		c.YAxisSecondary.extendRange(r, yar, c.styleDefaultsAxes(), yfa)
 		yticksAlt = c.YAxisSecondary.GetTicks(r, yar, c.styleDefaultsAxes(), yfa)
 	}
 	return
@@ -432,11 +803,21 @@ func (c Chart) hasSecondarySeries() bool {
 	return false
 }
 
+// hasSecondaryAxis returns if the secondary y-axis should be drawn, i.e. it isn't hidden
+// and has either series mapped to it or a user supplied range or ticks.
+func (c Chart) hasSecondaryAxis() bool {
+	if c.YAxisSecondary.Style.Hidden {
+		return false
+	}
+	return c.hasSecondarySeries() || c.YAxisSecondary.Range != nil || len(c.YAxisSecondary.Ticks) > 0
+}
+
 func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, xf, yf, yfa ValueFormatter) Box {
 	annotationSeriesBox := canvasBox.Clone()
 	for seriesIndex, s := range c.Series {
 		if as, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
 			if !as.GetStyle().Hidden {
+				as = c.windowAnnotations(as)
 				style := c.styleDefaultsSeries(seriesIndex)
 				var annotationBounds Box
 				if as.YAxis == YAxisPrimary {
@@ -458,10 +839,17 @@ func (c Chart) getBackgroundStyle() Style {
 }
 
 func (c Chart) drawBackground(r Renderer) {
-	Draw.Box(r, Box{
+	box := Box{
 		Right:  c.GetWidth(),
 		Bottom: c.GetHeight(),
-	}, c.getBackgroundStyle())
+	}
+	style := c.getBackgroundStyle()
+	if !style.GetFillColor().IsTransparent() || (!style.GetStrokeColor().IsTransparent() && style.StrokeWidth > 0) {
+		Draw.Box(r, box, style)
+	}
+	if c.BackgroundImage != nil {
+		ImageElement{Image: c.BackgroundImage, Box: box}.Render(r)
+	}
 }
 
 func (c Chart) getCanvasStyle() Style {
@@ -474,42 +862,287 @@ func (c Chart) drawCanvas(r Renderer, canvasBox Box) {
 
 func (c Chart) drawAxes(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, xticks, yticks, yticksAlt []Tick) {
 	if !c.XAxis.Style.Hidden {
-		c.XAxis.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticks)
+		drawGroup(r, func() { c.XAxis.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticks) }, "x-axis", "axis")
 	}
 	if !c.YAxis.Style.Hidden {
-		c.YAxis.Render(r, canvasBox, yrange, c.styleDefaultsAxes(), yticks)
+		drawGroup(r, func() { c.YAxis.Render(r, canvasBox, yrange, c.styleDefaultsAxes(), yticks) }, "y-axis", "axis")
 	}
-	if !c.YAxisSecondary.Style.Hidden {
-		c.YAxisSecondary.Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
+	if c.hasSecondaryAxis() {
+		drawGroup(r, func() { c.YAxisSecondary.Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt) }, "y-axis-secondary", "axis")
 	}
 }
 
 func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, s Series, seriesIndex int) {
-	if !s.GetStyle().Hidden {
-		if s.GetYAxis() == YAxisPrimary {
-			s.Render(r, canvasBox, xrange, yrange, c.styleDefaultsSeries(seriesIndex))
-		} else if s.GetYAxis() == YAxisSecondary {
-			s.Render(r, canvasBox, xrange, yrangeAlt, c.styleDefaultsSeries(seriesIndex))
+	if s.GetStyle().Hidden {
+		return
+	}
+	if s.GetYAxis() == YAxisSecondary {
+		yrange = yrangeAlt
+	} else if s.GetYAxis() != YAxisPrimary {
+		return
+	}
+	drawGroup(r, func() {
+		defaults := c.styleDefaultsSeries(seriesIndex)
+		if _, isBand := s.(bandSeries); isBand {
+			defaults = defaults.InheritFrom(c.styleDefaultsBands())
+		}
+		s.Render(r, canvasBox, xrange, yrange, defaults)
+		c.drawSeriesValues(r, canvasBox, xrange, yrange, s, seriesIndex)
+	}, fmt.Sprintf("series-%d", seriesIndex), "series")
+}
+
+// drawGroup draws a part of the chart in a group, if the renderer groups what's drawn; see `GroupRenderer`.
+func drawGroup(r Renderer, draw func(), name string, classes ...string) {
+	typed, isTyped := r.(GroupRenderer)
+	if !isTyped {
+		draw()
+		return
+	}
+	typed.BeginGroup(name, classes...)
+	draw()
+	typed.EndGroup()
+}
+
+// drawSeriesValues draws the value labels of a series that has `ShowValues` set.
+func (c Chart) drawSeriesValues(r Renderer, canvasBox Box, xrange, yrange Range, s Series, seriesIndex int) {
+	if !s.GetStyle().ShowValues {
+		return
+	}
+	vs, isValuesProvider := s.(ValuesProvider)
+	if !isValuesProvider {
+		return
+	}
+	style := s.GetStyle().InheritFrom(c.styleDefaultsSeries(seriesIndex).InheritFrom(Style{
+		FontColor: c.GetColorPalette().TextColor(),
+	}))
+	if style.ValueFormatter == nil {
+		style.ValueFormatter = FloatValueFormatter
+		if vfp, isVfp := s.(ValueFormatterProvider); isVfp {
+			_, style.ValueFormatter = vfp.GetValueFormatters()
+		}
+	}
+	Draw.ValueLabels(r, canvasBox, xrange, yrange, style, vs)
+}
+
+// getXWindow returns the x values series are limited to if the x axis is a window, see `XAxis.Window`.
+func (c Chart) getXWindow() (min, max float64, ok bool) {
+	if !c.XAxis.Window {
+		return
+	}
+	if len(c.XAxis.Ticks) > 0 {
+		min, max = math.MaxFloat64, -math.MaxFloat64
+		for _, t := range c.XAxis.Ticks {
+			min = math.Min(min, t.Value)
+			max = math.Max(max, t.Value)
+		}
+		return min, max, true
+	}
+	if c.XAxis.Range == nil || c.XAxis.Range.IsZero() {
+		return
+	}
+	min, max = c.XAxis.Range.GetMin(), c.XAxis.Range.GetMax()
+	return math.Min(min, max), math.Max(min, max), true
+}
+
+// windowAnnotations returns the annotation series without the annotations outside the x window.
+// Last value annotations label the last value within the window instead.
+func (c Chart) windowAnnotations(as AnnotationSeries) AnnotationSeries {
+	min, max, ok := c.getXWindow()
+	if !ok {
+		return as
+	}
+	if as.lastValueOf != nil {
+		as.Annotations = nil
+		if lastValue, ok := as.lastValueOf.within(min, max); ok {
+			as.Annotations = []Value2{lastValue}
+		}
+		return as
+	}
+	var annotations []Value2
+	for _, a := range as.Annotations {
+		if a.XValue >= min && a.XValue <= max {
+			annotations = append(annotations, a)
+		}
+	}
+	as.Annotations = annotations
+	return as
+}
+
+func (c Chart) getAnnotationPlacements(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, as AnnotationSeries, seriesIndex int) []annotationPlacement {
+	as = c.windowAnnotations(as)
+	if as.YAxis == YAxisSecondary {
+		return as.getPlacements(r, canvasBox, xrange, yrangeAlt, c.styleDefaultsSeries(seriesIndex))
+	}
+	return as.getPlacements(r, canvasBox, xrange, yrange, c.styleDefaultsSeries(seriesIndex))
+}
+
+func (c Chart) drawBands(r Renderer, canvasBox Box, xrange, yrange Range) {
+	defaults := c.styleDefaultsBands()
+	for _, b := range c.XBands {
+		b.Render(r, canvasBox, xrange, true, defaults)
+	}
+	for _, b := range c.YBands {
+		b.Render(r, canvasBox, yrange, false, defaults)
+	}
+}
+
+func (c Chart) drawWatermark(r Renderer, canvasBox Box) {
+	c.Watermark.Render(r, canvasBox, c.styleDefaultsWatermark())
+}
+
+func (c Chart) drawFrame(r Renderer, canvasBox Box, xrange, yrange Range, xticks, yticks []Tick) {
+	// the side opposite the primary y axis belongs to the secondary y axis if that is drawn.
+	mirrorY := !c.hasSecondaryAxis()
+	c.Frame.Render(r, canvasBox, xrange, yrange, xticks, yticks, c.YAxis.GetPosition(), mirrorY, c.styleDefaultsAxes())
+}
+
+func (c Chart) drawXMarkers(r Renderer, canvasBox Box, xrange Range, aboveSeries bool) {
+	defaults := c.styleDefaultsMarkers()
+	for _, m := range c.XMarkers {
+		if m.AboveSeries == aboveSeries {
+			m.Render(r, canvasBox, xrange, defaults)
+		}
+	}
+}
+
+func (c Chart) drawImages(r Renderer, aboveSeries bool) {
+	for _, ie := range c.Images {
+		if ie.AboveSeries == aboveSeries {
+			ie.Render(r)
 		}
 	}
 }
 
+func (c Chart) drawYMarkers(r Renderer, canvasBox Box, yrange, yrangeAlt Range, annotations []annotationPlacement) {
+	if len(c.YMarkers) == 0 {
+		return
+	}
+	avoid := make([]Box, len(annotations))
+	for index, a := range annotations {
+		avoid[index] = a.Box
+	}
+	defaults := c.styleDefaultsMarkers()
+	for _, m := range c.YMarkers {
+		if m.YAxis == YAxisSecondary {
+			m.Render(r, canvasBox, yrangeAlt, defaults, avoid)
+		} else {
+			m.Render(r, canvasBox, yrange, defaults, avoid)
+		}
+	}
+}
+
+// drawAnnotations draws the annotations, with the final labels, i.e. last value annotations, in a group of their own.
+func (c Chart) drawAnnotations(r Renderer, canvasBox Box, annotations []annotationPlacement) {
+	var final []annotationPlacement
+	for _, a := range annotations {
+		if a.Final {
+			final = append(final, a)
+			continue
+		}
+		a.Render(r, canvasBox)
+	}
+	if len(final) == 0 {
+		return
+	}
+	drawGroup(r, func() {
+		for _, a := range final {
+			a.Render(r, canvasBox)
+		}
+	}, "final-labels", "final-label")
+}
+
 func (c Chart) drawTitle(r Renderer) {
-	if len(c.Title) > 0 && !c.TitleStyle.Hidden {
-		r.SetFont(c.TitleStyle.GetFont(c.GetFont()))
-		r.SetFontColor(c.TitleStyle.GetFontColor(c.GetColorPalette().TextColor()))
-		titleFontSize := c.TitleStyle.GetFontSize(DefaultTitleFontSize)
-		r.SetFontSize(titleFontSize)
+	lines, _ := c.getTitleLines(r)
+	for _, line := range lines {
+		Draw.Text(r, line.Text, line.X, line.Y, line.Style)
+	}
+}
+
+// titleLine is a line of the title or subtitle positioned on the chart.
+type titleLine struct {
+	Text  string
+	Style Style
+	X, Y  int
+}
 
-		textBox := r.MeasureText(c.Title)
+// getTitleLines lays out the title and subtitle lines, one per `\n`, and returns them with the
+// bottom of the block they form. Lines are aligned with the `TextHorizontalAlign` of their style,
+// and lines wider than the chart box are wrapped to fit.
+func (c Chart) getTitleLines(r Renderer) (lines []titleLine, bottom int) {
+	titleStyle := c.TitleStyle.InheritFrom(c.styleDefaultsTitle())
+	subtitleStyle := c.SubtitleStyle.InheritFrom(Style{
+		FontSize: DefaultSubtitleFontSize,
+	}.InheritFrom(titleStyle))
+
+	box := c.Box()
+	var texts []string
+	var styles []Style
+	add := func(value string, style Style) {
+		for _, text := range strings.Split(value, "\n") {
+			if Draw.MeasureText(r, text, style).Width() > box.Width() {
+				for _, line := range Text.WrapFitWord(r, text, box.Width(), style) {
+					texts = append(texts, line)
+					styles = append(styles, style)
+				}
+				r.ResetStyle()
+				continue
+			}
+			texts = append(texts, text)
+			styles = append(styles, style)
+		}
+	}
+	if len(c.Title) > 0 && !c.TitleStyle.Hidden {
+		add(c.Title, titleStyle)
+	}
+	if len(c.Subtitle) > 0 && !c.SubtitleStyle.Hidden {
+		add(c.Subtitle, subtitleStyle)
+	}
+	if len(texts) == 0 {
+		return
+	}
 
-		textWidth := textBox.Width()
-		textHeight := textBox.Height()
+	y := titleStyle.Padding.Top
+	for index, text := range texts {
+		if index > 0 {
+			y += titleStyle.GetTextLineSpacing()
+		}
+		textBox := Draw.MeasureText(r, text, styles[index])
+		y += textBox.Height()
+
+		var x int
+		switch styles[index].GetTextHorizontalAlign() {
+		case TextHorizontalAlignLeft:
+			x = box.Left
+		case TextHorizontalAlignRight:
+			x = box.Right - textBox.Width()
+		default:
+			x = (c.GetWidth() >> 1) - (textBox.Width() >> 1)
+		}
+		lines = append(lines, titleLine{Text: text, Style: styles[index], X: x, Y: y})
+	}
+	bottom = y
+	return
+}
 
-		titleX := (c.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := c.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+// getTitleAdjustedCanvasBox moves the top of the canvas below the title block so tall or
+// multi-line titles don't overlap the plot area.
+func (c Chart) getTitleAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	lines, bottom := c.getTitleLines(r)
+	if len(lines) == 0 {
+		return canvasBox
+	}
+	canvasBox.Top = MaxInt(canvasBox.Top, bottom+c.TitleStyle.InheritFrom(c.styleDefaultsTitle()).Padding.Bottom)
+	return canvasBox
+}
 
-		r.Text(c.Title, titleX, titleY)
+func (c Chart) styleDefaultsTitle() Style {
+	return Style{
+		Font:                c.GetFont(),
+		FontColor:           c.GetColorPalette().TextColor(),
+		FontSize:            c.GetTheme().GetTitleFontSize(),
+		Padding:             Box{Top: DefaultTitleTop, Bottom: DefaultTitleBottom},
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextLineSpacing:     DefaultLineSpacing,
 	}
 }
 
@@ -521,6 +1154,14 @@ func (c Chart) styleDefaultsBackground() Style {
 	}
 }
 
+func (c Chart) styleDefaultsWatermark() Style {
+	return Style{
+		Font:      c.GetFont(),
+		FontColor: DefaultWatermarkColor,
+		FontSize:  DefaultWatermarkFontSize,
+	}
+}
+
 func (c Chart) styleDefaultsCanvas() Style {
 	return Style{
 		FillColor:   c.GetColorPalette().CanvasColor(),
@@ -535,7 +1176,8 @@ func (c Chart) styleDefaultsSeries(seriesIndex int) Style {
 		StrokeColor: c.GetColorPalette().GetSeriesColor(seriesIndex),
 		StrokeWidth: DefaultSeriesLineWidth,
 		Font:        c.GetFont(),
-		FontSize:    DefaultFontSize,
+		FontSize:    c.GetTheme().GetFontSize(),
+		FontColor:   c.GetColorPalette().TextColor(),
 	}
 }
 
@@ -543,35 +1185,47 @@ func (c Chart) styleDefaultsAxes() Style {
 	return Style{
 		Font:        c.GetFont(),
 		FontColor:   c.GetColorPalette().TextColor(),
-		FontSize:    DefaultAxisFontSize,
+		FontSize:    c.GetTheme().GetAxisFontSize(),
 		StrokeColor: c.GetColorPalette().AxisStrokeColor(),
 		StrokeWidth: DefaultAxisLineWidth,
 	}
 }
 
+func (c Chart) styleDefaultsBands() Style {
+	return Style{
+		FillColor: c.GetColorPalette().AxisStrokeColor().WithAlpha(DefaultBandAlpha),
+	}
+}
+
+func (c Chart) styleDefaultsMarkers() Style {
+	return Style{
+		Font:            c.GetFont(),
+		FontColor:       c.GetColorPalette().TextColor(),
+		FontSize:        DefaultMarkerFontSize,
+		StrokeColor:     c.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth:     DefaultAxisLineWidth,
+		StrokeDashArray: DashArrayDashesSmall,
+	}
+}
+
 func (c Chart) styleDefaultsElements() Style {
 	return Style{
 		Font: c.GetFont(),
 	}
 }
 
+// GetTheme returns the chart theme or the default theme; nil means the package defaults.
+func (c Chart) GetTheme() *Theme {
+	return getTheme(c.Theme)
+}
+
 // GetColorPalette returns the color palette for the chart.
 func (c Chart) GetColorPalette() ColorPalette {
-	if c.ColorPalette != nil {
-		return c.ColorPalette
-	}
-	return DefaultColorPalette
+	return getColorPalette(c.ColorPalette, c.GetTheme(), DefaultColorPalette)
 }
 
 // Box returns the chart bounds as a box.
 func (c Chart) Box() Box {
-	dpr := c.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
-	dpb := c.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
-
-	return Box{
-		Top:    c.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
-		Left:   c.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
-		Right:  c.GetWidth() - dpr,
-		Bottom: c.GetHeight() - dpb,
-	}
+	padding := c.Background.InheritFrom(Style{Padding: c.GetTheme().GetBackgroundPadding()}).Padding
+	return Box{Right: c.GetWidth(), Bottom: c.GetHeight()}.Inset(padding)
 }
@@ -0,0 +1,576 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+const (
+	// JSONRangeContinuous is the JSON range type of a `ContinuousRange`; it's the default.
+	JSONRangeContinuous = "continuous"
+	// JSONRangeLogarithmic is the JSON range type of a `LogarithmicRange`.
+	JSONRangeLogarithmic = "logarithmic"
+	// JSONRangeTime is the JSON range type of a `TimeRange`.
+	JSONRangeTime = "time"
+
+	// JSONColorTransparent is the JSON color of `ColorTransparent`, i.e. no color.
+	JSONColorTransparent = "transparent"
+)
+
+// the JSON names of the text alignments and wrapping, by their values; the unset value has no name.
+var (
+	jsonTextHorizontalAligns = []string{"", "left", "center", "right"}
+	jsonTextVerticalAligns   = []string{"", "baseline", "bottom", "middle", "middle_baseline", "top"}
+	jsonTextWraps            = []string{"", "none", "word", "rune"}
+)
+
+// ChartFromJSON returns the chart a JSON configuration describes, e.g. one written by `ChartToJSON`.
+//
+// The keys are the snake case field names, like "title_style" or "stroke_color"; keys that aren't known are an error.
+// Colors are css hex codes, like "#ff0000", with an optional alpha, like "#ff000080", or "transparent".
+// Ranges have a "type", one of "continuous" (the default), "logarithmic" or "time", and a "min" and "max".
+// Boxes, like paddings, have a "top", "left", "right" and "bottom"; the sides that are left out are inherited,
+// unless the box has "is_set", which makes it used as it is, like a `Box` with `IsSet`, e.g. `BoxZero`.
+// Text alignments are "left", "center" or "right", and "baseline", "bottom", "middle", "middle_baseline" or "top";
+// text wrapping is "none", "word" or "rune".
+// Value formatters are the names they're registered under with `RegisterValueFormatter`, like "time" or "percent",
+// and series are the keys of the series map, so the data stays out of the configuration; fonts are referred to
+// by the "font_name" they're registered under with `RegisterFont`.
+func ChartFromJSON(data []byte, series map[string]Series) (*Chart, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var cj chartJSON
+	if err := decoder.Decode(&cj); err != nil {
+		return nil, fmt.Errorf("chart json; %v", err)
+	}
+	if err := decoder.Decode(&struct{}{}); err != io.EOF {
+		return nil, fmt.Errorf("chart json; unexpected data after the chart")
+	}
+
+	var d chartJSONDecoder
+	c := &Chart{
+		Title:          cj.Title,
+		TitleStyle:     d.style("title_style", cj.TitleStyle),
+		Subtitle:       cj.Subtitle,
+		SubtitleStyle:  d.style("subtitle_style", cj.SubtitleStyle),
+		Theme:          d.theme("theme", cj.Theme),
+		Width:          cj.Width,
+		Height:         cj.Height,
+		DPI:            cj.DPI,
+		ScaleFactor:    cj.ScaleFactor,
+		Background:     d.style("background", cj.Background),
+		Canvas:         d.style("canvas", cj.Canvas),
+		XAxis:          d.xaxis("x_axis", cj.XAxis),
+		YAxis:          d.yaxis("y_axis", cj.YAxis),
+		YAxisSecondary: d.yaxis("y_axis_secondary", cj.YAxisSecondary),
+	}
+	for index, name := range cj.Series {
+		s, ok := series[name]
+		if !ok {
+			d.errs = append(d.errs, fmt.Errorf("chart json; series[%d]: there's no series named %q", index, name))
+			continue
+		}
+		c.Series = append(c.Series, s)
+	}
+	if err := d.errs.errorOrNil(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ChartToJSON returns the JSON configuration of a chart, as read by `ChartFromJSON`.
+//
+// Series are written as their names, so they must have one. Value formatters are written as the name they're
+// registered under, so they must be registered. What a configuration can't describe is left out, e.g. fonts,
+// other than by name, color palettes, providers, ticks, annotations, elements, legends, markers, the log,
+// and the style fields that are functions, like `DotWidthProvider`.
+func ChartToJSON(c Chart) ([]byte, error) {
+	var e chartJSONEncoder
+	cj := chartJSON{
+		Title:          c.Title,
+		TitleStyle:     e.style("title_style", c.TitleStyle),
+		Subtitle:       c.Subtitle,
+		SubtitleStyle:  e.style("subtitle_style", c.SubtitleStyle),
+		Theme:          e.theme(c.Theme),
+		Width:          c.Width,
+		Height:         c.Height,
+		DPI:            c.DPI,
+		ScaleFactor:    c.ScaleFactor,
+		Background:     e.style("background", c.Background),
+		Canvas:         e.style("canvas", c.Canvas),
+		XAxis:          e.xaxis("x_axis", c.XAxis),
+		YAxis:          e.yaxis("y_axis", c.YAxis),
+		YAxisSecondary: e.yaxis("y_axis_secondary", c.YAxisSecondary),
+	}
+	for index, s := range c.Series {
+		if len(s.GetName()) == 0 {
+			e.errs = append(e.errs, fmt.Errorf("chart json; series[%d] has no name", index))
+			continue
+		}
+		cj.Series = append(cj.Series, s.GetName())
+	}
+	if err := e.errs.errorOrNil(); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(cj, "", "  ")
+}
+
+type chartJSON struct {
+	Title         string     `json:"title,omitempty"`
+	TitleStyle    *styleJSON `json:"title_style,omitempty"`
+	Subtitle      string     `json:"subtitle,omitempty"`
+	SubtitleStyle *styleJSON `json:"subtitle_style,omitempty"`
+	Theme         *themeJSON `json:"theme,omitempty"`
+
+	Width       int     `json:"width,omitempty"`
+	Height      int     `json:"height,omitempty"`
+	DPI         float64 `json:"dpi,omitempty"`
+	ScaleFactor float64 `json:"scale_factor,omitempty"`
+
+	Background *styleJSON `json:"background,omitempty"`
+	Canvas     *styleJSON `json:"canvas,omitempty"`
+
+	XAxis          *xaxisJSON `json:"x_axis,omitempty"`
+	YAxis          *yaxisJSON `json:"y_axis,omitempty"`
+	YAxisSecondary *yaxisJSON `json:"y_axis_secondary,omitempty"`
+
+	Series []string `json:"series,omitempty"`
+}
+
+type styleJSON struct {
+	Hidden    bool     `json:"hidden,omitempty"`
+	Padding   *boxJSON `json:"padding,omitempty"`
+	ClassName string   `json:"class_name,omitempty"`
+
+	StrokeWidth     float64   `json:"stroke_width,omitempty"`
+	StrokeColor     string    `json:"stroke_color,omitempty"`
+	StrokeDashArray []float64 `json:"stroke_dash_array,omitempty"`
+	Smooth          bool      `json:"smooth,omitempty"`
+
+	ShowValues     bool   `json:"show_values,omitempty"`
+	ValueFormatter string `json:"value_formatter,omitempty"`
+	ShowTooltips   bool   `json:"show_tooltips,omitempty"`
+
+	DotColor  string  `json:"dot_color,omitempty"`
+	DotWidth  float64 `json:"dot_width,omitempty"`
+	FillColor string  `json:"fill_color,omitempty"`
+
+	FontSize            float64 `json:"font_size,omitempty"`
+	FontColor           string  `json:"font_color,omitempty"`
+	FontName            string  `json:"font_name,omitempty"`
+	TextHorizontalAlign string  `json:"text_horizontal_align,omitempty"`
+	TextVerticalAlign   string  `json:"text_vertical_align,omitempty"`
+	TextWrap            string  `json:"text_wrap,omitempty"`
+	TextLineSpacing     int     `json:"text_line_spacing,omitempty"`
+	TextRotationDegrees float64 `json:"text_rotation_degrees,omitempty"`
+	TickLabelSpacing    int     `json:"tick_label_spacing,omitempty"`
+}
+
+type boxJSON struct {
+	Top    int  `json:"top,omitempty"`
+	Left   int  `json:"left,omitempty"`
+	Right  int  `json:"right,omitempty"`
+	Bottom int  `json:"bottom,omitempty"`
+	IsSet  bool `json:"is_set,omitempty"`
+}
+
+type rangeJSON struct {
+	Type       string  `json:"type,omitempty"`
+	Min        float64 `json:"min"`
+	Max        float64 `json:"max"`
+	Descending bool    `json:"descending,omitempty"`
+}
+
+type themeJSON struct {
+	BackgroundColor       string   `json:"background_color,omitempty"`
+	BackgroundStrokeColor string   `json:"background_stroke_color,omitempty"`
+	CanvasColor           string   `json:"canvas_color,omitempty"`
+	CanvasStrokeColor     string   `json:"canvas_stroke_color,omitempty"`
+	AxisColor             string   `json:"axis_color,omitempty"`
+	TextColor             string   `json:"text_color,omitempty"`
+	SeriesColors          []string `json:"series_colors,omitempty"`
+
+	FontSize      float64 `json:"font_size,omitempty"`
+	TitleFontSize float64 `json:"title_font_size,omitempty"`
+	AxisFontSize  float64 `json:"axis_font_size,omitempty"`
+
+	BackgroundPadding *boxJSON `json:"background_padding,omitempty"`
+}
+
+// axisJSON is what the x and y axes have in common.
+type axisJSON struct {
+	Name      string     `json:"name,omitempty"`
+	NameStyle *styleJSON `json:"name_style,omitempty"`
+	Style     *styleJSON `json:"style,omitempty"`
+
+	ValueFormatter      string     `json:"value_formatter,omitempty"`
+	Range               *rangeJSON `json:"range,omitempty"`
+	RangePaddingPercent float64    `json:"range_padding_percent,omitempty"`
+
+	TickStyle      *styleJSON `json:"tick_style,omitempty"`
+	TickMarkLength int        `json:"tick_mark_length,omitempty"`
+	EvenTicks      bool       `json:"even_ticks,omitempty"`
+
+	GridMajorStyle *styleJSON `json:"grid_major_style,omitempty"`
+	GridMinorStyle *styleJSON `json:"grid_minor_style,omitempty"`
+}
+
+type xaxisJSON struct {
+	axisJSON
+	Window bool `json:"window,omitempty"`
+}
+
+type yaxisJSON struct {
+	axisJSON
+	Ascending   bool `json:"ascending,omitempty"`
+	IncludeZero bool `json:"include_zero,omitempty"`
+}
+
+// chartJSONDecoder turns the JSON form of a chart into the chart, collecting the problems it finds on the way.
+type chartJSONDecoder struct {
+	errs errorList
+}
+
+func (d *chartJSONDecoder) errorf(path, format string, args ...interface{}) {
+	d.errs = append(d.errs, fmt.Errorf("chart json; %s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (d *chartJSONDecoder) color(path, value string) drawing.Color {
+	if len(value) == 0 {
+		return drawing.Color{}
+	}
+	if value == JSONColorTransparent {
+		return ColorTransparent
+	}
+	digits := strings.TrimPrefix(value, "#")
+	if len(digits) == 8 {
+		alpha, err := strconv.ParseUint(digits[6:], 16, 8)
+		if err != nil {
+			d.errorf(path, "invalid color hex code %q; must be hexadecimal", value)
+			return drawing.Color{}
+		}
+		color, err := drawing.ParseColorHex(digits[:6])
+		if err != nil {
+			d.errorf(path, "invalid color hex code %q; must be hexadecimal", value)
+			return drawing.Color{}
+		}
+		return color.WithAlpha(uint8(alpha))
+	}
+	color, err := drawing.ParseColorHex(value)
+	if err != nil {
+		d.errorf(path, "%v", err)
+		return drawing.Color{}
+	}
+	return color
+}
+
+func (d *chartJSONDecoder) box(bj *boxJSON) Box {
+	if bj == nil {
+		return Box{}
+	}
+	return Box{Top: bj.Top, Left: bj.Left, Right: bj.Right, Bottom: bj.Bottom, IsSet: bj.IsSet}
+}
+
+// named returns the value of a name, i.e. its index in the names; an empty name is the unset value, zero.
+func (d *chartJSONDecoder) named(path, name string, names []string) int {
+	for value, candidate := range names {
+		if candidate == name {
+			return value
+		}
+	}
+	d.errorf(path, "unknown value %q; must be one of %q", name, names[1:])
+	return 0
+}
+
+func (d *chartJSONDecoder) valueFormatter(path, name string) ValueFormatter {
+	if len(name) == 0 {
+		return nil
+	}
+	vf := GetRegisteredValueFormatter(name)
+	if vf == nil {
+		d.errorf(path, "there's no value formatter named %q", name)
+	}
+	return vf
+}
+
+func (d *chartJSONDecoder) style(path string, sj *styleJSON) Style {
+	if sj == nil {
+		return Style{}
+	}
+	return Style{
+		Hidden:              sj.Hidden,
+		Padding:             d.box(sj.Padding),
+		ClassName:           sj.ClassName,
+		StrokeWidth:         sj.StrokeWidth,
+		StrokeColor:         d.color(path+".stroke_color", sj.StrokeColor),
+		StrokeDashArray:     sj.StrokeDashArray,
+		Smooth:              sj.Smooth,
+		ShowValues:          sj.ShowValues,
+		ValueFormatter:      d.valueFormatter(path+".value_formatter", sj.ValueFormatter),
+		ShowTooltips:        sj.ShowTooltips,
+		DotColor:            d.color(path+".dot_color", sj.DotColor),
+		DotWidth:            sj.DotWidth,
+		FillColor:           d.color(path+".fill_color", sj.FillColor),
+		FontSize:            sj.FontSize,
+		FontColor:           d.color(path+".font_color", sj.FontColor),
+		FontName:            sj.FontName,
+		TextHorizontalAlign: TextHorizontalAlign(d.named(path+".text_horizontal_align", sj.TextHorizontalAlign, jsonTextHorizontalAligns)),
+		TextVerticalAlign:   TextVerticalAlign(d.named(path+".text_vertical_align", sj.TextVerticalAlign, jsonTextVerticalAligns)),
+		TextWrap:            TextWrap(d.named(path+".text_wrap", sj.TextWrap, jsonTextWraps)),
+		TextLineSpacing:     sj.TextLineSpacing,
+		TextRotationDegrees: sj.TextRotationDegrees,
+		TickLabelSpacing:    sj.TickLabelSpacing,
+	}
+}
+
+func (d *chartJSONDecoder) axisRange(path string, rj *rangeJSON) Range {
+	if rj == nil {
+		return nil
+	}
+	switch rj.Type {
+	case "", JSONRangeContinuous:
+		return &ContinuousRange{Min: rj.Min, Max: rj.Max, Descending: rj.Descending}
+	case JSONRangeLogarithmic:
+		return &LogarithmicRange{Min: rj.Min, Max: rj.Max, Descending: rj.Descending}
+	case JSONRangeTime:
+		return &TimeRange{Min: rj.Min, Max: rj.Max, Descending: rj.Descending}
+	default:
+		d.errorf(path+".type", "unknown range type %q; must be %q, %q or %q", rj.Type, JSONRangeContinuous, JSONRangeLogarithmic, JSONRangeTime)
+		return nil
+	}
+}
+
+func (d *chartJSONDecoder) theme(path string, tj *themeJSON) *Theme {
+	if tj == nil {
+		return nil
+	}
+	theme := &Theme{
+		BackgroundColor:       d.color(path+".background_color", tj.BackgroundColor),
+		BackgroundStrokeColor: d.color(path+".background_stroke_color", tj.BackgroundStrokeColor),
+		CanvasColor:           d.color(path+".canvas_color", tj.CanvasColor),
+		CanvasStrokeColor:     d.color(path+".canvas_stroke_color", tj.CanvasStrokeColor),
+		AxisColor:             d.color(path+".axis_color", tj.AxisColor),
+		TextColor:             d.color(path+".text_color", tj.TextColor),
+		FontSize:              tj.FontSize,
+		TitleFontSize:         tj.TitleFontSize,
+		AxisFontSize:          tj.AxisFontSize,
+		BackgroundPadding:     d.box(tj.BackgroundPadding),
+	}
+	for index, color := range tj.SeriesColors {
+		theme.SeriesColors = append(theme.SeriesColors, d.color(fmt.Sprintf("%s.series_colors[%d]", path, index), color))
+	}
+	return theme
+}
+
+func (d *chartJSONDecoder) xaxis(path string, xj *xaxisJSON) XAxis {
+	if xj == nil {
+		return XAxis{}
+	}
+	return XAxis{
+		Name:                xj.Name,
+		NameStyle:           d.style(path+".name_style", xj.NameStyle),
+		Style:               d.style(path+".style", xj.Style),
+		ValueFormatter:      d.valueFormatter(path+".value_formatter", xj.ValueFormatter),
+		Range:               d.axisRange(path+".range", xj.Range),
+		RangePaddingPercent: xj.RangePaddingPercent,
+		Window:              xj.Window,
+		TickStyle:           d.style(path+".tick_style", xj.TickStyle),
+		TickMarkLength:      xj.TickMarkLength,
+		EvenTicks:           xj.EvenTicks,
+		GridMajorStyle:      d.style(path+".grid_major_style", xj.GridMajorStyle),
+		GridMinorStyle:      d.style(path+".grid_minor_style", xj.GridMinorStyle),
+	}
+}
+
+func (d *chartJSONDecoder) yaxis(path string, yj *yaxisJSON) YAxis {
+	if yj == nil {
+		return YAxis{}
+	}
+	return YAxis{
+		Name:                yj.Name,
+		NameStyle:           d.style(path+".name_style", yj.NameStyle),
+		Style:               d.style(path+".style", yj.Style),
+		Ascending:           yj.Ascending,
+		ValueFormatter:      d.valueFormatter(path+".value_formatter", yj.ValueFormatter),
+		Range:               d.axisRange(path+".range", yj.Range),
+		RangePaddingPercent: yj.RangePaddingPercent,
+		IncludeZero:         yj.IncludeZero,
+		TickStyle:           d.style(path+".tick_style", yj.TickStyle),
+		TickMarkLength:      yj.TickMarkLength,
+		EvenTicks:           yj.EvenTicks,
+		GridMajorStyle:      d.style(path+".grid_major_style", yj.GridMajorStyle),
+		GridMinorStyle:      d.style(path+".grid_minor_style", yj.GridMinorStyle),
+	}
+}
+
+// chartJSONEncoder turns a chart into its JSON form, collecting what can't be written on the way.
+type chartJSONEncoder struct {
+	errs errorList
+}
+
+func (e *chartJSONEncoder) errorf(path, format string, args ...interface{}) {
+	e.errs = append(e.errs, fmt.Errorf("chart json; %s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (e *chartJSONEncoder) color(c drawing.Color) string {
+	if c.IsZero() {
+		return ""
+	}
+	if c.IsTransparent() {
+		return JSONColorTransparent
+	}
+	if c.A < 255 {
+		return fmt.Sprintf("%s%02x", c.Hex(), c.A)
+	}
+	return c.Hex()
+}
+
+func (e *chartJSONEncoder) box(b Box) *boxJSON {
+	if b.IsZero() {
+		return nil
+	}
+	return &boxJSON{Top: b.Top, Left: b.Left, Right: b.Right, Bottom: b.Bottom, IsSet: b.IsSet}
+}
+
+// name returns the name of a value, i.e. the name at its index in the names.
+func (e *chartJSONEncoder) name(path string, value int, names []string) string {
+	if value < 0 || value >= len(names) {
+		e.errorf(path, "can't write the value %d", value)
+		return ""
+	}
+	return names[value]
+}
+
+func (e *chartJSONEncoder) valueFormatter(path string, vf ValueFormatter) string {
+	if vf == nil {
+		return ""
+	}
+	name, ok := getValueFormatterName(vf)
+	if !ok {
+		e.errorf(path, "the value formatter isn't registered; see `RegisterValueFormatter`")
+	}
+	return name
+}
+
+func (e *chartJSONEncoder) style(path string, s Style) *styleJSON {
+	sj := &styleJSON{
+		Hidden:              s.Hidden,
+		Padding:             e.box(s.Padding),
+		ClassName:           s.ClassName,
+		StrokeWidth:         s.StrokeWidth,
+		StrokeColor:         e.color(s.StrokeColor),
+		StrokeDashArray:     s.StrokeDashArray,
+		Smooth:              s.Smooth,
+		ShowValues:          s.ShowValues,
+		ValueFormatter:      e.valueFormatter(path+".value_formatter", s.ValueFormatter),
+		ShowTooltips:        s.ShowTooltips,
+		DotColor:            e.color(s.DotColor),
+		DotWidth:            s.DotWidth,
+		FillColor:           e.color(s.FillColor),
+		FontSize:            s.FontSize,
+		FontColor:           e.color(s.FontColor),
+		FontName:            s.FontName,
+		TextHorizontalAlign: e.name(path+".text_horizontal_align", int(s.TextHorizontalAlign), jsonTextHorizontalAligns),
+		TextVerticalAlign:   e.name(path+".text_vertical_align", int(s.TextVerticalAlign), jsonTextVerticalAligns),
+		TextWrap:            e.name(path+".text_wrap", int(s.TextWrap), jsonTextWraps),
+		TextLineSpacing:     s.TextLineSpacing,
+		TextRotationDegrees: s.TextRotationDegrees,
+		TickLabelSpacing:    s.TickLabelSpacing,
+	}
+	if reflect.DeepEqual(*sj, styleJSON{}) {
+		return nil
+	}
+	return sj
+}
+
+func (e *chartJSONEncoder) axisRange(path string, r Range) *rangeJSON {
+	switch typed := r.(type) {
+	case nil:
+		return nil
+	case *ContinuousRange:
+		return &rangeJSON{Type: JSONRangeContinuous, Min: typed.Min, Max: typed.Max, Descending: typed.Descending}
+	case *LogarithmicRange:
+		return &rangeJSON{Type: JSONRangeLogarithmic, Min: typed.Min, Max: typed.Max, Descending: typed.Descending}
+	case *TimeRange:
+		return &rangeJSON{Type: JSONRangeTime, Min: typed.Min, Max: typed.Max, Descending: typed.Descending}
+	default:
+		e.errorf(path, "can't write a range of type %T", r)
+		return nil
+	}
+}
+
+func (e *chartJSONEncoder) theme(theme *Theme) *themeJSON {
+	if theme == nil {
+		return nil
+	}
+	tj := &themeJSON{
+		BackgroundColor:       e.color(theme.BackgroundColor),
+		BackgroundStrokeColor: e.color(theme.BackgroundStrokeColor),
+		CanvasColor:           e.color(theme.CanvasColor),
+		CanvasStrokeColor:     e.color(theme.CanvasStrokeColor),
+		AxisColor:             e.color(theme.AxisColor),
+		TextColor:             e.color(theme.TextColor),
+		FontSize:              theme.FontSize,
+		TitleFontSize:         theme.TitleFontSize,
+		AxisFontSize:          theme.AxisFontSize,
+		BackgroundPadding:     e.box(theme.BackgroundPadding),
+	}
+	for _, color := range theme.SeriesColors {
+		tj.SeriesColors = append(tj.SeriesColors, e.color(color))
+	}
+	return tj
+}
+
+func (e *chartJSONEncoder) xaxis(path string, xa XAxis) *xaxisJSON {
+	xj := &xaxisJSON{
+		axisJSON: axisJSON{
+			Name:                xa.Name,
+			NameStyle:           e.style(path+".name_style", xa.NameStyle),
+			Style:               e.style(path+".style", xa.Style),
+			ValueFormatter:      e.valueFormatter(path+".value_formatter", xa.ValueFormatter),
+			Range:               e.axisRange(path+".range", xa.Range),
+			RangePaddingPercent: xa.RangePaddingPercent,
+			TickStyle:           e.style(path+".tick_style", xa.TickStyle),
+			TickMarkLength:      xa.TickMarkLength,
+			EvenTicks:           xa.EvenTicks,
+			GridMajorStyle:      e.style(path+".grid_major_style", xa.GridMajorStyle),
+			GridMinorStyle:      e.style(path+".grid_minor_style", xa.GridMinorStyle),
+		},
+		Window: xa.Window,
+	}
+	if reflect.DeepEqual(*xj, xaxisJSON{}) {
+		return nil
+	}
+	return xj
+}
+
+func (e *chartJSONEncoder) yaxis(path string, ya YAxis) *yaxisJSON {
+	yj := &yaxisJSON{
+		axisJSON: axisJSON{
+			Name:                ya.Name,
+			NameStyle:           e.style(path+".name_style", ya.NameStyle),
+			Style:               e.style(path+".style", ya.Style),
+			ValueFormatter:      e.valueFormatter(path+".value_formatter", ya.ValueFormatter),
+			Range:               e.axisRange(path+".range", ya.Range),
+			RangePaddingPercent: ya.RangePaddingPercent,
+			TickStyle:           e.style(path+".tick_style", ya.TickStyle),
+			TickMarkLength:      ya.TickMarkLength,
+			EvenTicks:           ya.EvenTicks,
+			GridMajorStyle:      e.style(path+".grid_major_style", ya.GridMajorStyle),
+			GridMinorStyle:      e.style(path+".grid_minor_style", ya.GridMinorStyle),
+		},
+		Ascending:   ya.Ascending,
+		IncludeZero: ya.IncludeZero,
+	}
+	if reflect.DeepEqual(*yj, yaxisJSON{}) {
+		return nil
+	}
+	return yj
+}
@@ -0,0 +1,249 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+const chartJSONTestConfig = `{
+  "title": "Requests",
+  "title_style": {
+    "font_size": 14,
+    "font_color": "#333333"
+  },
+  "subtitle": "last hour",
+  "theme": {
+    "canvas_color": "#fafafa",
+    "series_colors": ["#1f77b4", "#ff7f0e80"],
+    "axis_font_size": 9,
+    "background_padding": {"top": 20, "left": 10, "right": 10, "bottom": 10, "is_set": true}
+  },
+  "width": 800,
+  "height": 400,
+  "background": {
+    "fill_color": "transparent",
+    "padding": {"is_set": true}
+  },
+  "x_axis": {
+    "name": "Time",
+    "value_formatter": "time_minute",
+    "tick_style": {"text_rotation_degrees": 45, "text_horizontal_align": "right", "tick_label_spacing": 40},
+    "window": true
+  },
+  "y_axis": {
+    "name": "Errors",
+    "name_style": {"class_name": "axis-name"},
+    "value_formatter": "percent",
+    "range": {"type": "continuous", "min": 0, "max": 1},
+    "grid_major_style": {"stroke_width": 1, "stroke_color": "#cccccc", "stroke_dash_array": [5, 2]},
+    "include_zero": true
+  },
+  "y_axis_secondary": {
+    "range": {"type": "logarithmic", "min": 1, "max": 10000, "descending": true}
+  },
+  "series": ["errors", "latency"]
+}`
+
+func chartJSONTestSeries() map[string]Series {
+	return map[string]Series{
+		"errors": ContinuousSeries{
+			Name:    "errors",
+			Style:   Style{StrokeWidth: 2, ShowTooltips: true, Smooth: true},
+			XValues: LinearRange(0, 10),
+			YValues: LinearRange(0, 0.5),
+		},
+		"latency": ContinuousSeries{
+			Name:    "latency",
+			YAxis:   YAxisSecondary,
+			XValues: LinearRange(0, 10),
+			YValues: LinearRange(10, 1000),
+		},
+	}
+}
+
+// assertJSONEqual compares JSON documents by their values, regardless of their layout.
+func assertJSONEqual(assert *assert.Assertions, expected, actual []byte) {
+	var expectedValue, actualValue interface{}
+	assert.Nil(json.Unmarshal(expected, &expectedValue))
+	assert.Nil(json.Unmarshal(actual, &actualValue))
+	assert.Equal(expectedValue, actualValue)
+}
+
+func TestChartFromJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := ChartFromJSON([]byte(chartJSONTestConfig), chartJSONTestSeries())
+	assert.Nil(err)
+	assert.Equal("Requests", c.Title)
+	assert.Equal(drawing.ColorFromHex("333333"), c.TitleStyle.FontColor)
+	assert.Equal(800, c.Width)
+	assert.Equal(ColorTransparent, c.Background.FillColor)
+	assert.Equal(BoxZero, c.Background.Padding)
+
+	assert.NotNil(c.Theme)
+	assert.Equal(drawing.ColorFromHex("fafafa"), c.Theme.CanvasColor)
+	assert.Equal([]drawing.Color{drawing.ColorFromHex("1f77b4"), drawing.ColorFromHex("ff7f0e").WithAlpha(0x80)}, c.Theme.SeriesColors)
+	assert.Equal(Box{Top: 20, Left: 10, Right: 10, Bottom: 10, IsSet: true}, c.Theme.BackgroundPadding)
+
+	assert.Equal("Time", c.XAxis.Name)
+	assert.Equal(TimeMinuteValueFormatter(1e9), c.XAxis.ValueFormatter(1e9))
+	assert.Equal(45.0, c.XAxis.TickStyle.TextRotationDegrees)
+	assert.Equal(40, c.XAxis.TickStyle.TickLabelSpacing)
+	assert.Equal(TextHorizontalAlignRight, c.XAxis.TickStyle.TextHorizontalAlign)
+	assert.True(c.XAxis.Window)
+
+	assert.Equal("50.00%", c.YAxis.ValueFormatter(0.5))
+	assert.Equal(&ContinuousRange{Min: 0, Max: 1}, c.YAxis.Range)
+	assert.Equal([]float64{5, 2}, c.YAxis.GridMajorStyle.StrokeDashArray)
+	assert.True(c.YAxis.IncludeZero)
+	assert.Equal(&LogarithmicRange{Min: 1, Max: 10000, Descending: true}, c.YAxisSecondary.Range)
+
+	assert.Len(c.Series, 2)
+	assert.Equal("errors", c.Series[0].GetName())
+	assert.Equal("latency", c.Series[1].GetName())
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	assert.Contains(buffer.String(), ">Requests</text>")
+	assert.Contains(buffer.String(), ">40.00%</text>")
+}
+
+func TestChartJSONRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := ChartFromJSON([]byte(chartJSONTestConfig), chartJSONTestSeries())
+	assert.Nil(err)
+	data, err := ChartToJSON(*c)
+	assert.Nil(err)
+	assertJSONEqual(assert, []byte(chartJSONTestConfig), data)
+
+	again, err := ChartFromJSON(data, chartJSONTestSeries())
+	assert.Nil(err)
+	againData, err := ChartToJSON(*again)
+	assert.Nil(err)
+	assert.Equal(string(data), string(againData))
+
+	// a chart written in Go comes back the same.
+	original := Chart{
+		Title:      "Memory",
+		TitleStyle: Style{Hidden: true, FontName: "Go", TextVerticalAlign: TextVerticalAlignMiddleBaseline, TextWrap: TextWrapWord, TextLineSpacing: 3},
+		DPI:        144,
+		Canvas:     Style{FillColor: drawing.ColorFromHex("eeeeee"), StrokeColor: ColorTransparent},
+		XAxis: XAxis{
+			ValueFormatter: TimeHourValueFormatter,
+			Range:          &TimeRange{Min: 0, Max: 3600e9},
+			EvenTicks:      true,
+			TickMarkLength: Disabled,
+		},
+		YAxis: YAxis{
+			Style:          Style{Padding: Box{Left: 4}, FontSize: 8},
+			ValueFormatter: BytesValueFormatter,
+			Ascending:      true,
+		},
+	}
+	data, err = ChartToJSON(original)
+	assert.Nil(err)
+	read, err := ChartFromJSON(data, nil)
+	assert.Nil(err)
+	assert.Equal(original.Title, read.Title)
+	assert.Equal(original.TitleStyle, read.TitleStyle)
+	assert.Equal(original.DPI, read.DPI)
+	assert.Equal(original.Canvas, read.Canvas)
+	assert.Equal(original.XAxis.Range, read.XAxis.Range)
+	assert.Equal(original.XAxis.EvenTicks, read.XAxis.EvenTicks)
+	assert.Equal(original.XAxis.TickMarkLength, read.XAxis.TickMarkLength)
+	assert.Equal(original.XAxis.ValueFormatter(1800e9), read.XAxis.ValueFormatter(1800e9))
+	assert.Equal(original.YAxis.ValueFormatter(2048.0), read.YAxis.ValueFormatter(2048.0))
+	assert.Equal(Box{Left: 4}, read.YAxis.Style.Padding)
+	assert.Equal(original.YAxis.Style.FontSize, read.YAxis.Style.FontSize)
+	assert.True(read.YAxis.Ascending)
+	assert.Nil(read.Theme)
+}
+
+func TestChartJSONRoundTripPadding(t *testing.T) {
+	assert := assert.New(t)
+
+	// a partial padding inherits its other sides, and a set one is used as it is, before and after the round trip.
+	for _, padding := range []Box{{Top: 50}, NewBox(50, 0, 0, 0), BoxZero} {
+		original := Chart{Width: 400, Height: 300, Background: Style{Padding: padding}}
+		data, err := ChartToJSON(original)
+		assert.Nil(err)
+		read, err := ChartFromJSON(data, nil)
+		assert.Nil(err)
+		assert.Equal(original.Box(), read.Box(), padding.String())
+	}
+	assert.Equal(Box{Top: 50, Left: 5, Right: 395, Bottom: 295}, Chart{Width: 400, Height: 300, Background: Style{Padding: Box{Top: 50}}}.Box())
+}
+
+func TestChartFromJSONErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	series := chartJSONTestSeries()
+	_, err := ChartFromJSON([]byte(`{"title": "t", "colour": "#fff"}`), series)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "colour")
+
+	_, err = ChartFromJSON([]byte(`{"canvas": {"fill_colour": "#fff"}}`), series)
+	assert.NotNil(err)
+
+	_, err = ChartFromJSON([]byte(`{"title": "t"} {}`), series)
+	assert.NotNil(err)
+
+	_, err = ChartFromJSON([]byte(`{"canvas": {"fill_color": "red"}, "y_axis": {"grid_major_style": {"stroke_color": "#12345"}}}`), series)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "canvas.fill_color")
+	assert.Contains(err.Error(), "y_axis.grid_major_style.stroke_color")
+
+	_, err = ChartFromJSON([]byte(`{"x_axis": {"value_formatter": "fahrenheit"}}`), series)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "fahrenheit")
+
+	_, err = ChartFromJSON([]byte(`{"y_axis": {"range": {"type": "square_root", "min": 0, "max": 1}}}`), series)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "square_root")
+
+	_, err = ChartFromJSON([]byte(`{"title_style": {"text_horizontal_align": "justify"}}`), series)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "title_style.text_horizontal_align")
+
+	_, err = ChartFromJSON([]byte(`{"series": ["errors", "saturation"]}`), series)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "saturation")
+
+	// the x axis has no include zero.
+	_, err = ChartFromJSON([]byte(`{"x_axis": {"include_zero": true}}`), series)
+	assert.NotNil(err)
+}
+
+func TestChartToJSONErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := ChartToJSON(Chart{Series: []Series{ContinuousSeries{XValues: []float64{0, 1}, YValues: []float64{0, 1}}}})
+	assert.NotNil(err)
+
+	_, err = ChartToJSON(Chart{YAxis: YAxis{ValueFormatter: func(v interface{}) string { return "" }}})
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "y_axis.value_formatter")
+}
+
+func TestRegisterValueFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(RegisterValueFormatter("", FloatValueFormatter))
+	assert.NotNil(RegisterValueFormatter("celsius", nil))
+
+	celsius := func(v interface{}) string { return fmt.Sprintf("%v°C", v) }
+	assert.Nil(RegisterValueFormatter("celsius", celsius))
+	assert.Equal("20°C", GetRegisteredValueFormatter("celsius")(20))
+
+	c, err := ChartFromJSON([]byte(`{"y_axis": {"value_formatter": "celsius"}}`), nil)
+	assert.Nil(err)
+	data, err := ChartToJSON(*c)
+	assert.Nil(err)
+	assertJSONEqual(assert, []byte(`{"y_axis": {"value_formatter": "celsius"}}`), data)
+}
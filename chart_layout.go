@@ -0,0 +1,133 @@
+package chart
+
+import "math"
+
+// ChartLayout is where a chart draws its series, and the values it draws there; e.g. to map the mouse over
+// an image of the chart back to values. See `Chart.Measure`.
+type ChartLayout struct {
+	// Canvas is the box the series are drawn in.
+	Canvas Box
+	// XRange, YRange and YRangeSecondary translate values to pixels from the left and bottom of the canvas.
+	XRange          Range
+	YRange          Range
+	YRangeSecondary Range
+	// Series are the points drawn of each of the series of the chart, in the same order.
+	Series []SeriesLayout
+	// Tolerance is how far in x a point is found from a pixel by `ValueAtPixel`;
+	// it defaults to `DefaultValueAtPixelTolerance`.
+	Tolerance float64
+}
+
+// SeriesLayout is the points drawn of a series.
+type SeriesLayout struct {
+	Name  string
+	YAxis YAxisType
+	// Points are the values of the series within the canvas, downsampled if the series is; values that
+	// aren't finite and hidden series have none.
+	Points []PointLayout
+}
+
+// PointLayout is a value of a series and where it's drawn on the chart.
+type PointLayout struct {
+	XValue, YValue float64
+	X, Y           float64
+}
+
+// GetTolerance returns the tolerance or the default value.
+func (cl ChartLayout) GetTolerance() float64 {
+	if cl.Tolerance == 0 {
+		return DefaultValueAtPixelTolerance
+	}
+	return cl.Tolerance
+}
+
+// Nearest returns the series and point drawn nearest to a position on the chart, or false if there are none.
+func (cl ChartLayout) Nearest(x, y float64) (seriesIndex, pointIndex int, ok bool) {
+	nearest := math.MaxFloat64
+	for si, s := range cl.Series {
+		for pi, p := range s.Points {
+			if distance := math.Hypot(p.X-x, p.Y-y); distance < nearest {
+				nearest, seriesIndex, pointIndex, ok = distance, si, pi, true
+			}
+		}
+	}
+	return
+}
+
+// ValueAtPixel returns the point drawn nearest in x to a pixel of the chart, and its values, the way a chart is
+// usually hovered; points as near in x are told apart by the distance in y. Only points within the tolerance in x
+// are found, and pixels outside of the canvas have none.
+func (cl ChartLayout) ValueAtPixel(px, py int) (seriesIndex, pointIndex int, x, y float64, ok bool) {
+	if px < cl.Canvas.Left || px > cl.Canvas.Right || py < cl.Canvas.Top || py > cl.Canvas.Bottom {
+		return
+	}
+	nearestX, nearestY := cl.GetTolerance(), math.MaxFloat64
+	for si, s := range cl.Series {
+		for pi, p := range s.Points {
+			dx, dy := math.Abs(p.X-float64(px)), math.Abs(p.Y-float64(py))
+			if dx < nearestX || (dx == nearestX && dy < nearestY) {
+				nearestX, nearestY = dx, dy
+				seriesIndex, pointIndex, x, y, ok = si, pi, p.XValue, p.YValue, true
+			}
+		}
+	}
+	return
+}
+
+// drawnValuesProvider is a series that draws other values than it provides, e.g. fewer of them.
+type drawnValuesProvider interface {
+	getDrawnValues(canvasBox Box) ValuesProvider
+}
+
+// Measure lays out the chart the way `Render` would with a renderer provider, without drawing it, and returns
+// where it draws its series. The layout is in the units of the chart width and height, whatever the scale factor.
+func (c Chart) Measure(rp RendererProvider) (ChartLayout, error) {
+	if err := c.Validate(); err != nil {
+		return ChartLayout{}, err
+	}
+	r, err := rp(c.GetWidth(), c.GetHeight())
+	if err != nil {
+		return ChartLayout{}, err
+	}
+	if err := c.prepare(r); err != nil {
+		return ChartLayout{}, err
+	}
+	canvasBox, xr, yr, yra, _, _, _, err := c.layout(r)
+	if err != nil {
+		return ChartLayout{}, err
+	}
+
+	layout := ChartLayout{Canvas: canvasBox, XRange: xr, YRange: yr, YRangeSecondary: yra}
+	for _, s := range c.Series {
+		layout.Series = append(layout.Series, c.getSeriesLayout(canvasBox, xr, yr, yra, s))
+	}
+	return layout, nil
+}
+
+// getSeriesLayout returns the points drawn of a series, the way the dots of a line are placed.
+func (c Chart) getSeriesLayout(canvasBox Box, xrange, yrange, yrangeAlt Range, s Series) SeriesLayout {
+	layout := SeriesLayout{Name: s.GetName(), YAxis: s.GetYAxis()}
+	vs, isValuesProvider := s.(ValuesProvider)
+	if !isValuesProvider || s.GetStyle().Hidden {
+		return layout
+	}
+	if typed, isTyped := s.(drawnValuesProvider); isTyped {
+		vs = typed.getDrawnValues(canvasBox)
+	}
+	if s.GetYAxis() == YAxisSecondary {
+		yrange = yrangeAlt
+	}
+
+	for index := 0; index < vs.Len(); index++ {
+		vx, vy := vs.GetValues(index)
+		if !isFinite(vx) || !isFinite(vy) {
+			continue
+		}
+		x, y := float64(canvasBox.Left)+translateFloat(xrange, vx), float64(canvasBox.Bottom)-translateFloat(yrange, vy)
+		if outcode(canvasBox, x, y) != 0 {
+			continue
+		}
+		layout.Points = append(layout.Points, PointLayout{XValue: vx, YValue: vy, X: x, Y: y})
+	}
+	return layout
+}
@@ -0,0 +1,97 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestChartMeasure(t *testing.T) {
+	assert := assert.New(t)
+
+	many := ContinuousSeries{XValues: LinearRange(0, 9999), YValues: LinearRange(0, 9999)}
+	graph := Chart{
+		Width:          400,
+		Height:         300,
+		YAxisSecondary: YAxis{Style: Shown()},
+		Series: []Series{
+			ContinuousSeries{Style: Style{DotWidth: 2}, XValues: []float64{0, 2500, 5000, math.NaN(), 10000}, YValues: []float64{1, 2, 3, 4, 5}},
+			DownsampledSeries{Inner: many, Threshold: 50},
+			ContinuousSeries{YAxis: YAxisSecondary, XValues: []float64{0, 10000}, YValues: []float64{-5, 5}},
+			ContinuousSeries{Style: Hidden(), XValues: []float64{0, 10000}, YValues: []float64{0, 1}},
+		},
+	}
+
+	layout, err := graph.Measure(SVG)
+	assert.Nil(err)
+	assert.Len(layout.Series, 4)
+	assert.Len(layout.Series[0].Points, 4)
+	assert.True(len(layout.Series[1].Points) <= 50)
+	assert.Equal(YAxisSecondary, layout.Series[2].YAxis)
+	assert.Empty(layout.Series[3].Points)
+	for _, p := range layout.Series[2].Points {
+		assert.True(p.X >= float64(layout.Canvas.Left) && p.X <= float64(layout.Canvas.Right))
+		assert.True(p.Y >= float64(layout.Canvas.Top) && p.Y <= float64(layout.Canvas.Bottom))
+	}
+
+	// the points are where the dots are drawn.
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(SVG, buffer))
+	for _, p := range layout.Series[0].Points {
+		assert.Contains(buffer.String(), fmt.Sprintf(`<circle cx="%s" cy="%s"`, formatCoordinate(p.X), formatCoordinate(p.Y)))
+	}
+
+	last := layout.Series[0].Points[3]
+	seriesIndex, pointIndex, ok := layout.Nearest(last.X+1, last.Y-10)
+	assert.True(ok)
+	assert.Equal(0, seriesIndex)
+	assert.Equal(3, pointIndex)
+	assert.Equal(10000.0, last.XValue)
+
+	_, _, ok = ChartLayout{}.Nearest(0, 0)
+	assert.False(ok)
+	_, err = Chart{}.Measure(SVG)
+	assert.NotNil(err)
+}
+
+func TestChartLayoutValueAtPixel(t *testing.T) {
+	assert := assert.New(t)
+
+	layout := ChartLayout{
+		Canvas: Box{Top: 10, Left: 10, Right: 110, Bottom: 110},
+		Series: []SeriesLayout{
+			{Points: []PointLayout{{XValue: 1, YValue: 10, X: 20, Y: 100}, {XValue: 2, YValue: 20, X: 50, Y: 60}}},
+			{Points: []PointLayout{{XValue: 2, YValue: 5, X: 50, Y: 90}, {XValue: 3, YValue: 6, X: 80, Y: 20}}},
+		},
+	}
+
+	// the nearest in x is preferred over the nearest overall.
+	seriesIndex, pointIndex, x, y, ok := layout.ValueAtPixel(77, 100)
+	assert.True(ok)
+	assert.Equal(1, seriesIndex)
+	assert.Equal(1, pointIndex)
+	assert.Equal(3.0, x)
+	assert.Equal(6.0, y)
+
+	// points as near in x are told apart in y.
+	seriesIndex, pointIndex, _, y, ok = layout.ValueAtPixel(51, 85)
+	assert.True(ok)
+	assert.Equal(1, seriesIndex)
+	assert.Equal(0, pointIndex)
+	assert.Equal(5.0, y)
+	seriesIndex, _, _, _, ok = layout.ValueAtPixel(51, 65)
+	assert.True(ok)
+	assert.Equal(0, seriesIndex)
+
+	// pixels too far from any point in x, or outside the canvas, have none.
+	_, _, _, _, ok = layout.ValueAtPixel(35, 60)
+	assert.False(ok)
+	layout.Tolerance = 20
+	_, _, _, _, ok = layout.ValueAtPixel(35, 60)
+	assert.True(ok)
+	_, _, _, _, ok = layout.ValueAtPixel(5, 100)
+	assert.False(ok)
+}
@@ -2,9 +2,12 @@ package chart
 
 import (
 	"bytes"
+	"fmt"
 	"image"
 	"image/png"
 	"math"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -267,8 +270,20 @@ func TestChartGetValueFormatters(t *testing.T) {
 
 	dxf, dyf, dyaf := c.getValueFormatters()
 	assert.NotNil(dxf)
-	assert.NotNil(dyf)
+	// series without a y formatter leave the precision to the y axes.
+	assert.Nil(dyf)
+	assert.Nil(dyaf)
+
+	c.Series = append(c.Series, ContinuousSeries{
+		YAxis:           YAxisSecondary,
+		XValues:         []float64{1.0, 2.0},
+		YValues:         []float64{1.0, 2.0},
+		YValueFormatter: FloatValueFormatter,
+	})
+	_, dyf, dyaf = c.getValueFormatters()
+	assert.Nil(dyf)
 	assert.NotNil(dyaf)
+	assert.Equal("1.00", dyaf(1.0))
 }
 
 func TestChartHasAxes(t *testing.T) {
@@ -574,3 +589,1235 @@ func TestChartE2ELineWithFill(t *testing.T) {
 	assert.Equal(defaultSeriesColor, at(i, 0, 49))
 	assert.Equal(defaultSeriesColor, at(i, 49, 0))
 }
+
+func TestChartE2ELineWithFillNegative(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Height: 50,
+		Width:  50,
+		Background: Style{
+			Padding: Box{Top: 10, Left: 10, Right: 10, Bottom: 10},
+		},
+		TitleStyle:     Hidden(),
+		XAxis:          HideXAxis(),
+		YAxis:          HideYAxis(),
+		YAxisSecondary: HideYAxis(),
+		Series: []Series{
+			ContinuousSeries{
+				Style: Style{
+					StrokeColor: drawing.ColorBlue,
+					FillColor:   drawing.ColorRed,
+				},
+				XValues: LinearRangeWithStep(0, 4, 1),
+				YValues: LinearRangeWithStep(-5, -1, 1),
+			},
+		},
+	}
+
+	var buffer = &bytes.Buffer{}
+	err := c.Render(PNG, buffer)
+	assert.Nil(err)
+
+	i, err := png.Decode(buffer)
+	assert.Nil(err)
+
+	// the fill should stop at the top of the canvas, not spill into the background padding.
+	assert.Equal(drawing.ColorWhite, at(i, 25, 5))
+	assert.Equal(drawing.ColorRed, at(i, 15, 15))
+}
+
+func TestChartYAxisPositionLeft(t *testing.T) {
+	assert := assert.New(t)
+
+	series := []Series{
+		ContinuousSeries{
+			XValues: LinearRange(1.0, 10.0),
+			YValues: LinearRange(1.0, 10.0),
+		},
+	}
+
+	adjustedCanvasBox := func(c Chart) Box {
+		r, err := PNG(c.GetWidth(), c.GetHeight())
+		assert.Nil(err)
+		c.defaultFont, _ = GetDefaultFont()
+
+		xr, yr, yra := c.getRanges()
+		canvasBox := c.getDefaultCanvasBox()
+		xf, yf, yfa := c.getValueFormatters()
+		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		xt, yt, yta := c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		return c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+	}
+
+	right := adjustedCanvasBox(Chart{Series: series})
+	left := adjustedCanvasBox(Chart{YAxis: YAxis{Position: YAxisPositionLeft}, Series: series})
+
+	assert.True(left.Left > right.Left)
+	assert.True(left.Right > right.Right)
+
+	c := Chart{YAxis: YAxis{Position: YAxisPositionLeft}, Series: series}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+}
+
+func TestChartAxesReserveOwnSpace(t *testing.T) {
+	assert := assert.New(t)
+
+	series := []Series{
+		ContinuousSeries{
+			XValues: LinearRange(1.0, 10.0),
+			YValues: LinearRange(1000.0, 10000.0),
+		},
+	}
+
+	adjustedCanvasBox := func(c Chart) Box {
+		r, err := PNG(c.GetWidth(), c.GetHeight())
+		assert.Nil(err)
+		c.defaultFont, _ = GetDefaultFont()
+
+		xr, yr, yra := c.getRanges()
+		canvasBox := c.getDefaultCanvasBox()
+		xf, yf, yfa := c.getValueFormatters()
+		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		xt, yt, yta := c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		return c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+	}
+
+	full := Chart{Series: series}.getDefaultCanvasBox()
+	both := adjustedCanvasBox(Chart{Series: series})
+
+	xOnly := adjustedCanvasBox(Chart{YAxis: HideYAxis(), Series: series})
+	assert.Equal(both.Bottom, xOnly.Bottom)
+	// the x axis only keeps room for its last label.
+	assert.True(xOnly.Right > both.Right)
+	assert.True(xOnly.Right <= full.Right)
+
+	yOnly := adjustedCanvasBox(Chart{XAxis: HideXAxis(), Series: series})
+	assert.Equal(both.Right, yOnly.Right)
+	// the y axis only keeps room for half of its bottom label.
+	assert.True(yOnly.Bottom > both.Bottom)
+	assert.True(yOnly.Bottom <= full.Bottom)
+
+	// a smaller y axis font only narrows the y axis.
+	small := adjustedCanvasBox(Chart{YAxis: YAxis{Style: Style{FontSize: 6}}, Series: series})
+	assert.True(small.Right > both.Right)
+	assert.Equal(both.Bottom, small.Bottom)
+}
+
+func TestChartXAxisDescending(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		XAxis: XAxis{
+			Range: &ContinuousRange{Descending: true},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: LinearRange(1.0, 10.0),
+				YValues: LinearRange(1.0, 10.0),
+			},
+		},
+	}
+
+	r, err := PNG(c.GetWidth(), c.GetHeight())
+	assert.Nil(err)
+	c.defaultFont, _ = GetDefaultFont()
+
+	xr, yr, yra := c.getRanges()
+	assert.True(xr.IsDescending())
+	assert.Equal(1.0, xr.GetMin())
+	assert.Equal(10.0, xr.GetMax())
+
+	canvasBox := c.getDefaultCanvasBox()
+	xf, yf, yfa := c.getValueFormatters()
+	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+	xt, _, _ := c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+
+	// the ticks are laid out left to right in the same order the data is drawn.
+	assert.NotEmpty(xt)
+	for index := 1; index < len(xt); index++ {
+		assert.True(xt[index].Value < xt[index-1].Value)
+		assert.True(xr.Translate(xt[index].Value) > xr.Translate(xt[index-1].Value))
+	}
+}
+
+func TestChartGetRangesDegenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		XValues []float64
+		YValues []float64
+	}{
+		{XValues: []float64{1.0, 2.0, 3.0}, YValues: []float64{3.0, 3.0, 3.0}},
+		{XValues: []float64{2.0}, YValues: []float64{3.0}},
+		{XValues: []float64{2.0, 2.0, 2.0}, YValues: []float64{0.0, 0.0, 0.0}},
+	}
+
+	for _, tc := range testCases {
+		c := Chart{
+			Series: []Series{
+				ContinuousSeries{
+					XValues: tc.XValues,
+					YValues: tc.YValues,
+				},
+			},
+		}
+
+		xr, yr, yra := c.getRanges()
+		assert.NotZero(xr.GetDelta())
+		assert.NotZero(yr.GetDelta())
+		assert.True(xr.GetMin() <= tc.XValues[0] && xr.GetMax() >= tc.XValues[0])
+		assert.True(yr.GetMin() <= tc.YValues[0] && yr.GetMax() >= tc.YValues[0])
+		assert.Nil(c.checkRanges(xr, yr, yra))
+
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(PNG, buffer))
+		assert.NotZero(buffer.Len())
+	}
+}
+
+func TestChartRenderSinglePoint(t *testing.T) {
+	assert := assert.New(t)
+
+	// a single point, with a single x tick and a y range set to its value.
+	c := Chart{
+		XAxis: XAxis{Ticks: []Tick{{Value: 2, Label: "two"}}},
+		YAxis: YAxis{Range: &ContinuousRange{Min: 3, Max: 3}},
+		Series: []Series{
+			ContinuousSeries{
+				Style:   Style{DotWidth: 3},
+				XValues: []float64{2},
+				YValues: []float64{3},
+			},
+		},
+	}
+	xr, yr, _ := c.getRanges()
+	assert.NotZero(xr.GetDelta())
+	assert.Zero(yr.GetDelta())
+
+	// the point is drawn at the middle of the canvas, rather than wherever dividing by the zero delta puts it.
+	layout, err := c.Measure(SVG)
+	assert.Nil(err)
+	assert.Len(layout.Series[0].Points, 1)
+	point := layout.Series[0].Points[0]
+	assert.Equal(float64(layout.Canvas.Left+layout.Canvas.Width()/2), point.X)
+	assert.Equal(float64(layout.Canvas.Bottom-layout.Canvas.Height()/2), point.Y)
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	assert.NotContains(buffer.String(), "NaN")
+	assert.Contains(buffer.String(), ">two</text>")
+
+	png := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, png))
+	assert.NotZero(png.Len())
+}
+
+func TestChartGetRangesSkipsNonFinite(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := [][]float64{
+		{math.NaN(), 2.0, 3.0, 4.0, 5.0},
+		{1.0, 2.0, math.NaN(), 4.0, 5.0},
+		{1.0, 2.0, 3.0, 4.0, math.NaN()},
+		{1.0, math.Inf(1), 3.0, math.Inf(-1), 5.0},
+	}
+
+	for _, yvalues := range testCases {
+		c := Chart{
+			Series: []Series{
+				ContinuousSeries{
+					XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+					YValues: yvalues,
+				},
+			},
+		}
+
+		xr, yr, yra := c.getRanges()
+		assert.False(math.IsNaN(yr.GetMin()))
+		assert.False(math.IsNaN(yr.GetMax()))
+		assert.Nil(c.checkRanges(xr, yr, yra))
+
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(PNG, buffer))
+	}
+}
+
+func TestChartE2ELineWithGap(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Height:         50,
+		Width:          50,
+		TitleStyle:     Hidden(),
+		Background:     Style{FillColor: drawing.ColorWhite},
+		Canvas:         Style{FillColor: drawing.ColorWhite},
+		XAxis:          HideXAxis(),
+		YAxis:          HideYAxis(),
+		YAxisSecondary: HideYAxis(),
+		Series: []Series{
+			ContinuousSeries{
+				Style: Style{
+					StrokeColor: drawing.ColorRed,
+					StrokeWidth: 1,
+				},
+				XValues: LinearRangeWithStep(0, 4, 1),
+				YValues: []float64{1, 1, math.NaN(), 1, 1},
+			},
+		},
+	}
+
+	var buffer = &bytes.Buffer{}
+	assert.Nil(c.Render(PNG, buffer))
+
+	i, err := png.Decode(buffer)
+	assert.Nil(err)
+
+	// the flat line sits in the middle of the canvas, and is broken around x = 2.
+	assert.NotEqual(drawing.ColorWhite, at(i, 10, 25))
+	assert.Equal(drawing.ColorWhite, at(i, 25, 25))
+	assert.NotEqual(drawing.ColorWhite, at(i, 40, 25))
+}
+
+func TestChartGetRangesSecondaryUseTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		YAxis: YAxis{
+			Ticks: []Tick{{0.0, "0"}, {1000.0, "1000"}},
+		},
+		YAxisSecondary: YAxis{
+			Ticks: []Tick{{0.0, "0%"}, {50.0, "50%"}, {100.0, "100%"}},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{100.0, 500.0, 900.0},
+			},
+			ContinuousSeries{
+				YAxis:   YAxisSecondary,
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{5.0, 25.0, 75.0},
+			},
+		},
+	}
+
+	_, yr, yra := c.getRanges()
+	assert.Equal(0.0, yr.GetMin())
+	assert.Equal(1000.0, yr.GetMax())
+	assert.Equal(0.0, yra.GetMin())
+	assert.Equal(100.0, yra.GetMax())
+}
+
+func TestChartGetRangesPadding(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		YValues  []float64
+		Min, Max float64
+	}{
+		{YValues: []float64{0.0, 5.0, 10.0}, Min: -1.0, Max: 11.0},
+		{YValues: []float64{-10.0, -5.0, 0.0}, Min: -11.0, Max: 1.0},
+		{YValues: []float64{0.0, 0.0, 0.0}, Min: -1.2, Max: 1.2},
+	}
+
+	for _, tc := range testCases {
+		c := Chart{
+			XAxis: XAxis{RangePaddingPercent: 0.5},
+			YAxis: YAxis{Style: Hidden(), RangePaddingPercent: 0.1},
+			Series: []Series{
+				ContinuousSeries{
+					XValues: []float64{1.0, 2.0, 3.0},
+					YValues: tc.YValues,
+				},
+			},
+		}
+
+		xr, yr, _ := c.getRanges()
+		assert.Equal(0.0, xr.GetMin())
+		assert.Equal(4.0, xr.GetMax())
+		assert.InDelta(tc.Min, yr.GetMin(), 0.0000001)
+		assert.InDelta(tc.Max, yr.GetMax(), 0.0000001)
+	}
+
+	// user supplied ranges aren't padded.
+	c := Chart{
+		YAxis: YAxis{
+			Range:               &ContinuousRange{Min: 0, Max: 10},
+			RangePaddingPercent: 0.1,
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+	_, yr, _ := c.getRanges()
+	assert.Equal(0.0, yr.GetMin())
+	assert.Equal(10.0, yr.GetMax())
+}
+
+func TestChartGetRangesIncludeZero(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		YValues  []float64
+		Padding  float64
+		Min, Max float64
+	}{
+		{YValues: []float64{950.0, 975.0, 1000.0}, Min: 0.0, Max: 1000.0},
+		{YValues: []float64{950.0, 975.0, 1000.0}, Padding: 0.1, Min: 0.0, Max: 1005.0},
+		{YValues: []float64{0.0, 5.0, 10.0}, Padding: 0.1, Min: 0.0, Max: 11.0},
+		{YValues: []float64{-1000.0, -975.0, -950.0}, Min: -1000.0, Max: 0.0},
+		{YValues: []float64{-5.0, 0.0, 5.0}, Min: -5.0, Max: 5.0},
+	}
+
+	for _, tc := range testCases {
+		c := Chart{
+			YAxis: YAxis{Style: Hidden(), IncludeZero: true, RangePaddingPercent: tc.Padding},
+			Series: []Series{
+				ContinuousSeries{
+					XValues: []float64{1.0, 2.0, 3.0},
+					YValues: tc.YValues,
+				},
+			},
+		}
+
+		_, yr, _ := c.getRanges()
+		assert.InDelta(tc.Min, yr.GetMin(), 0.0000001)
+		assert.InDelta(tc.Max, yr.GetMax(), 0.0000001)
+	}
+
+	// user supplied ranges are left alone.
+	c := Chart{
+		YAxis: YAxis{
+			Range:       &ContinuousRange{Min: 900, Max: 1000},
+			IncludeZero: true,
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{950.0, 975.0, 1000.0},
+			},
+		},
+	}
+	_, yr, _ := c.getRanges()
+	assert.Equal(900.0, yr.GetMin())
+}
+
+func TestChartE2ELineDashed(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(dashArray []float64) []byte {
+		c := Chart{
+			Height:         50,
+			Width:          100,
+			TitleStyle:     Hidden(),
+			Background:     Style{FillColor: drawing.ColorWhite},
+			Canvas:         Style{FillColor: drawing.ColorWhite},
+			XAxis:          HideXAxis(),
+			YAxis:          HideYAxis(),
+			YAxisSecondary: HideYAxis(),
+			Series: []Series{
+				ContinuousSeries{
+					Style: Style{
+						StrokeColor:     drawing.ColorRed,
+						StrokeWidth:     2,
+						StrokeDashArray: dashArray,
+					},
+					XValues: []float64{0, 1},
+					YValues: []float64{1, 1},
+				},
+			},
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(PNG, buffer))
+		return buffer.Bytes()
+	}
+
+	// an empty dash array is a solid line.
+	solid := render(nil)
+	assert.Equal(solid, render([]float64{}))
+
+	dashed := render(DashArrayDashesLarge)
+	assert.NotEqual(solid, dashed)
+
+	i, err := png.Decode(bytes.NewReader(dashed))
+	assert.Nil(err)
+
+	var stroked, gaps int
+	for x := 10; x < 90; x++ {
+		if at(i, x, 25) == drawing.ColorWhite {
+			gaps++
+		} else {
+			stroked++
+		}
+	}
+	assert.NotZero(stroked)
+	assert.NotZero(gaps)
+}
+
+func TestChartScatter(t *testing.T) {
+	assert := assert.New(t)
+
+	xvalues := make([]float64, 10000)
+	yvalues := make([]float64, 10000)
+	for index := range xvalues {
+		xvalues[index] = float64(index)
+		yvalues[index] = math.Sin(float64(index)) * float64(index%100)
+	}
+
+	series := ContinuousSeries{
+		Style: Style{
+			StrokeWidth: Disabled,
+			DotWidth:    2,
+		},
+		XValues: xvalues,
+		YValues: yvalues,
+	}
+
+	c := Chart{
+		XAxis: HideXAxis(),
+		YAxis: HideYAxis(),
+		Series: []Series{
+			series,
+			LastValueAnnotationSeries(series),
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	buffer.Reset()
+	assert.Nil(c.Render(SVG, buffer))
+
+	// one circle per point, and besides the background and canvas
+	// the only path drawn is the last value annotation.
+	raw := buffer.String()
+	assert.Equal(10000, strings.Count(raw, "<circle"))
+	assert.Equal(3, strings.Count(raw, "<path"))
+}
+
+func TestChartE2ELineSmooth(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(smooth bool) string {
+		c := Chart{
+			Height:         50,
+			Width:          100,
+			TitleStyle:     Hidden(),
+			XAxis:          HideXAxis(),
+			YAxis:          HideYAxis(),
+			YAxisSecondary: HideYAxis(),
+			Series: []Series{
+				ContinuousSeries{
+					Style: Style{
+						StrokeColor: drawing.ColorRed,
+						StrokeWidth: 1,
+						Smooth:      smooth,
+					},
+					XValues: []float64{0, 1, 2, 3},
+					YValues: []float64{0, 3, 1, 2},
+				},
+			},
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(SVG, buffer))
+		return buffer.String()
+	}
+
+	// a smoothed line is flattened into many more segments than the straight one.
+	straight := render(false)
+	smooth := render(true)
+	assert.True(strings.Count(smooth, "L ") > 2*strings.Count(straight, "L "))
+}
+
+func TestChartE2EShowValues(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(style Style) string {
+		c := Chart{
+			Height:         100,
+			Width:          200,
+			TitleStyle:     Hidden(),
+			XAxis:          HideXAxis(),
+			YAxis:          HideYAxis(),
+			YAxisSecondary: HideYAxis(),
+			Series: []Series{
+				ContinuousSeries{
+					Style:   style,
+					XValues: []float64{0, 1, 2, 3},
+					YValues: []float64{1, 3, 2, 4},
+				},
+			},
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(SVG, buffer))
+		return buffer.String()
+	}
+
+	assert.Equal(0, strings.Count(render(Style{}), "<text"))
+
+	shown := render(Style{ShowValues: true})
+	assert.Equal(4, strings.Count(shown, "<text"))
+	assert.True(strings.Contains(shown, ">3.00</text>"))
+
+	formatted := render(Style{
+		ShowValues: true,
+		ValueFormatter: func(v interface{}) string {
+			return fmt.Sprintf("v%.0f", v)
+		},
+	})
+	assert.True(strings.Contains(formatted, ">v4</text>"))
+}
+
+func TestDrawValueLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: DefaultFontSize, FontColor: drawing.ColorBlack}
+
+	xrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	canvasBox := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+
+	// the second label sits on top of the first and is skipped.
+	r, err := SVG(100, 100)
+	assert.Nil(err)
+	Draw.ValueLabels(r, canvasBox, xrange, yrange, style, ContinuousSeries{
+		XValues: []float64{1, 1.2, 8},
+		YValues: []float64{5, 5, 5},
+	})
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+	assert.Equal(2, strings.Count(buffer.String(), "<text"))
+
+	// a label at the top edge is drawn below its point.
+	r, err = SVG(100, 100)
+	assert.Nil(err)
+	textBox := Draw.MeasureText(r, "10.00", style)
+	Draw.ValueLabels(r, canvasBox, xrange, yrange, style, ContinuousSeries{
+		XValues: []float64{5},
+		YValues: []float64{10},
+	})
+	buffer = bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+	assert.True(strings.Contains(buffer.String(), fmt.Sprintf("y=\"%d\"", DefaultValueLabelPadding+textBox.Height())), buffer.String())
+}
+
+func TestDrawValueLabelsPixels(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: DefaultFontSize, FontColor: drawing.ColorBlack}
+
+	xrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	canvasBox := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+
+	// labels are centered above their points, or below them at the top edge, and kept within the canvas.
+	labels, err := PNG(100, 100)
+	assert.Nil(err)
+	Draw.ValueLabels(labels, canvasBox, xrange, yrange, style, ContinuousSeries{
+		XValues: []float64{0, 5, 9},
+		YValues: []float64{2, 10, 5},
+	})
+
+	expected, err := PNG(100, 100)
+	assert.Nil(err)
+	for _, label := range []struct {
+		text string
+		x, y int
+	}{
+		{"2.00", 0, 80},
+		{"10.00", 50, 0},
+		{"5.00", 90, 50},
+	} {
+		textBox := Draw.MeasureText(expected, label.text, style)
+		left := MinInt(MaxInt(label.x-(textBox.Width()>>1), 0), 100-textBox.Width())
+		baseline := label.y - DefaultValueLabelPadding
+		if baseline-textBox.Height() < 0 {
+			baseline = label.y + DefaultValueLabelPadding + textBox.Height()
+		}
+		Draw.Text(expected, label.text, left, baseline, style)
+	}
+
+	labelsBuffer, expectedBuffer := bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+	assert.Nil(labels.Save(labelsBuffer))
+	assert.Nil(expected.Save(expectedBuffer))
+	assert.True(bytes.Equal(expectedBuffer.Bytes(), labelsBuffer.Bytes()))
+}
+
+func TestChartGetTitleLines(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(400, 300)
+	assert.Nil(err)
+
+	c := Chart{
+		Width:  400,
+		Height: 300,
+		Font:   f,
+		Title:  "First line\nSecond",
+	}
+	lines, bottom := c.getTitleLines(r)
+	assert.Len(lines, 2)
+	assert.Equal("First line", lines[0].Text)
+	assert.Equal("Second", lines[1].Text)
+	assert.True(lines[1].Y > lines[0].Y)
+	assert.Equal(lines[1].Y, bottom)
+	assert.Equal(DefaultTitleFontSize, lines[0].Style.FontSize)
+
+	// lines are centered by default.
+	width := Draw.MeasureText(r, "Second", lines[1].Style).Width()
+	assert.Equal(200-(width>>1), lines[1].X)
+
+	c.TitleStyle = Style{TextHorizontalAlign: TextHorizontalAlignLeft}
+	lines, _ = c.getTitleLines(r)
+	assert.Equal(c.Box().Left, lines[0].X)
+
+	c.TitleStyle = Style{TextHorizontalAlign: TextHorizontalAlignRight}
+	lines, _ = c.getTitleLines(r)
+	assert.Equal(c.Box().Right-width, lines[1].X)
+
+	c.TitleStyle = Style{}
+	c.Subtitle = "Beneath"
+	lines, _ = c.getTitleLines(r)
+	assert.Len(lines, 3)
+	assert.Equal("Beneath", lines[2].Text)
+	assert.Equal(DefaultSubtitleFontSize, lines[2].Style.FontSize)
+	assert.True(lines[2].Y > lines[1].Y)
+
+	// lines wider than the chart are wrapped.
+	c.Subtitle = ""
+	c.Width = 120
+	c.Title = "A title that is much too long for the chart"
+	lines, _ = c.getTitleLines(r)
+	assert.True(len(lines) > 1)
+	for _, line := range lines {
+		assert.True(Draw.MeasureText(r, line.Text, line.Style).Width() <= c.Box().Width(), line.Text)
+	}
+
+	c.TitleStyle = Hidden()
+	c.SubtitleStyle = Hidden()
+	lines, _ = c.getTitleLines(r)
+	assert.Empty(lines)
+}
+
+func TestChartGetTitleAdjustedCanvasBox(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(400, 300)
+	assert.Nil(err)
+
+	c := Chart{Width: 400, Height: 300, Font: f}
+	assert.Equal(c.Box(), c.getTitleAdjustedCanvasBox(r, c.Box()))
+
+	c.Title = "One"
+	_, oneLine := c.getTitleLines(r)
+	single := c.getTitleAdjustedCanvasBox(r, c.Box())
+	assert.Equal(oneLine+DefaultTitleBottom, single.Top)
+
+	c.Title = "One\nTwo"
+	double := c.getTitleAdjustedCanvasBox(r, c.Box())
+	assert.True(double.Top > single.Top)
+	assert.Equal(c.Box().Bottom, double.Bottom)
+}
+
+func TestChartRenderWithoutTitleUnchanged(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(c Chart) []byte {
+		c.Width, c.Height = 300, 200
+		c.Series = []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 1, 2, 3},
+				YValues: []float64{1, 3, 2, 4},
+			},
+		}
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(PNG, buffer))
+		return buffer.Bytes()
+	}
+
+	untitled := render(Chart{})
+	assert.Equal(untitled, render(Chart{Title: "Hidden", TitleStyle: Hidden()}))
+	assert.NotEqual(untitled, render(Chart{Title: "Shown"}))
+}
+
+func TestChartRenderImage(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  300,
+		Height: 200,
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 1, 2, 3},
+				YValues: []float64{1, 3, 2, 4},
+			},
+		},
+	}
+
+	first, err := c.RenderImage(PNG)
+	assert.Nil(err)
+	assert.Equal(300, first.Bounds().Dx())
+	assert.Equal(200, first.Bounds().Dy())
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	decoded, err := png.Decode(buffer)
+	assert.Nil(err)
+	assert.Equal(decoded.At(150, 100), first.At(150, 100))
+
+	// each render draws to a new image.
+	second, err := c.RenderImage(PNG)
+	assert.Nil(err)
+	assert.True(first.(*image.RGBA) != second.(*image.RGBA))
+
+	_, err = Chart{}.RenderImage(PNG)
+	assert.NotNil(err)
+}
+
+// scanCountingSeries is a values provider that counts how often its values are read.
+type scanCountingSeries struct {
+	values ContinuousSeries
+	reads  *int
+}
+
+func (scs scanCountingSeries) GetName() string     { return scs.values.GetName() }
+func (scs scanCountingSeries) GetYAxis() YAxisType { return scs.values.GetYAxis() }
+func (scs scanCountingSeries) GetStyle() Style     { return scs.values.GetStyle() }
+func (scs scanCountingSeries) Validate() error     { return scs.values.Validate() }
+func (scs scanCountingSeries) Len() int            { return scs.values.Len() }
+
+func (scs scanCountingSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	scs.values.Render(r, canvasBox, xrange, yrange, defaults)
+}
+
+func (scs scanCountingSeries) GetValues(index int) (x, y float64) {
+	*scs.reads++
+	return scs.values.GetValues(index)
+}
+
+// boundedScanCountingSeries additionally provides precomputed bounds.
+type boundedScanCountingSeries struct {
+	scanCountingSeries
+	minx, maxx, miny, maxy float64
+}
+
+func (bscs boundedScanCountingSeries) GetBounds() (minx, maxx, miny, maxy float64) {
+	return bscs.minx, bscs.maxx, bscs.miny, bscs.maxy
+}
+
+func TestChartTransparentBackground(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:      300,
+		Height:     200,
+		Background: Style{FillColor: ColorTransparent},
+		Canvas:     Style{FillColor: ColorTransparent},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 1, 2, 3},
+				YValues: []float64{1, 3, 2, 4},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	decoded, err := png.Decode(buffer)
+	assert.Nil(err)
+	_, _, _, a := decoded.At(1, 1).RGBA()
+	assert.Zero(a)
+
+	// the svg has no background rect.
+	svg := renderFrameTestChart(t, c)
+	assert.False(strings.Contains(svg, "M 0 0\nL 300 0\nL 300 200"))
+	assert.Contains(renderFrameTestChart(t, Chart{Width: 300, Height: 200, Series: c.Series}), "M 0 0\nL 300 0\nL 300 200")
+
+	// a stroke is still drawn.
+	c.Background.StrokeColor = drawing.ColorBlack
+	c.Background.StrokeWidth = 2
+	assert.Contains(renderFrameTestChart(t, c), "M 0 0\nL 300 0\nL 300 200")
+}
+
+func TestChartBackgroundImage(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:           300,
+		Height:          200,
+		BackgroundImage: imageTestLogo(drawing.ColorFromHex("336699")),
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 1, 2, 3},
+				YValues: []float64{1, 3, 2, 4},
+			},
+		},
+	}
+	img, err := c.RenderImage(PNG)
+	assert.Nil(err)
+
+	// it's scaled to the whole chart, and the canvas is drawn over it.
+	assert.Equal(drawing.ColorFromHex("336699"), drawing.ColorFromAlphaMixedRGBA(img.At(1, 1).RGBA()))
+	assert.Equal(drawing.ColorFromHex("336699"), drawing.ColorFromAlphaMixedRGBA(img.At(298, 198).RGBA()))
+	assert.Equal(DefaultCanvasColor, drawing.ColorFromAlphaMixedRGBA(img.At(150, 40).RGBA()))
+}
+
+func TestChartGetRangesBoundsProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	var reads int
+	c := Chart{
+		Series: []Series{
+			boundedScanCountingSeries{
+				scanCountingSeries: scanCountingSeries{
+					values: ContinuousSeries{XValues: []float64{0, 1}, YValues: []float64{0, 1}},
+					reads:  &reads,
+				},
+				minx: -5, maxx: 5, miny: 10, maxy: 20,
+			},
+			ContinuousSeries{
+				YAxis:   YAxisSecondary,
+				XValues: []float64{-10, 2},
+				YValues: []float64{math.NaN(), 3},
+			},
+		},
+	}
+
+	xr, yr, yra := c.getRanges()
+	assert.Zero(reads)
+	assert.Equal(-5.0, xr.GetMin())
+	assert.Equal(5.0, xr.GetMax())
+	assert.Equal(10.0, yr.GetMin())
+	assert.Equal(20.0, yr.GetMax())
+	// the secondary series bounds leave out the point with a missing y value.
+	assert.True(yra.GetMin() <= 3 && yra.GetMax() >= 3)
+
+	// a provider without finite bounds doesn't affect the ranges.
+	c.Series[0] = boundedScanCountingSeries{
+		scanCountingSeries: scanCountingSeries{reads: &reads},
+		minx:               math.NaN(), maxx: math.NaN(), miny: math.NaN(), maxy: math.NaN(),
+	}
+	xr, _, _ = c.getRanges()
+	assert.True(xr.GetMin() > -5 && xr.GetMin() <= 2 && xr.GetMax() >= 2)
+	assert.Zero(reads)
+}
+
+func benchmarkChartRenderBounds(b *testing.B, cs ContinuousSeries) {
+	c := Chart{Width: 800, Height: 400, Series: []Series{cs}}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		c.Render(PNG, bytes.NewBuffer(nil))
+	}
+}
+
+func BenchmarkChartRenderScannedBounds(b *testing.B) {
+	benchmarkChartRenderBounds(b, benchmarkSeries(1000000))
+}
+
+func BenchmarkChartRenderCachedBounds(b *testing.B) {
+	cs := benchmarkSeries(1000000)
+	benchmarkChartRenderBounds(b, NewContinuousSeries("", cs.XValues, cs.YValues))
+}
+
+func TestChartValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	valid := Chart{
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{0, 1}, YValues: []float64{1, 2}},
+		},
+	}
+	assert.Nil(valid.Validate())
+	// a zero size uses the defaults.
+	assert.Zero(valid.Width)
+	assert.Equal(DefaultChartWidth, valid.GetWidth())
+	assert.Equal(DefaultChartHeight, valid.GetHeight())
+
+	err := Chart{}.Validate()
+	assert.NotNil(err)
+	assert.Equal("please provide at least one series", err.Error())
+
+	invalid := Chart{
+		Width:  -1,
+		Height: -2,
+		XAxis:  XAxis{Range: &ContinuousRange{Min: 5, Max: 1}},
+		YAxis:  YAxis{Range: &LogarithmicRange{Min: 0, Max: 100}},
+		Series: []Series{
+			nil,
+			TimeSeries{Name: "mismatched", XValues: []time.Time{time.Unix(0, 0), time.Unix(1, 0), time.Unix(2, 0)}, YValues: []float64{1, 2}},
+			ContinuousSeries{Name: "hidden", Style: Hidden()},
+			ContinuousSeries{XValues: []float64{0, 1}, YValues: []float64{1, 2}},
+		},
+	}
+	err = invalid.Validate()
+	assert.NotNil(err)
+	for _, message := range []string{
+		"width must not be negative, got -1",
+		"height must not be negative, got -2",
+		"series 0 is nil",
+		`series 1 ("mismatched"); time series must have same length xvalues as yvalues`,
+		"x axis; range min 5 must not be greater than its max 1",
+		"y axis; logarithmic range requires a positive min",
+	} {
+		assert.True(strings.Contains(err.Error(), message), message)
+	}
+	assert.False(strings.Contains(err.Error(), "hidden"))
+
+	// series without finite values are logged rather than an error, so charts with them still render.
+	logBuffer := bytes.NewBuffer(nil)
+	sparse := Chart{
+		Log: NewLogger(OptLoggerStdout(logBuffer), OptLoggerStderr(logBuffer)),
+		Series: []Series{
+			ContinuousSeries{Name: "empty"},
+			ContinuousSeries{Name: "nans", XValues: []float64{0, 1}, YValues: []float64{math.NaN(), math.Inf(1)}},
+			ContinuousSeries{XValues: []float64{0, 1}, YValues: []float64{1, 2}},
+		},
+	}
+	assert.Nil(sparse.Validate())
+	assert.True(strings.Contains(logBuffer.String(), `series 0 ("empty") has no finite values`))
+	assert.True(strings.Contains(logBuffer.String(), `series 1 ("nans") has no finite values`))
+	assert.Nil(sparse.Render(PNG, bytes.NewBuffer(nil)))
+}
+
+func TestChartRenderValidates(t *testing.T) {
+	assert := assert.New(t)
+
+	var created bool
+	rp := func(width, height int) (Renderer, error) {
+		created = true
+		return PNG(width, height)
+	}
+
+	err := Chart{Width: -1, Series: []Series{ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}}}}.Render(rp, bytes.NewBuffer(nil))
+	assert.NotNil(err)
+	assert.False(created)
+}
+
+func TestChartsRenderSizes(t *testing.T) {
+	assert := assert.New(t)
+
+	values := []Value{{Value: 1, Label: "a"}, {Value: 2, Label: "b"}}
+	charts := func(width, height int) map[string]ChartRenderer {
+		return map[string]ChartRenderer{
+			"chart":       Chart{Width: width, Height: height, Series: []Series{ContinuousSeries{XValues: []float64{0, 1}, YValues: []float64{1, 2}}}},
+			"bar":         BarChart{Width: width, Height: height, Bars: values},
+			"stacked bar": StackedBarChart{Width: width, Height: height, Bars: []StackedBar{{Name: "a", Values: values}}},
+			"pie":         PieChart{Width: width, Height: height, Values: values},
+			"donut":       DonutChart{Width: width, Height: height, Values: values},
+			"heatmap":     HeatmapChart{Width: width, Height: height, Values: [][]float64{{1, 2}, {3, 4}}},
+		}
+	}
+	size := func(c ChartRenderer) image.Point {
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(PNG, buffer))
+		img, err := png.Decode(buffer)
+		assert.Nil(err)
+		return img.Bounds().Size()
+	}
+
+	defaults := map[string]image.Point{
+		"chart":       {DefaultChartWidth, DefaultChartHeight},
+		"bar":         {DefaultChartWidth, DefaultChartHeight},
+		"stacked bar": {DefaultChartWidth, DefaultChartHeight},
+		"pie":         {DefaultChartWidth, DefaultChartWidth},
+		"donut":       {DefaultChartWidth, DefaultChartWidth},
+		"heatmap":     {DefaultChartWidth, DefaultChartHeight},
+	}
+	for name, c := range charts(0, 0) {
+		assert.Equal(defaults[name], size(c), name)
+	}
+	for name, c := range charts(10, 10) {
+		assert.Equal(image.Point{10, 10}, size(c), name)
+	}
+}
+
+func TestChartGetRangesXWindow(t *testing.T) {
+	assert := assert.New(t)
+
+	series := ContinuousSeries{XValues: LinearRange(0, 9)}
+	for _, x := range series.XValues {
+		series.YValues = append(series.YValues, x*10)
+	}
+	c := Chart{
+		XAxis:  XAxis{Range: &ContinuousRange{Min: 2.5, Max: 4.5}},
+		YAxis:  YAxis{Style: Hidden()},
+		Series: []Series{series},
+	}
+
+	_, yr, _ := c.getRanges()
+	assert.Equal(0.0, yr.GetMin())
+	assert.Equal(90.0, yr.GetMax())
+
+	c.XAxis.Window = true
+	c.XAxis.Range = &ContinuousRange{Min: 2.5, Max: 4.5}
+	_, yr, _ = c.getRanges()
+	// the line crosses the edges of the window at 25 and 45.
+	assert.Equal(25.0, yr.GetMin())
+	assert.Equal(45.0, yr.GetMax())
+
+	// without a range or ticks there is no window.
+	c.XAxis.Range = nil
+	_, yr, _ = c.getRanges()
+	assert.Equal(90.0, yr.GetMax())
+
+	// both bounds of a bounded series are windowed.
+	upper := ContinuousSeries{XValues: series.XValues}
+	for _, y := range series.YValues {
+		upper.YValues = append(upper.YValues, y+5)
+	}
+	c.Series = []Series{FillBetweenSeries{Upper: upper, Lower: series}}
+	c.XAxis.Range = &ContinuousRange{Min: 2.5, Max: 4.5}
+	_, yr, _ = c.getRanges()
+	assert.Equal(25.0, yr.GetMin())
+	assert.Equal(50.0, yr.GetMax())
+}
+
+func TestChartXWindowAnnotations(t *testing.T) {
+	assert := assert.New(t)
+
+	series := ContinuousSeries{
+		XValues: []float64{0, 1, 2, 3, 4},
+		YValues: []float64{1, 2, 3, 4, 5},
+	}
+	c := Chart{
+		XAxis: XAxis{Range: &ContinuousRange{Min: 0, Max: 2}, Window: true},
+		Series: []Series{
+			series,
+			AnnotationSeries{Annotations: []Value2{
+				{XValue: 1, YValue: 2, Label: "inside"},
+				{XValue: 4, YValue: 5, Label: "outside"},
+			}},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	assert.Contains(buffer.String(), "inside")
+	assert.NotContains(buffer.String(), "outside")
+
+	// a last value annotation labels the last value within the window.
+	lva := LastValueAnnotationSeries(series, func(v interface{}) string {
+		return fmt.Sprintf("last %v", v)
+	})
+	c.Series = append(c.Series, lva)
+	buffer.Reset()
+	assert.Nil(c.Render(SVG, buffer))
+	assert.Contains(buffer.String(), ">last 3</text>")
+	assert.NotContains(buffer.String(), "last 5")
+
+	// and nothing when the series has no values within it.
+	c.XAxis.Range = &ContinuousRange{Min: 10, Max: 20}
+	assert.Empty(c.windowAnnotations(lva).Annotations)
+}
+
+func TestChartRenderConcurrently(t *testing.T) {
+	assert := assert.New(t)
+
+	// run with -race; the chart is shared by every render, and each has a renderer of its own.
+	values := ContinuousSeries{Name: "values", XValues: LinearRange(0, 10), YValues: LinearRange(0, 10), Style: Style{ShowValues: true}}
+	c := Chart{
+		Title:          "Concurrent",
+		Subtitle:       "renders",
+		Theme:          GetDefaultTheme(),
+		XAxis:          XAxis{Name: "x", Range: &ContinuousRange{Min: 0, Max: 10}},
+		YAxis:          YAxis{Name: "y", Range: &ContinuousRange{}},
+		YAxisSecondary: YAxis{Range: &LogarithmicRange{Min: 1, Max: 1000}},
+		XBands:         []Band{{From: 1, To: 2}},
+		XMarkers:       []XMarker{{Value: 3, Label: "x marker"}},
+		YMarkers:       []YMarker{{Value: 3, Label: "y marker"}},
+		Watermark:      Watermark{Text: "draft"},
+		Series: []Series{
+			values,
+			ContinuousSeries{Name: "secondary", YAxis: YAxisSecondary, XValues: LinearRange(0, 10), YValues: LinearRange(1, 100)},
+			SMASeries{Name: "sma", InnerSeries: values},
+			LastValueAnnotationSeries(values),
+		},
+	}
+	c.Elements = []Renderable{LegendThin(&c)}
+
+	expected := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, expected))
+
+	var wg sync.WaitGroup
+	outputs := make([]*bytes.Buffer, 16)
+	errs := make([]error, len(outputs))
+	for index := range outputs {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			outputs[index] = bytes.NewBuffer(nil)
+			if index%2 == 0 {
+				errs[index] = c.Render(SVG, outputs[index])
+				return
+			}
+			errs[index] = c.Render(PNG, outputs[index])
+		}(index)
+	}
+	wg.Wait()
+
+	for index, output := range outputs {
+		assert.Nil(errs[index])
+		if index%2 == 0 {
+			assert.Equal(expected.String(), output.String())
+		}
+	}
+	// laying out the chart leaves its ranges as they were.
+	assert.True(c.YAxis.Range.IsZero())
+	assert.Zero(c.XAxis.Range.GetDomain())
+
+	// series that work out their values on first use do it once, whichever render gets there first.
+	cachingChart := func() Chart {
+		regression := &LinearRegressionSeries{Name: "regression", InnerSeries: values}
+		return Chart{
+			Series: []Series{
+				values,
+				&EMASeries{Name: "ema", InnerSeries: values},
+				&BollingerBandsSeries{Name: "bollinger", InnerSeries: values},
+				&MACDSignalSeries{Name: "macd signal", InnerSeries: values},
+				&MACDLineSeries{Name: "macd line", InnerSeries: values},
+				&MinSeries{Name: "min", InnerSeries: values},
+				&MaxSeries{Name: "max", InnerSeries: values},
+				regression,
+				&LinearSeries{Name: "linear", XValues: LinearRange(0, 10), InnerSeries: regression},
+				&PolynomialRegressionSeries{Name: "polynomial", Degree: 2, InnerSeries: values},
+				&BinnedHistogramSeries{Name: "histogram", Values: LinearRange(0, 10), BinCount: 4},
+			},
+		}
+	}
+	expected.Reset()
+	expectedChart := cachingChart()
+	assert.Nil(expectedChart.Render(SVG, expected))
+
+	c = cachingChart()
+	for index := range outputs {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			outputs[index] = bytes.NewBuffer(nil)
+			errs[index] = c.Render(SVG, outputs[index])
+		}(index)
+	}
+	wg.Wait()
+
+	for index, output := range outputs {
+		assert.Nil(errs[index])
+		assert.Equal(expected.String(), output.String())
+	}
+}
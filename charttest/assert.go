@@ -0,0 +1,46 @@
+package charttest
+
+import (
+	"strings"
+	"testing"
+)
+
+// AssertTextWasDrawn fails the test if the mock renderer didn't draw a text.
+func AssertTextWasDrawn(t testing.TB, mock *MockRenderer, text string) {
+	t.Helper()
+	for _, drawn := range mock.Texts() {
+		if drawn == text {
+			return
+		}
+	}
+	t.Errorf("charttest; %q wasn't drawn, the text drawn is %q", text, mock.Texts())
+}
+
+// AssertTextWasNotDrawn fails the test if the mock renderer drew a text.
+func AssertTextWasNotDrawn(t testing.TB, mock *MockRenderer, text string) {
+	t.Helper()
+	for _, drawn := range mock.Texts() {
+		if drawn == text {
+			t.Errorf("charttest; %q was drawn", text)
+			return
+		}
+	}
+}
+
+// AssertCallsEqual fails the test if the calls made to the mock renderer, one per line, aren't the expected ones;
+// e.g. a snapshot of a chart. Leading and trailing space is ignored.
+func AssertCallsEqual(t testing.TB, mock *MockRenderer, expected string) {
+	t.Helper()
+	actual := mock.String()
+	if strings.TrimSpace(actual) == strings.TrimSpace(expected) {
+		return
+	}
+	actualLines, expectedLines := strings.Split(actual, "\n"), strings.Split(strings.TrimSpace(expected), "\n")
+	for index := 0; index < len(actualLines) && index < len(expectedLines); index++ {
+		if actualLines[index] != expectedLines[index] {
+			t.Errorf("charttest; call %d is %s, expected %s", index, actualLines[index], expectedLines[index])
+			return
+		}
+	}
+	t.Errorf("charttest; %d calls were made, expected %d", len(actualLines), len(expectedLines))
+}
@@ -0,0 +1,83 @@
+package charttest
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart"
+)
+
+// recordingT records the errors of failed assertions.
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (rt *recordingT) Helper() {}
+
+func (rt *recordingT) Errorf(format string, args ...interface{}) {
+	rt.errors = append(rt.errors, fmt.Sprintf(format, args...))
+}
+
+func testChart() chart.Chart {
+	return chart.Chart{
+		Title: "Sales",
+		Series: []chart.Series{
+			chart.ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1000, 1240, 1100}},
+			chart.ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{900, 1000, 1200}},
+		},
+	}
+}
+
+func TestMockRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &MockRenderer{}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(testChart().Render(mock.Provider(), buffer))
+	assert.Equal(chart.DefaultChartWidth, mock.Width)
+	assert.Equal(mock.String(), buffer.String())
+	AssertTextWasDrawn(t, mock, "Sales")
+	AssertTextWasDrawn(t, mock, "1250")
+	AssertTextWasNotDrawn(t, mock, "Costs")
+
+	// the chart is drawn in the documented order.
+	var groups []string
+	for _, c := range mock.Filter("BeginGroup") {
+		groups = append(groups, c.Args[0].(string))
+	}
+	assert.Equal([]string{"chart-background", "chart-canvas", "x-axis", "y-axis", "series-0", "series-1", "title"}, groups)
+	assert.Equal(`Text("Sales", 484, 33)`, mock.Filter("Text")[len(mock.Texts())-1].String())
+
+	// and the same way every time.
+	again := &MockRenderer{}
+	assert.Nil(testChart().Render(again.Provider(), bytes.NewBuffer(nil)))
+	AssertCallsEqual(t, again, mock.String())
+}
+
+func TestAssertions(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &MockRenderer{}
+	mock.Text("drawn", 1, 2)
+	mock.LineTo(3, 4)
+
+	rt := &recordingT{TB: t}
+	AssertTextWasDrawn(rt, mock, "drawn")
+	AssertTextWasNotDrawn(rt, mock, "missing")
+	AssertCallsEqual(rt, mock, "Text(\"drawn\", 1, 2)\nLineTo(3, 4)\n")
+	assert.Empty(rt.errors)
+
+	AssertTextWasDrawn(rt, mock, "missing")
+	AssertTextWasNotDrawn(rt, mock, "drawn")
+	AssertCallsEqual(rt, mock, "Text(\"drawn\", 1, 2)\nLineTo(3, 5)")
+	AssertCallsEqual(rt, mock, "Text(\"drawn\", 1, 2)")
+	assert.Equal([]string{
+		`charttest; "missing" wasn't drawn, the text drawn is ["drawn"]`,
+		`charttest; "drawn" was drawn`,
+		`charttest; call 1 is LineTo(3, 4), expected LineTo(3, 5)`,
+		`charttest; 2 calls were made, expected 1`,
+	}, rt.errors)
+}
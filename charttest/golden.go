@@ -0,0 +1,113 @@
+package charttest
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wcharczuk/go-chart"
+)
+
+var (
+	// Update makes `AssertRenderedMatches` rewrite the golden images with what's rendered, rather than compare them.
+	// It's set by running the tests with `CHARTTEST_UPDATE=1` in the environment; tests can also set it,
+	// e.g. from a flag of their own.
+	Update = os.Getenv("CHARTTEST_UPDATE") == "1"
+
+	// MaxPixelDelta is how much a color channel of a pixel can differ from the golden image, out of 255,
+	// before the pixel counts as different; e.g. for changes in anti-aliasing.
+	MaxPixelDelta uint8 = 16
+)
+
+// AssertRenderedMatches renders a chart to a png and fails the test if it doesn't match a golden image:
+// the images must be the same size, and at most the tolerance, a fraction of the pixels, can differ by more
+// than `MaxPixelDelta`. If it doesn't match, the image rendered is written next to the golden image, e.g.
+// `line.actual.png` for `line.png`, to compare them. Run the tests with `CHARTTEST_UPDATE=1` to write the golden images.
+func AssertRenderedMatches(t testing.TB, c *chart.Chart, goldenPath string, tolerance float64) {
+	t.Helper()
+
+	rendered, err := c.RenderImage(chart.PNG)
+	if err != nil {
+		t.Errorf("charttest; rendering %s: %v", goldenPath, err)
+		return
+	}
+	if Update {
+		if err := writePNG(goldenPath, rendered); err != nil {
+			t.Errorf("charttest; updating %s: %v", goldenPath, err)
+		}
+		return
+	}
+
+	golden, err := readPNG(goldenPath)
+	if err != nil {
+		t.Errorf("charttest; reading %s, run the tests with CHARTTEST_UPDATE=1 to write it: %v", goldenPath, err)
+		return
+	}
+	if message := compareImages(golden, rendered, tolerance); message != "" {
+		actualPath := strings.TrimSuffix(goldenPath, filepath.Ext(goldenPath)) + ".actual.png"
+		if err := writePNG(actualPath, rendered); err != nil {
+			t.Errorf("charttest; %s %s, and writing %s: %v", goldenPath, message, actualPath, err)
+			return
+		}
+		t.Errorf("charttest; %s %s, the image rendered is %s", goldenPath, message, actualPath)
+	}
+}
+
+// compareImages returns how the rendered image doesn't match the golden one, or an empty string if it does.
+func compareImages(golden, rendered image.Image, tolerance float64) string {
+	if golden.Bounds().Size() != rendered.Bounds().Size() {
+		return fmt.Sprintf("is %v, not %v", golden.Bounds().Size(), rendered.Bounds().Size())
+	}
+
+	delta := func(a, b uint32) uint32 {
+		a, b = a>>8, b>>8
+		if a > b {
+			return a - b
+		}
+		return b - a
+	}
+	var differing int
+	gb, rb := golden.Bounds(), rendered.Bounds()
+	for y := 0; y < gb.Dy(); y++ {
+		for x := 0; x < gb.Dx(); x++ {
+			gr, gg, gbl, ga := golden.At(gb.Min.X+x, gb.Min.Y+y).RGBA()
+			rr, rg, rbl, ra := rendered.At(rb.Min.X+x, rb.Min.Y+y).RGBA()
+			limit := uint32(MaxPixelDelta)
+			if delta(gr, rr) > limit || delta(gg, rg) > limit || delta(gbl, rbl) > limit || delta(ga, ra) > limit {
+				differing++
+			}
+		}
+	}
+	if fraction := float64(differing) / float64(gb.Dx()*gb.Dy()); fraction > tolerance {
+		return fmt.Sprintf("differs in %d pixels (%.4f of them, more than %.4f)", differing, fraction, tolerance)
+	}
+	return ""
+}
+
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,112 @@
+package charttest
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart"
+)
+
+// _update is an `-update` flag of the tests' own, which tests using charttest can have, as it doesn't define flags.
+var _update = flag.Bool("update", false, "rewrite the golden images")
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if *_update {
+		Update = true
+	}
+	os.Exit(m.Run())
+}
+
+func TestGoldenLineChart(t *testing.T) {
+	AssertRenderedMatches(t, &chart.Chart{
+		Width:  400,
+		Height: 240,
+		Series: []chart.Series{
+			chart.ContinuousSeries{XValues: []float64{1, 2, 3, 4, 5}, YValues: []float64{1, 4, 2, 5, 3}},
+		},
+	}, "testdata/line.png", 0.01)
+}
+
+func TestGoldenAxes(t *testing.T) {
+	AssertRenderedMatches(t, &chart.Chart{
+		Title:          "Axes",
+		Width:          400,
+		Height:         240,
+		XAxis:          chart.XAxis{Name: "x"},
+		YAxis:          chart.YAxis{Name: "y"},
+		YAxisSecondary: chart.YAxis{Style: chart.Shown()},
+		Series: []chart.Series{
+			chart.ContinuousSeries{XValues: []float64{1, 2, 3, 4, 5}, YValues: []float64{1, 4, 2, 5, 3}},
+			chart.ContinuousSeries{YAxis: chart.YAxisSecondary, XValues: []float64{1, 5}, YValues: []float64{100, 0}},
+		},
+	}, "testdata/axes.png", 0.01)
+}
+
+func TestGoldenLastValueLabel(t *testing.T) {
+	series := chart.ContinuousSeries{XValues: []float64{1, 2, 3, 4, 5}, YValues: []float64{1, 4, 2, 5, 3}}
+	AssertRenderedMatches(t, &chart.Chart{
+		Width:  400,
+		Height: 240,
+		Series: []chart.Series{series, chart.LastValueAnnotationSeries(series)},
+	}, "testdata/last_value_label.png", 0.01)
+}
+
+func TestCompareImages(t *testing.T) {
+	assert := assert.New(t)
+
+	golden := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	rendered := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	assert.Empty(compareImages(golden, rendered, 0))
+
+	// slight differences don't count, and a few pixels can differ within the tolerance.
+	rendered.Set(0, 0, color.RGBA{R: MaxPixelDelta, A: MaxPixelDelta})
+	assert.Empty(compareImages(golden, rendered, 0))
+	rendered.Set(1, 1, color.RGBA{R: 255, A: 255})
+	assert.Empty(compareImages(golden, rendered, 0.01))
+	assert.NotEmpty(compareImages(golden, rendered, 0))
+
+	assert.NotEmpty(compareImages(golden, image.NewRGBA(image.Rect(0, 0, 10, 11)), 1))
+}
+
+func TestAssertRenderedMatchesWritesActual(t *testing.T) {
+	assert := assert.New(t)
+
+	update := Update
+	Update = false
+	defer func() { Update = update }()
+	dir, err := ioutil.TempDir("", "charttest")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	c := &chart.Chart{
+		Width:  200,
+		Height: 100,
+		Series: []chart.Series{chart.ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}}},
+	}
+	golden := filepath.Join(dir, "chart.png")
+	rt := &recordingT{TB: t}
+	AssertRenderedMatches(rt, c, golden, 0)
+	assert.Len(rt.errors, 1)
+	assert.True(strings.Contains(rt.errors[0], "CHARTTEST_UPDATE=1"))
+
+	img, err := c.RenderImage(chart.PNG)
+	assert.Nil(err)
+	assert.Nil(writePNG(golden, img))
+	rt.errors = nil
+	AssertRenderedMatches(rt, c, golden, 0)
+	assert.Empty(rt.errors)
+
+	c.Series = []chart.Series{chart.ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{2, 1}}}
+	AssertRenderedMatches(rt, c, golden, 0)
+	assert.Len(rt.errors, 1)
+	_, err = os.Stat(filepath.Join(dir, "chart.actual.png"))
+	assert.Nil(err)
+}
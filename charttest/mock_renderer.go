@@ -0,0 +1,273 @@
+// Package charttest has helpers to test charts by what they draw rather than by the bytes of their images.
+package charttest
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// Interface Assertions.
+var (
+	_ chart.Renderer            = (*MockRenderer)(nil)
+	_ chart.FloatPathRenderer   = (*MockRenderer)(nil)
+	_ chart.FloatCircleRenderer = (*MockRenderer)(nil)
+	_ chart.ImageRenderer       = (*MockRenderer)(nil)
+	_ chart.GroupRenderer       = (*MockRenderer)(nil)
+)
+
+// Call is a call made to a `MockRenderer`, with its arguments.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// String returns the call the way it's written in Go, e.g. `LineTo(10, 20)`.
+func (c Call) String() string {
+	args := make([]string, len(c.Args))
+	for index, arg := range c.Args {
+		if typed, isTyped := arg.(string); isTyped {
+			args[index] = fmt.Sprintf("%q", typed)
+		} else {
+			args[index] = fmt.Sprint(arg)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", c.Method, strings.Join(args, ", "))
+}
+
+// MockRenderer is a renderer that records the calls made to draw with it, in order, rather than drawing.
+// Charts draw in a set order, so the calls are the same every time a chart is rendered; see `chart.Chart.Render`.
+//
+// Text is measured as if every character were `CharacterWidth` of the font size wide and the font size
+// high, so the layout doesn't depend on fonts. Measuring isn't recorded.
+type MockRenderer struct {
+	// Width and Height are the size the renderer was created with.
+	Width, Height int
+	// CharacterWidth is the width of a character relative to the font size; it defaults to 0.5.
+	CharacterWidth float64
+	// Calls are the calls made to draw, in order.
+	Calls []Call
+
+	dpi      float64
+	fontSize float64
+}
+
+// Provider returns a renderer provider that returns the mock renderer, so a chart can be rendered with it.
+func (mr *MockRenderer) Provider() chart.RendererProvider {
+	return func(width, height int) (chart.Renderer, error) {
+		mr.Width, mr.Height = width, height
+		return mr, nil
+	}
+}
+
+// Filter returns the calls of a method, in order.
+func (mr *MockRenderer) Filter(method string) []Call {
+	var calls []Call
+	for _, c := range mr.Calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Texts returns the text drawn, in order.
+func (mr *MockRenderer) Texts() []string {
+	var texts []string
+	for _, c := range mr.Filter("Text") {
+		texts = append(texts, c.Args[0].(string))
+	}
+	return texts
+}
+
+// String returns the calls, one per line; e.g. to compare with a snapshot.
+func (mr *MockRenderer) String() string {
+	lines := make([]string, len(mr.Calls))
+	for index, c := range mr.Calls {
+		lines[index] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (mr *MockRenderer) record(method string, args ...interface{}) {
+	mr.Calls = append(mr.Calls, Call{Method: method, Args: args})
+}
+
+// ResetStyle implements the interface method.
+func (mr *MockRenderer) ResetStyle() {
+	mr.record("ResetStyle")
+}
+
+// GetDPI implements the interface method.
+func (mr *MockRenderer) GetDPI() float64 {
+	if mr.dpi == 0 {
+		return chart.DefaultDPI
+	}
+	return mr.dpi
+}
+
+// SetDPI implements the interface method.
+func (mr *MockRenderer) SetDPI(dpi float64) {
+	mr.dpi = dpi
+	mr.record("SetDPI", dpi)
+}
+
+// SetClassName implements the interface method.
+func (mr *MockRenderer) SetClassName(className string) {
+	mr.record("SetClassName", className)
+}
+
+// SetStrokeColor implements the interface method.
+func (mr *MockRenderer) SetStrokeColor(c drawing.Color) {
+	mr.record("SetStrokeColor", c)
+}
+
+// SetFillColor implements the interface method.
+func (mr *MockRenderer) SetFillColor(c drawing.Color) {
+	mr.record("SetFillColor", c)
+}
+
+// SetStrokeWidth implements the interface method.
+func (mr *MockRenderer) SetStrokeWidth(width float64) {
+	mr.record("SetStrokeWidth", width)
+}
+
+// SetStrokeDashArray implements the interface method.
+func (mr *MockRenderer) SetStrokeDashArray(dashArray []float64) {
+	mr.record("SetStrokeDashArray", dashArray)
+}
+
+// MoveTo implements the interface method.
+func (mr *MockRenderer) MoveTo(x, y int) {
+	mr.record("MoveTo", x, y)
+}
+
+// LineTo implements the interface method.
+func (mr *MockRenderer) LineTo(x, y int) {
+	mr.record("LineTo", x, y)
+}
+
+// MoveToFloat implements the interface method.
+func (mr *MockRenderer) MoveToFloat(x, y float64) {
+	mr.record("MoveToFloat", x, y)
+}
+
+// LineToFloat implements the interface method.
+func (mr *MockRenderer) LineToFloat(x, y float64) {
+	mr.record("LineToFloat", x, y)
+}
+
+// QuadCurveTo implements the interface method.
+func (mr *MockRenderer) QuadCurveTo(cx, cy, x, y int) {
+	mr.record("QuadCurveTo", cx, cy, x, y)
+}
+
+// ArcTo implements the interface method.
+func (mr *MockRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	mr.record("ArcTo", cx, cy, rx, ry, startAngle, delta)
+}
+
+// Close implements the interface method.
+func (mr *MockRenderer) Close() {
+	mr.record("Close")
+}
+
+// Stroke implements the interface method.
+func (mr *MockRenderer) Stroke() {
+	mr.record("Stroke")
+}
+
+// Fill implements the interface method.
+func (mr *MockRenderer) Fill() {
+	mr.record("Fill")
+}
+
+// FillStroke implements the interface method.
+func (mr *MockRenderer) FillStroke() {
+	mr.record("FillStroke")
+}
+
+// Circle implements the interface method.
+func (mr *MockRenderer) Circle(radius float64, x, y int) {
+	mr.record("Circle", radius, x, y)
+}
+
+// CircleFloat implements the interface method.
+func (mr *MockRenderer) CircleFloat(radius, x, y float64) {
+	mr.record("CircleFloat", radius, x, y)
+}
+
+// SetFont implements the interface method; the font is recorded by its name.
+func (mr *MockRenderer) SetFont(f *truetype.Font) {
+	var name string
+	if f != nil {
+		name = f.Name(truetype.NameIDFontFullName)
+	}
+	mr.record("SetFont", name)
+}
+
+// SetFontColor implements the interface method.
+func (mr *MockRenderer) SetFontColor(c drawing.Color) {
+	mr.record("SetFontColor", c)
+}
+
+// SetFontSize implements the interface method.
+func (mr *MockRenderer) SetFontSize(size float64) {
+	mr.fontSize = size
+	mr.record("SetFontSize", size)
+}
+
+// Text implements the interface method.
+func (mr *MockRenderer) Text(body string, x, y int) {
+	mr.record("Text", body, x, y)
+}
+
+// MeasureText implements the interface method; rotated text is measured as if it weren't rotated.
+func (mr *MockRenderer) MeasureText(body string) chart.Box {
+	characterWidth := mr.CharacterWidth
+	if characterWidth == 0 {
+		characterWidth = 0.5
+	}
+	size := drawing.PointsToPixels(mr.GetDPI(), mr.fontSize)
+	return chart.Box{
+		Right:  int(float64(utf8.RuneCountInString(body)) * characterWidth * size),
+		Bottom: int(size),
+	}
+}
+
+// SetTextRotation implements the interface method.
+func (mr *MockRenderer) SetTextRotation(radians float64) {
+	mr.record("SetTextRotation", radians)
+}
+
+// ClearTextRotation implements the interface method.
+func (mr *MockRenderer) ClearTextRotation() {
+	mr.record("ClearTextRotation")
+}
+
+// DrawImage implements the interface method; the image is recorded by its size.
+func (mr *MockRenderer) DrawImage(img image.Image, box chart.Box) {
+	mr.record("DrawImage", img.Bounds().Size(), box)
+}
+
+// BeginGroup implements the interface method.
+func (mr *MockRenderer) BeginGroup(name string, classes ...string) {
+	mr.record("BeginGroup", name, classes)
+}
+
+// EndGroup implements the interface method.
+func (mr *MockRenderer) EndGroup() {
+	mr.record("EndGroup")
+}
+
+// Save implements the interface method; it writes the calls, one per line.
+func (mr *MockRenderer) Save(w io.Writer) error {
+	_, err := io.WriteString(w, mr.String())
+	return err
+}
@@ -0,0 +1,167 @@
+package chart
+
+// outcode bits of the Cohen-Sutherland algorithm; which sides of a box a point is beyond.
+const (
+	outcodeLeft = 1 << iota
+	outcodeRight
+	outcodeTop
+	outcodeBottom
+)
+
+// outcode returns the sides of the box a point is beyond, or zero if the point is within it.
+func outcode(box Box, x, y float64) (code int) {
+	if x < float64(box.Left) {
+		code |= outcodeLeft
+	} else if x > float64(box.Right) {
+		code |= outcodeRight
+	}
+	if y < float64(box.Top) {
+		code |= outcodeTop
+	} else if y > float64(box.Bottom) {
+		code |= outcodeBottom
+	}
+	return
+}
+
+// clipSegment clips the segment from a to b to the box with the Cohen-Sutherland algorithm.
+// It returns the part of the segment within the box, and false if none of it is.
+func clipSegment(box Box, a, b floatPoint) (floatPoint, floatPoint, bool) {
+	x0, y0, x1, y1 := a.X, a.Y, b.X, b.Y
+	code0, code1 := outcode(box, x0, y0), outcode(box, x1, y1)
+	for {
+		if code0|code1 == 0 {
+			return floatPoint{x0, y0}, floatPoint{x1, y1}, true
+		}
+		if code0&code1 != 0 {
+			return floatPoint{}, floatPoint{}, false
+		}
+
+		code := code0
+		if code == 0 {
+			code = code1
+		}
+		var x, y float64
+		switch {
+		case code&outcodeTop != 0:
+			x, y = x0+(x1-x0)*(float64(box.Top)-y0)/(y1-y0), float64(box.Top)
+		case code&outcodeBottom != 0:
+			x, y = x0+(x1-x0)*(float64(box.Bottom)-y0)/(y1-y0), float64(box.Bottom)
+		case code&outcodeLeft != 0:
+			x, y = float64(box.Left), y0+(y1-y0)*(float64(box.Left)-x0)/(x1-x0)
+		default:
+			x, y = float64(box.Right), y0+(y1-y0)*(float64(box.Right)-x0)/(x1-x0)
+		}
+
+		if code == code0 {
+			x0, y0 = x, y
+			code0 = outcode(box, x0, y0)
+		} else {
+			x1, y1 = x, y
+			code1 = outcode(box, x1, y1)
+		}
+	}
+}
+
+// clipPolyline clips a line through the given points to the box.
+// Parts of the line outside the box split it, so it returns the runs of the line within the box.
+func clipPolyline(box Box, points []floatPoint) (runs [][]floatPoint) {
+	if len(points) == 1 {
+		if outcode(box, points[0].X, points[0].Y) == 0 {
+			runs = append(runs, points)
+		}
+		return
+	}
+
+	var run []floatPoint
+	for index := 1; index < len(points); index++ {
+		from, to, visible := clipSegment(box, points[index-1], points[index])
+		if !visible {
+			continue
+		}
+		if len(run) > 0 && run[len(run)-1] != from {
+			runs = append(runs, run)
+			run = nil
+		}
+		if len(run) == 0 {
+			run = append(run, from)
+		}
+		run = append(run, to)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	return
+}
+
+// clipPolygon clips a closed polygon to the box with the Sutherland-Hodgman algorithm.
+// A polygon within the box is returned as is; one outside of it has no points.
+func clipPolygon(box Box, points []floatPoint) []floatPoint {
+	edges := []struct {
+		inside    func(x, y float64) bool
+		intersect func(x0, y0, x1, y1 float64) (float64, float64)
+	}{
+		{
+			func(x, _ float64) bool { return x >= float64(box.Left) },
+			func(x0, y0, x1, y1 float64) (float64, float64) {
+				return float64(box.Left), y0 + (y1-y0)*(float64(box.Left)-x0)/(x1-x0)
+			},
+		},
+		{
+			func(x, _ float64) bool { return x <= float64(box.Right) },
+			func(x0, y0, x1, y1 float64) (float64, float64) {
+				return float64(box.Right), y0 + (y1-y0)*(float64(box.Right)-x0)/(x1-x0)
+			},
+		},
+		{
+			func(_, y float64) bool { return y >= float64(box.Top) },
+			func(x0, y0, x1, y1 float64) (float64, float64) {
+				return x0 + (x1-x0)*(float64(box.Top)-y0)/(y1-y0), float64(box.Top)
+			},
+		},
+		{
+			func(_, y float64) bool { return y <= float64(box.Bottom) },
+			func(x0, y0, x1, y1 float64) (float64, float64) {
+				return x0 + (x1-x0)*(float64(box.Bottom)-y0)/(y1-y0), float64(box.Bottom)
+			},
+		},
+	}
+
+	polygon := points
+	for _, edge := range edges {
+		if len(polygon) == 0 {
+			break
+		}
+		input := polygon
+		polygon = nil
+		previous := input[len(input)-1]
+		for _, current := range input {
+			if edge.inside(current.X, current.Y) {
+				if !edge.inside(previous.X, previous.Y) {
+					x, y := edge.intersect(previous.X, previous.Y, current.X, current.Y)
+					polygon = append(polygon, floatPoint{x, y})
+				}
+				polygon = append(polygon, current)
+			} else if edge.inside(previous.X, previous.Y) {
+				x, y := edge.intersect(previous.X, previous.Y, current.X, current.Y)
+				polygon = append(polygon, floatPoint{x, y})
+			}
+			previous = current
+		}
+	}
+	return polygon
+}
+
+// clipBox returns the part of a box within another, with its sides in order, and false if none of it is.
+// Boxes with no height or width, like a bar for a zero value, are kept as long as they are within the box.
+func clipBox(box, b Box) (Box, bool) {
+	clipped := Box{
+		Top:    MaxInt(MinInt(b.Top, b.Bottom), box.Top),
+		Left:   MaxInt(MinInt(b.Left, b.Right), box.Left),
+		Right:  MinInt(MaxInt(b.Left, b.Right), box.Right),
+		Bottom: MinInt(MaxInt(b.Top, b.Bottom), box.Bottom),
+	}
+	if clipped.Left > clipped.Right || clipped.Top > clipped.Bottom {
+		return Box{}, false
+	}
+	return clipped, true
+}
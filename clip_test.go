@@ -0,0 +1,195 @@
+package chart
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestClipSegment(t *testing.T) {
+	assert := assert.New(t)
+
+	box := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+
+	from, to, visible := clipSegment(box, floatPoint{10, 10}, floatPoint{90, 90})
+	assert.True(visible)
+	assert.Equal(floatPoint{10, 10}, from)
+	assert.Equal(floatPoint{90, 90}, to)
+
+	// crossing the top is cut at the intersection.
+	from, to, visible = clipSegment(box, floatPoint{50, 50}, floatPoint{150, -50})
+	assert.True(visible)
+	assert.Equal(floatPoint{50, 50}, from)
+	assert.Equal(floatPoint{100, 0}, to)
+
+	// through the box from outside on both ends.
+	from, to, visible = clipSegment(box, floatPoint{-50, 50}, floatPoint{150, 50})
+	assert.True(visible)
+	assert.Equal(floatPoint{0, 50}, from)
+	assert.Equal(floatPoint{100, 50}, to)
+
+	_, _, visible = clipSegment(box, floatPoint{-10, -10}, floatPoint{-10, 200})
+	assert.False(visible)
+	// beyond a corner without touching the box.
+	_, _, visible = clipSegment(box, floatPoint{90, -20}, floatPoint{120, 10})
+	assert.False(visible)
+}
+
+func TestClipPolyline(t *testing.T) {
+	assert := assert.New(t)
+
+	box := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+
+	inside := []floatPoint{{0, 50}, {50, 10}, {100, 50}}
+	assert.Equal([][]floatPoint{inside}, clipPolyline(box, inside))
+
+	// leaving and coming back splits the line.
+	runs := clipPolyline(box, []floatPoint{{0, 50}, {50, -50}, {100, 50}})
+	assert.Equal([][]floatPoint{{{0, 50}, {25, 0}}, {{75, 0}, {100, 50}}}, runs)
+
+	assert.Empty(clipPolyline(box, []floatPoint{{-10, -10}, {-20, 50}}))
+	assert.Equal([][]floatPoint{{{5, 5}}}, clipPolyline(box, []floatPoint{{5, 5}}))
+	assert.Empty(clipPolyline(box, []floatPoint{{500, 5}}))
+}
+
+func TestClipPolygon(t *testing.T) {
+	assert := assert.New(t)
+
+	box := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+
+	inside := []floatPoint{{10, 10}, {90, 10}, {90, 90}, {10, 90}}
+	assert.Equal(inside, clipPolygon(box, inside))
+
+	clipped := clipPolygon(box, []floatPoint{{50, -50}, {150, 50}, {50, 150}, {-50, 50}})
+	assert.Len(clipped, 8)
+	for _, p := range clipped {
+		assert.Zero(outcode(box, p.X, p.Y))
+	}
+
+	assert.Empty(clipPolygon(box, []floatPoint{{200, 200}, {300, 200}, {300, 300}}))
+}
+
+func TestChartRenderClipsSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  300,
+		Height: 200,
+		YAxis: YAxis{
+			Range: &ContinuousRange{Min: 0, Max: 5},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				Style:   Style{StrokeColor: ColorRed, StrokeWidth: 1, FillColor: ColorBlue},
+				XValues: []float64{0, 1, 2, 3, 4},
+				YValues: []float64{1, 10, 2, -10, 3},
+			},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	canvasBox, paths := svgSeriesPaths(buffer.String(), ColorRed, ColorBlue)
+	assert.Len(paths, 2)
+
+	for _, path := range paths {
+		for _, p := range svgPathPoints.FindAllStringSubmatch(path[1], -1) {
+			x, _ := strconv.ParseFloat(p[1], 64)
+			y, _ := strconv.ParseFloat(p[2], 64)
+			assert.Zero(outcode(canvasBox, x, y), p[0])
+		}
+		if strings.Contains(path[2], "stroke:"+ColorRed.Hex()) {
+			// the spikes out of the range split the line into three runs.
+			assert.Equal(3, strings.Count(path[1], "M "))
+		}
+	}
+}
+
+func TestChartRenderClipsBandsAndBars(t *testing.T) {
+	assert := assert.New(t)
+
+	style := Style{StrokeColor: ColorRed, StrokeWidth: 1, FillColor: ColorBlue}
+	xvalues := []float64{0, 1, 2, 3, 4}
+	c := Chart{
+		Width:  300,
+		Height: 200,
+		XAxis: XAxis{
+			Range: &ContinuousRange{Min: 0, Max: 4},
+		},
+		YAxis: YAxis{
+			Range: &ContinuousRange{Min: 0, Max: 5},
+		},
+		Series: []Series{
+			FillBetweenSeries{
+				Style: style,
+				Upper: ContinuousSeries{XValues: xvalues, YValues: []float64{2, 10, 3, 10, 4}},
+				Lower: ContinuousSeries{XValues: xvalues, YValues: []float64{1, -10, 2, 1, -10}},
+			},
+			HistogramSeries{
+				Style:       style,
+				InnerSeries: ContinuousSeries{XValues: xvalues, YValues: []float64{1, 10, -10, 3, 4}},
+			},
+			OHLCSeries{
+				Style:   style,
+				XValues: xvalues,
+				Open:    []float64{1, 2, -8, 3, 8},
+				High:    []float64{10, 3, 2, 4, 10},
+				Low:     []float64{0, -10, -10, 2, 6},
+				Close:   []float64{2, 1, -6, 4, 9},
+			},
+			BoxPlotSeries{
+				Style:         style,
+				MedianStyle:   style,
+				XValues:       xvalues,
+				Min:           []float64{-10, 1, -10, -9, 6},
+				LowerQuartile: []float64{-5, 2, 1, -8, 7},
+				Median:        []float64{-1, 3, 2, -7, 8},
+				UpperQuartile: []float64{1, 4, 3, -6, 9},
+				Max:           []float64{10, 5, 4, 10, 10},
+			},
+			&BinnedHistogramSeries{
+				Style:    style,
+				Values:   []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 3},
+				BinEdges: []float64{-1, 1.5, 3, 5},
+			},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	canvasBox, paths := svgSeriesPaths(buffer.String(), ColorRed, ColorBlue)
+	assert.NotEmpty(paths)
+
+	for _, path := range paths {
+		for _, p := range svgPathPoints.FindAllStringSubmatch(path[1], -1) {
+			x, _ := strconv.ParseFloat(p[1], 64)
+			y, _ := strconv.ParseFloat(p[2], 64)
+			assert.Zero(outcode(canvasBox, x, y), p[0])
+		}
+	}
+}
+
+var svgPathPoints = regexp.MustCompile(`[ML] (-?[\d.]+) (-?[\d.]+)`)
+
+// svgSeriesPaths returns the canvas box of a rendered chart, and the data and style of the paths drawn with
+// the given stroke or fill color.
+func svgSeriesPaths(svg string, stroke, fill drawing.Color) (canvasBox Box, series [][]string) {
+	paths := regexp.MustCompile(`<path  d="([^"]*)" style="([^"]*)"`).FindAllStringSubmatch(svg, -1)
+
+	// the second path is the canvas, after the background.
+	corners := svgPathPoints.FindAllStringSubmatch(paths[1][1], -1)
+	canvasBox.Left, _ = strconv.Atoi(corners[0][1])
+	canvasBox.Top, _ = strconv.Atoi(corners[0][2])
+	canvasBox.Right, _ = strconv.Atoi(corners[2][1])
+	canvasBox.Bottom, _ = strconv.Atoi(corners[2][2])
+
+	for _, path := range paths[2:] {
+		if strings.Contains(path[2], "stroke:"+stroke.Hex()) || strings.Contains(path[2], "fill:"+fill.Hex()) {
+			series = append(series, path)
+		}
+	}
+	return
+}
@@ -4,34 +4,34 @@ import "github.com/wcharczuk/go-chart/drawing"
 
 var (
 	// ColorWhite is white.
-	ColorWhite = drawing.Color{R: 255, G: 255, B: 255, A: 255}
+	ColorWhite = drawing.ColorFromHex("ffffff")
 	// ColorBlue is the basic theme blue color.
-	ColorBlue = drawing.Color{R: 0, G: 116, B: 217, A: 255}
+	ColorBlue = drawing.ColorFromHex("0074d9")
 	// ColorCyan is the basic theme cyan color.
-	ColorCyan = drawing.Color{R: 0, G: 217, B: 210, A: 255}
+	ColorCyan = drawing.ColorFromHex("00d9d2")
 	// ColorGreen is the basic theme green color.
-	ColorGreen = drawing.Color{R: 0, G: 217, B: 101, A: 255}
+	ColorGreen = drawing.ColorFromHex("00d965")
 	// ColorRed is the basic theme red color.
-	ColorRed = drawing.Color{R: 217, G: 0, B: 116, A: 255}
+	ColorRed = drawing.ColorFromHex("d90074")
 	// ColorOrange is the basic theme orange color.
-	ColorOrange = drawing.Color{R: 217, G: 101, B: 0, A: 255}
+	ColorOrange = drawing.ColorFromHex("d96500")
 	// ColorYellow is the basic theme yellow color.
-	ColorYellow = drawing.Color{R: 217, G: 210, B: 0, A: 255}
+	ColorYellow = drawing.ColorFromHex("d9d200")
 	// ColorBlack is the basic theme black color.
-	ColorBlack = drawing.Color{R: 51, G: 51, B: 51, A: 255}
+	ColorBlack = drawing.ColorFromHex("333333")
 	// ColorLightGray is the basic theme light gray color.
-	ColorLightGray = drawing.Color{R: 239, G: 239, B: 239, A: 255}
+	ColorLightGray = drawing.ColorFromHex("efefef")
 
 	// ColorAlternateBlue is a alternate theme color.
-	ColorAlternateBlue = drawing.Color{R: 106, G: 195, B: 203, A: 255}
+	ColorAlternateBlue = drawing.ColorFromHex("6ac3cb")
 	// ColorAlternateGreen is a alternate theme color.
-	ColorAlternateGreen = drawing.Color{R: 42, G: 190, B: 137, A: 255}
+	ColorAlternateGreen = drawing.ColorFromHex("2abe89")
 	// ColorAlternateGray is a alternate theme color.
-	ColorAlternateGray = drawing.Color{R: 110, G: 128, B: 139, A: 255}
+	ColorAlternateGray = drawing.ColorFromHex("6e808b")
 	// ColorAlternateYellow is a alternate theme color.
-	ColorAlternateYellow = drawing.Color{R: 240, G: 174, B: 90, A: 255}
+	ColorAlternateYellow = drawing.ColorFromHex("f0ae5a")
 	// ColorAlternateLightGray is a alternate theme color.
-	ColorAlternateLightGray = drawing.Color{R: 187, G: 190, B: 191, A: 255}
+	ColorAlternateLightGray = drawing.ColorFromHex("bbbebf")
 
 	// ColorTransparent is a transparent (alpha zero) color.
 	ColorTransparent = drawing.Color{R: 1, G: 1, B: 1, A: 0}
@@ -66,6 +66,11 @@ var (
 	DefaultAnnotationFillColor = ColorWhite
 	// DefaultGridLineColor is the default grid line color.
 	DefaultGridLineColor = ColorLightGray
+	// DefaultZeroLineColor is the default color of the line at zero on the y axis.
+	// It is equivalent to #999999.
+	DefaultZeroLineColor = drawing.ColorFromHex("999999")
+	// DefaultWatermarkColor is the default color of watermark text, a faint gray.
+	DefaultWatermarkColor = drawing.Color{R: 128, G: 128, B: 128, A: 48}
 )
 
 var (
@@ -90,20 +95,65 @@ var (
 		ColorCyan,
 		ColorOrange,
 	}
+
+	// ColorblindSafeColors are series colors that stay distinguishable with the common forms of color blindness.
+	// They are the Okabe-Ito palette, without its black.
+	ColorblindSafeColors = []drawing.Color{
+		drawing.ColorFromHex("0072b2"),
+		drawing.ColorFromHex("e69f00"),
+		drawing.ColorFromHex("009e73"),
+		drawing.ColorFromHex("cc79a7"),
+		drawing.ColorFromHex("56b4e9"),
+		drawing.ColorFromHex("d55e00"),
+		drawing.ColorFromHex("f0e442"),
+	}
 )
 
+// InterpolatedColorProvider returns a color provider that interpolates through the given colors,
+// spread evenly from the min to the max value. Values that can't be placed between them, like NaN, are transparent.
+func InterpolatedColorProvider(colors ...drawing.Color) ColorProvider {
+	return func(v, vmin, vmax float64) drawing.Color {
+		if len(colors) == 0 {
+			return drawing.ColorTransparent
+		}
+		if len(colors) == 1 || vmax <= vmin {
+			return colors[0]
+		}
+		t := (v - vmin) / (vmax - vmin)
+		if !isFinite(t) {
+			return drawing.ColorTransparent
+		}
+		if t <= 0 {
+			return colors[0]
+		}
+		if t >= 1 {
+			return colors[len(colors)-1]
+		}
+		scaled := t * float64(len(colors)-1)
+		index := int(scaled)
+		return colors[index].Interpolate(colors[index+1], scaled-float64(index))
+	}
+}
+
 // GetDefaultColor returns a color from the default list by index.
 // NOTE: the index will wrap around (using a modulo).
 func GetDefaultColor(index int) drawing.Color {
-	finalIndex := index % len(DefaultColors)
-	return DefaultColors[finalIndex]
+	return getColor(DefaultColors, index)
 }
 
 // GetAlternateColor returns a color from the default list by index.
 // NOTE: the index will wrap around (using a modulo).
 func GetAlternateColor(index int) drawing.Color {
-	finalIndex := index % len(DefaultAlternateColors)
-	return DefaultAlternateColors[finalIndex]
+	return getColor(DefaultAlternateColors, index)
+}
+
+// getColor returns a color from a list by index, wrapping around in both directions.
+func getColor(colors []drawing.Color, index int) drawing.Color {
+	finalIndex := index % len(colors)
+	if finalIndex < 0 {
+		finalIndex += len(colors)
+	}
+	return colors[finalIndex]
 }
 
 // ColorPalette is a set of colors that.
@@ -182,3 +232,24 @@ func (ap alternateColorPalette) TextColor() drawing.Color {
 func (ap alternateColorPalette) GetSeriesColor(index int) drawing.Color {
 	return GetAlternateColor(index)
 }
+
+// ColorblindSafeColorPalette is the default palette with the colorblind safe series colors.
+var ColorblindSafeColorPalette = SeriesColorPalette(ColorblindSafeColors...)
+
+// SeriesColorPalette returns the default palette with the given series colors, e.g. `chart.Chart{ColorPalette: SeriesColorPalette(colors...)}`.
+// The series index wraps around the colors; without colors it uses the default series colors.
+func SeriesColorPalette(colors ...drawing.Color) ColorPalette {
+	return seriesColorPalette{colors: colors}
+}
+
+type seriesColorPalette struct {
+	defaultColorPalette
+	colors []drawing.Color
+}
+
+func (sp seriesColorPalette) GetSeriesColor(index int) drawing.Color {
+	if len(sp.colors) == 0 {
+		return GetDefaultColor(index)
+	}
+	return getColor(sp.colors, index)
+}
@@ -0,0 +1,73 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestDefaultColorsFromHex(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{"#0074d9", "#00d965", "#d90074", "#00d9d2", "#d96500"}, colorHexes(DefaultColors))
+	assert.Equal([]string{"#6ac3cb", "#2abe89", "#6e808b", "#f0ae5a", "#0074d9", "#00d965", "#d90074", "#00d9d2", "#d96500"}, colorHexes(DefaultAlternateColors))
+
+	for _, colors := range [][]drawing.Color{DefaultColors, DefaultAlternateColors} {
+		for _, c := range colors {
+			assert.Equal(c, drawing.ColorFromHex(c.Hex()))
+		}
+	}
+	assert.Equal(drawing.Color{R: 51, G: 51, B: 51, A: 255}, ColorBlack)
+	assert.Equal(drawing.Color{R: 239, G: 239, B: 239, A: 255}, ColorLightGray)
+}
+
+func colorHexes(colors []drawing.Color) []string {
+	var hexes []string
+	for _, c := range colors {
+		hexes = append(hexes, c.Hex())
+	}
+	return hexes
+}
+
+func TestGetDefaultColorWraps(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(DefaultColors[0], GetDefaultColor(len(DefaultColors)))
+	assert.Equal(DefaultColors[len(DefaultColors)-1], GetDefaultColor(-1))
+	assert.Equal(DefaultAlternateColors[2], GetAlternateColor(2*len(DefaultAlternateColors)+2))
+}
+
+func TestSeriesColorPalette(t *testing.T) {
+	assert := assert.New(t)
+
+	cp := SeriesColorPalette(drawing.ColorRed, drawing.ColorBlue)
+	assert.Equal(DefaultBackgroundColor, cp.BackgroundColor())
+	assert.Equal(DefaultTextColor, cp.TextColor())
+	assert.Equal(drawing.ColorRed, cp.GetSeriesColor(0))
+	assert.Equal(drawing.ColorBlue, cp.GetSeriesColor(11))
+	assert.Equal(drawing.ColorBlue, cp.GetSeriesColor(-1))
+
+	assert.Equal(GetDefaultColor(7), SeriesColorPalette().GetSeriesColor(7))
+	assert.Equal(ColorblindSafeColors[1], ColorblindSafeColorPalette.GetSeriesColor(len(ColorblindSafeColors)+1))
+}
+
+func TestChartSeriesColorPalette(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		ColorPalette: ColorblindSafeColorPalette,
+		Series: []Series{
+			ContinuousSeries{Name: "a", XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+			ContinuousSeries{Name: "b", XValues: []float64{1, 2}, YValues: []float64{2, 1}},
+		},
+	}
+	c.Elements = []Renderable{Legend(&c)}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	// once for the series, once for the legend swatch.
+	assert.Equal(2, strings.Count(buffer.String(), "stroke:"+ColorblindSafeColors[1].Hex()))
+}
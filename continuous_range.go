@@ -5,6 +5,12 @@ import (
 	"math"
 )
 
+// Interface Assertions.
+var (
+	_ Range           = (*ContinuousRange)(nil)
+	_ FloatTranslator = (*ContinuousRange)(nil)
+)
+
 // ContinuousRange represents a boundary for a set of numbers.
 type ContinuousRange struct {
 	Min        float64
@@ -25,6 +31,12 @@ func (r ContinuousRange) IsZero() bool {
 		r.Domain == 0
 }
 
+// IsDegenerate returns if the range has no delta, i.e. min and max are equal.
+// Values translate to the middle of the domain of a degenerate range.
+func (r ContinuousRange) IsDegenerate() bool {
+	return r.Min == r.Max
+}
+
 // GetMin gets the min value for the continuous range.
 func (r ContinuousRange) GetMin() float64 {
 	return r.Min
@@ -70,6 +82,9 @@ func (r ContinuousRange) String() string {
 
 // Translate maps a given value into the ContinuousRange space.
 func (r ContinuousRange) Translate(value float64) int {
+	if r.IsDegenerate() {
+		return r.Domain / 2
+	}
 	normalized := value - r.Min
 	ratio := normalized / r.GetDelta()
 
@@ -79,3 +94,16 @@ func (r ContinuousRange) Translate(value float64) int {
 
 	return int(math.Ceil(ratio * float64(r.Domain)))
 }
+
+// TranslateFloat maps a given value into the ContinuousRange space without rounding to a whole pixel.
+func (r ContinuousRange) TranslateFloat(value float64) float64 {
+	if r.IsDegenerate() {
+		return float64(r.Domain) / 2
+	}
+	ratio := (value - r.Min) / r.GetDelta()
+	if r.IsDescending() {
+		return float64(r.Domain) * (1 - ratio)
+	}
+
+	return ratio * float64(r.Domain)
+}
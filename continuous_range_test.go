@@ -20,3 +20,48 @@ func TestRangeTranslate(t *testing.T) {
 	assert.Equal(1000, r.Translate(8.0))
 	assert.Equal(572, r.Translate(5.0))
 }
+
+func TestRangeTranslateDescending(t *testing.T) {
+	assert := assert.New(t)
+
+	r := ContinuousRange{Min: 1.0, Max: 8.0, Domain: 1000, Descending: true}
+	assert.Equal(1000, r.Translate(1.0))
+	assert.Equal(0, r.Translate(8.0))
+	assert.True(r.Translate(2.0) > r.Translate(7.0))
+}
+
+func TestRangeIsDegenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(ContinuousRange{}.IsDegenerate())
+	assert.True(ContinuousRange{Min: 3, Max: 3}.IsDegenerate())
+	assert.False(ContinuousRange{Min: 1, Max: 3}.IsDegenerate())
+
+	// values translate to the middle of a degenerate range, rather than dividing by its zero delta.
+	r := ContinuousRange{Min: 3, Max: 3, Domain: 100}
+	assert.Equal(50, r.Translate(3))
+	assert.Equal(50.0, r.TranslateFloat(3))
+	assert.Equal(50, TimeRange{Min: 3, Max: 3, Domain: 100}.Translate(3))
+	assert.Equal(50, LogarithmicRange{Min: 10, Max: 10, Domain: 100}.Translate(10))
+}
+
+func TestRangeTranslateFloat(t *testing.T) {
+	assert := assert.New(t)
+
+	r := ContinuousRange{Min: 0, Max: 8, Domain: 100}
+	assert.Equal(62.5, r.TranslateFloat(5))
+	assert.Equal(63, r.Translate(5))
+	assert.Equal(62.5, translateFloat(&r, 5))
+
+	r.Descending = true
+	assert.Equal(37.5, r.TranslateFloat(5))
+	assert.Equal(100.0, r.TranslateFloat(0))
+
+	// ranges that only translate to whole pixels fall back to them.
+	assert.Equal(63.0, translateFloat(wholePixelRange{&ContinuousRange{Min: 0, Max: 8, Domain: 100}}, 5))
+}
+
+// wholePixelRange hides the fractional translation of a range, like ranges that predate it.
+type wholePixelRange struct {
+	Range
+}
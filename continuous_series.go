@@ -7,8 +7,20 @@ var (
 	_ Series              = (*ContinuousSeries)(nil)
 	_ FirstValuesProvider = (*ContinuousSeries)(nil)
 	_ LastValuesProvider  = (*ContinuousSeries)(nil)
+	_ BoundsProvider      = (*ContinuousSeries)(nil)
 )
 
+// NewContinuousSeries returns a new continuous series with the given name and values.
+// The series remembers its bounds between renders; see `ResetBounds`.
+func NewContinuousSeries(name string, xvalues, yvalues []float64) ContinuousSeries {
+	return ContinuousSeries{
+		Name:    name,
+		XValues: xvalues,
+		YValues: yvalues,
+		bounds:  new(boundsCache),
+	}
+}
+
 // ContinuousSeries represents a line on a chart.
 type ContinuousSeries struct {
 	Name  string
@@ -21,6 +33,8 @@ type ContinuousSeries struct {
 
 	XValues []float64
 	YValues []float64
+
+	bounds *boundsCache
 }
 
 // GetName returns the name of the time series.
@@ -34,23 +48,51 @@ func (cs ContinuousSeries) GetStyle() Style {
 }
 
 // Len returns the number of elements in the series.
+// If the x and y values differ in length, the shorter of the two is used.
 func (cs ContinuousSeries) Len() int {
-	return len(cs.XValues)
+	return MinInt(len(cs.XValues), len(cs.YValues))
 }
 
 // GetValues gets the x,y values at a given index.
-func (cs ContinuousSeries) GetValues(index int) (float64, float64) {
-	return cs.XValues[index], cs.YValues[index]
+// It returns zero values if the index is out of bounds.
+func (cs ContinuousSeries) GetValues(index int) (x, y float64) {
+	if index < 0 || index >= cs.Len() {
+		return
+	}
+	x, y = cs.XValues[index], cs.YValues[index]
+	return
+}
+
+// GetBounds returns the bounds of the finite values of the series.
+// Series made with `NewContinuousSeries` only scan their values again when `XValues` or `YValues`
+// is replaced or changes length.
+func (cs ContinuousSeries) GetBounds() (minx, maxx, miny, maxy float64) {
+	key := boundsCacheKey{xlen: len(cs.XValues), ylen: len(cs.YValues)}
+	if key.xlen > 0 {
+		key.x = &cs.XValues[0]
+	}
+	if key.ylen > 0 {
+		key.y = &cs.YValues[0]
+	}
+	return cs.bounds.get(key, func() (float64, float64, float64, float64) {
+		return bounds(cs.XValues, cs.YValues)
+	})
+}
+
+// ResetBounds makes the series scan its values for its bounds again.
+// Call it after changing values in place.
+func (cs ContinuousSeries) ResetBounds() {
+	cs.bounds.reset()
 }
 
 // GetFirstValues gets the first x,y values.
 func (cs ContinuousSeries) GetFirstValues() (float64, float64) {
-	return cs.XValues[0], cs.YValues[0]
+	return cs.GetValues(0)
 }
 
 // GetLastValues gets the last x,y values.
 func (cs ContinuousSeries) GetLastValues() (float64, float64) {
-	return cs.XValues[len(cs.XValues)-1], cs.YValues[len(cs.YValues)-1]
+	return cs.GetValues(cs.Len() - 1)
 }
 
 // GetValueFormatters returns value formatter defaults for the series.
@@ -63,7 +105,7 @@ func (cs ContinuousSeries) GetValueFormatters() (x, y ValueFormatter) {
 	if cs.YValueFormatter != nil {
 		y = cs.YValueFormatter
 	} else {
-		y = FloatValueFormatter
+		y = defaultValueFormatter
 	}
 	return
 }
@@ -80,6 +122,7 @@ func (cs ContinuousSeries) Render(r Renderer, canvasBox Box, xrange, yrange Rang
 }
 
 // Validate validates the series.
+// Values of differing lengths are valid; the series is drawn up to the shorter of the two.
 func (cs ContinuousSeries) Validate() error {
 	if len(cs.XValues) == 0 {
 		return fmt.Errorf("continuous series; must have xvalues set")
@@ -88,9 +131,5 @@ func (cs ContinuousSeries) Validate() error {
 	if len(cs.YValues) == 0 {
 		return fmt.Errorf("continuous series; must have yvalues set")
 	}
-
-	if len(cs.XValues) != len(cs.YValues) {
-		return fmt.Errorf("continuous series; must have same length xvalues as yvalues")
-	}
 	return nil
 }
@@ -1,10 +1,14 @@
 package chart
 
 import (
+	"bytes"
 	"fmt"
+	"math"
+	"strings"
 	"testing"
 
 	assert "github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
 )
 
 func TestContinuousSeries(t *testing.T) {
@@ -69,4 +73,207 @@ func TestContinuousSeriesValidate(t *testing.T) {
 		YValues: LinearRange(1.0, 10.0),
 	}
 	assert.NotNil(cs.Validate())
+
+	cs = ContinuousSeries{
+		Name:    "Test Series",
+		XValues: LinearRange(1.0, 10.0),
+		YValues: LinearRange(1.0, 5.0),
+	}
+	assert.Nil(cs.Validate())
+}
+
+func TestNewContinuousSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := NewContinuousSeries("Test Series", LinearRange(1.0, 5.0), LinearRange(2.0, 6.0))
+	assert.Equal("Test Series", cs.GetName())
+	assert.Equal(5, cs.Len())
+
+	x, y := cs.GetFirstValues()
+	assert.Equal(1.0, x)
+	assert.Equal(2.0, y)
+}
+
+func TestContinuousSeriesMismatchedLengths(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := ContinuousSeries{
+		XValues: LinearRange(1.0, 10.0),
+		YValues: LinearRange(1.0, 5.0),
+	}
+	assert.Equal(5, cs.Len())
+
+	x, y := cs.GetLastValues()
+	assert.Equal(5.0, x)
+	assert.Equal(5.0, y)
+
+	x, y = cs.GetValues(7)
+	assert.Zero(x)
+	assert.Zero(y)
+
+	empty := ContinuousSeries{}
+	assert.Zero(empty.Len())
+	x, y = empty.GetLastValues()
+	assert.Zero(x)
+	assert.Zero(y)
+}
+
+func TestContinuousSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			NewContinuousSeries("matched", LinearRange(1.0, 10.0), LinearRange(1.0, 10.0)),
+			NewContinuousSeries("mismatched", LinearRange(1.0, 10.0), LinearRange(1.0, 5.0)),
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestContinuousSeriesGetBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := ContinuousSeries{
+		XValues: []float64{3, 1, math.NaN(), 4, 2},
+		YValues: []float64{5, -2, 100, math.Inf(1), 8},
+	}
+	minx, maxx, miny, maxy := cs.GetBounds()
+	assert.Equal(1.0, minx)
+	assert.Equal(3.0, maxx)
+	assert.Equal(-2.0, miny)
+	assert.Equal(8.0, maxy)
+
+	minx, _, _, maxy = ContinuousSeries{}.GetBounds()
+	assert.True(math.IsNaN(minx))
+	assert.True(math.IsNaN(maxy))
+}
+
+func TestContinuousSeriesCachedBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := NewContinuousSeries("cached", []float64{1, 2, 3}, []float64{4, 5, 6})
+	_, _, _, maxy := cs.GetBounds()
+	assert.Equal(6.0, maxy)
+
+	// changes in place aren't seen until the bounds are reset.
+	cs.YValues[2] = 10
+	_, _, _, maxy = cs.GetBounds()
+	assert.Equal(6.0, maxy)
+	cs.ResetBounds()
+	_, _, _, maxy = cs.GetBounds()
+	assert.Equal(10.0, maxy)
+
+	// new or longer values are.
+	cs.XValues = append(cs.XValues, 4)
+	cs.YValues = append(cs.YValues, 20)
+	_, maxx, _, maxy := cs.GetBounds()
+	assert.Equal(4.0, maxx)
+	assert.Equal(20.0, maxy)
+	cs.YValues = []float64{-1, -2, -3, -4}
+	_, _, miny, maxy := cs.GetBounds()
+	assert.Equal(-4.0, miny)
+	assert.Equal(-1.0, maxy)
+
+	// series without a cache always scan their values.
+	plain := ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{4, 5}}
+	plain.GetBounds()
+	plain.YValues[1] = 10
+	_, _, _, maxy = plain.GetBounds()
+	assert.Equal(10.0, maxy)
+}
+
+func decimationSeries(count int) ContinuousSeries {
+	cs := ContinuousSeries{
+		XValues: LinearRange(0, float64(count-1)),
+		YValues: make([]float64, count),
+	}
+	for index := range cs.YValues {
+		cs.YValues[index] = math.Sin(float64(index)/100) + 0.01*math.Sin(float64(index))
+	}
+	return cs
+}
+
+func TestContinuousSeriesDecimatedRuns(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := decimationSeries(10000)
+	cs.YValues[5000] = 10
+	cs.YValues[5001] = math.NaN()
+
+	canvasBox := Box{Top: 0, Left: 0, Right: 200, Bottom: 100}
+	xrange := &ContinuousRange{Min: 0, Max: 9999, Domain: canvasBox.Width()}
+	yrange := &ContinuousRange{Min: -2, Max: 10, Domain: canvasBox.Height()}
+
+	full := Draw.lineSeriesRuns(canvasBox, xrange, yrange, cs, false)
+	decimated := Draw.lineSeriesRuns(canvasBox, xrange, yrange, cs, true)
+	assert.Len(decimated, 2)
+
+	// every pixel column keeps its first, last, lowest and highest point.
+	extents := func(runs [][]floatPoint) map[int][4]float64 {
+		columns := map[int][4]float64{}
+		for runIndex, run := range runs {
+			for _, p := range run {
+				key := runIndex<<16 | int(math.Floor(p.X))
+				if column, ok := columns[key]; ok {
+					columns[key] = [4]float64{column[0], p.Y, math.Min(column[2], p.Y), math.Max(column[3], p.Y)}
+				} else {
+					columns[key] = [4]float64{p.Y, p.Y, p.Y, p.Y}
+				}
+			}
+		}
+		return columns
+	}
+	assert.Equal(extents(full), extents(decimated))
+	assert.True(len(decimated[0])+len(decimated[1]) <= 4*(canvasBox.Width()+1))
+
+	// unsorted x values fall back to every point.
+	cs.XValues[10], cs.XValues[9000] = cs.XValues[9000], cs.XValues[10]
+	assert.Equal(Draw.lineSeriesRuns(canvasBox, xrange, yrange, cs, false), Draw.lineSeriesRuns(canvasBox, xrange, yrange, cs, true))
+}
+
+func TestContinuousSeriesRenderDecimated(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:          200,
+		Height:         100,
+		TitleStyle:     Hidden(),
+		XAxis:          HideXAxis(),
+		YAxis:          HideYAxis(),
+		YAxisSecondary: HideYAxis(),
+		Series:         []Series{decimationSeries(100000)},
+	}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	assert.True(strings.Count(buffer.String(), "L ") < 4*c.Width)
+}
+
+func benchmarkLineSeries(b *testing.B, decimate bool) {
+	cs := decimationSeries(5000000)
+	canvasBox := Box{Top: 0, Left: 0, Right: 800, Bottom: 400}
+	xrange := &ContinuousRange{Min: 0, Max: float64(cs.Len() - 1), Domain: canvasBox.Width()}
+	yrange := &ContinuousRange{Min: -2, Max: 2, Domain: canvasBox.Height()}
+	style := Style{StrokeColor: drawing.ColorBlue, StrokeWidth: 1}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		r, _ := SVG(800, 400)
+		style.GetStrokeOptions().WriteDrawingOptionsToRenderer(r)
+		for _, run := range Draw.lineSeriesRuns(canvasBox, xrange, yrange, cs, decimate) {
+			Draw.path(r, run)
+		}
+		r.Stroke()
+		r.Save(bytes.NewBuffer(nil))
+	}
+}
+
+func BenchmarkLineSeriesNaive(b *testing.B) {
+	benchmarkLineSeries(b, false)
+}
+
+func BenchmarkLineSeriesDecimated(b *testing.B) {
+	benchmarkLineSeries(b, true)
 }
@@ -5,6 +5,16 @@ const (
 	DefaultChartHeight = 400
 	// DefaultChartWidth is the default chart width.
 	DefaultChartWidth = 1024
+	// DefaultSparklineWidth is the default width of a sparkline chart.
+	DefaultSparklineWidth = 120
+	// DefaultSparklineHeight is the default height of a sparkline chart.
+	DefaultSparklineHeight = 28
+	// DefaultSparklineDotWidth is the radius of the dots on the lowest, highest and last values of a sparkline.
+	DefaultSparklineDotWidth = 2.0
+	// DefaultWatermarkFontSize is the default font size of watermark text.
+	DefaultWatermarkFontSize = 36.0
+	// DefaultWatermarkGap is the default space between tiles of watermark text.
+	DefaultWatermarkGap = 40
 	// DefaultStrokeWidth is the default chart stroke width.
 	DefaultStrokeWidth = 0.0
 	// DefaultDotWidth is the default chart dot width.
@@ -13,6 +23,8 @@ const (
 	DefaultSeriesLineWidth = 1.0
 	// DefaultAxisLineWidth is the line width of the axis lines.
 	DefaultAxisLineWidth = 1.0
+	// DefaultZeroLineWidth is the line width of the line at zero on the y axis.
+	DefaultZeroLineWidth = 2.0
 	//DefaultDPI is the default dots per inch for the chart.
 	DefaultDPI = 92.0
 	// DefaultMinimumFontSize is the default minimum font size.
@@ -21,14 +33,26 @@ const (
 	DefaultFontSize = 10.0
 	// DefaultTitleFontSize is the default title font size.
 	DefaultTitleFontSize = 18.0
+	// DefaultSubtitleFontSize is the default subtitle font size.
+	DefaultSubtitleFontSize = 12.0
 	// DefaultAnnotationDeltaWidth is the width of the left triangle out of annotations.
 	DefaultAnnotationDeltaWidth = 10
 	// DefaultAnnotationFontSize is the font size of annotations.
 	DefaultAnnotationFontSize = 10.0
+	// DefaultAnnotationConnectorMinLength is the distance a label has to be from its point to draw a connector to it.
+	DefaultAnnotationConnectorMinLength = 2
 	// DefaultAxisFontSize is the font size of the axis labels.
 	DefaultAxisFontSize = 10.0
 	// DefaultTitleTop is the default distance from the top of the chart to put the title.
 	DefaultTitleTop = 10
+	// DefaultTitleBottom is the default distance between the title and the canvas.
+	DefaultTitleBottom = 10
+	// DefaultMarkerFontSize is the font size of marker labels.
+	DefaultMarkerFontSize = 8.0
+	// DefaultMarkerLabelPadding is the distance between a marker label and its line or the canvas edge.
+	DefaultMarkerLabelPadding = 3
+	// DefaultBandAlpha is the alpha of the default band fill color.
+	DefaultBandAlpha = 32
 
 	// DefaultBackgroundStrokeWidth is the default stroke on the chart background.
 	DefaultBackgroundStrokeWidth = 0.0
@@ -37,6 +61,12 @@ const (
 
 	// DefaultLineSpacing is the default vertical distance between lines of text.
 	DefaultLineSpacing = 5
+	// DefaultTextEllipsis is appended to text that is truncated to fit.
+	DefaultTextEllipsis = "…"
+	// DefaultStackedBarTickCount is roughly how many ticks the value axis of a stacked bar chart is divided into.
+	DefaultStackedBarTickCount = 5
+	// DefaultBarLabelMaxLines is the most lines a bar chart category label wraps onto before it is truncated.
+	DefaultBarLabelMaxLines = 2
 
 	// DefaultYAxisMargin is the default distance from the right of the canvas to the y axis labels.
 	DefaultYAxisMargin = 10
@@ -53,6 +83,9 @@ const (
 	// DefaultTickCountSanityCheck is a hard limit on number of ticks to prevent infinite loops.
 	DefaultTickCountSanityCheck = 1 << 10 //1024
 
+	// DefaultDegenerateRangeExpansion is the fraction of the value a range with equal min and max is widened by on each side.
+	DefaultDegenerateRangeExpansion = 0.05
+
 	// DefaultMinimumTickHorizontalSpacing is the minimum distance between horizontal ticks.
 	DefaultMinimumTickHorizontalSpacing = 20
 	// DefaultMinimumTickVerticalSpacing is the minimum distance between vertical ticks.
@@ -68,22 +101,49 @@ const (
 	DefaultFloatFormat = "%.2f"
 	// DefaultPercentValueFormat is the default percent format.
 	DefaultPercentValueFormat = "%0.2f%%"
+	// DefaultMaxStepPrecision is the most decimals axis labels get from their tick step.
+	DefaultMaxStepPrecision = 10
 
 	// DefaultBarSpacing is the default pixel spacing between bars.
 	DefaultBarSpacing = 100
 	// DefaultBarWidth is the default pixel width of bars in a bar chart.
 	DefaultBarWidth = 50
+	// DefaultTextMeasureCacheSize is the number of text measurements kept before the cache is cleared.
+	DefaultTextMeasureCacheSize = 4096
+	// DefaultDecimationRatio is the number of points per pixel of canvas width above which line series
+	// are drawn with the first, lowest, highest and last point of each pixel column.
+	DefaultDecimationRatio = 4
+	// DefaultDownsampleRatio is the number of points per pixel of canvas width a downsampled series is drawn with.
+	DefaultDownsampleRatio = 2
+	// DefaultValueLabelPadding is the distance between a point and its value label.
+	DefaultValueLabelPadding = 5
+	// DefaultBarValueLabelPadding is the distance between the end of a bar and its value label.
+	DefaultBarValueLabelPadding = 5
+	// DefaultBodyWidthRatio is the fraction of the smallest x spacing taken up by a candle or box body.
+	DefaultBodyWidthRatio = 0.7
+	// DefaultBoxPlotOutlierRadius is the default radius of the outlier dots of a box plot.
+	DefaultBoxPlotOutlierRadius = 3.0
+	// DefaultPieLeaderLineLength is the length of the leader line to a label drawn outside a pie slice.
+	DefaultPieLeaderLineLength = 20
+	// DefaultPieLeaderLabelPadding is the distance between the end of a leader line and its label.
+	DefaultPieLeaderLabelPadding = 3
+	// DefaultDonutInnerRadius is the default radius of a donut hole, as a fraction of the outer radius.
+	DefaultDonutInnerRadius = 0.5
+	// DefaultDonutCenterLabelFontStep is how much the center label font size is reduced by until it fits.
+	DefaultDonutCenterLabelFontStep = 1.0
+	// DefaultDonutCenterLabelMinFontSize is the smallest font size the center label is stepped down to.
+	DefaultDonutCenterLabelMinFontSize = 6.0
 )
 
 var (
 	// DashArrayDots is a dash array that represents '....' style stroke dashes.
-	DashArrayDots = []int{1, 1}
+	DashArrayDots = []float64{1, 1}
 	// DashArrayDashesSmall is a dash array that represents '- - -' style stroke dashes.
-	DashArrayDashesSmall = []int{3, 3}
+	DashArrayDashesSmall = []float64{3, 3}
 	// DashArrayDashesMedium is a dash array that represents '-- -- --' style stroke dashes.
-	DashArrayDashesMedium = []int{5, 5}
+	DashArrayDashesMedium = []float64{5, 5}
 	// DashArrayDashesLarge is a dash array that represents '----- ----- -----' style stroke dashes.
-	DashArrayDashesLarge = []int{10, 10}
+	DashArrayDashesLarge = []float64{10, 10}
 )
 
 var (
@@ -92,12 +152,26 @@ var (
 
 	// DefaultBackgroundPadding is the default canvas padding config.
 	DefaultBackgroundPadding = Box{Top: 5, Left: 5, Right: 5, Bottom: 5}
+
+	// DefaultValueAtPixelTolerance is the default distance in x, in pixels, a point is found from a pixel.
+	DefaultValueAtPixelTolerance = 10.0
+
+	// DefaultImageMapRadius is the default radius of the areas of an image map.
+	DefaultImageMapRadius = 5
+	// DefaultImageMapMaxAreas is the default most areas of a series in an image map.
+	DefaultImageMapMaxAreas = 500
+
+	// DefaultGridCellPadding is the default space around each chart within its grid cell.
+	DefaultGridCellPadding = Box{Top: 5, Left: 5, Right: 5, Bottom: 5}
 )
 
 const (
 	// ContentTypePNG is the png mime type.
 	ContentTypePNG = "image/png"
 
+	// ContentTypeJPEG is the jpeg mime type.
+	ContentTypeJPEG = "image/jpeg"
+
 	// ContentTypeSVG is the svg mime type.
 	ContentTypeSVG = "image/svg+xml"
 )
@@ -3,7 +3,9 @@ package chart
 import (
 	"errors"
 	"fmt"
+	"image"
 	"io"
+	"math"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -14,6 +16,10 @@ type DonutChart struct {
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme sets the colors and padding of the chart, including the color of the text in the hole; it defaults to
+	// `GetDefaultTheme()`. Slices keep the `AlternateColorPalette` colors unless the theme has series colors.
+	// A ColorPalette takes precedence over the theme colors.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -23,6 +29,13 @@ type DonutChart struct {
 	Canvas     Style
 	SliceStyle Style
 
+	// InnerRadius is the radius of the hole as a fraction of the outer radius.
+	InnerRadius float64
+
+	// CenterLabel is drawn in the hole, with the font size stepped down until it fits.
+	CenterLabel      string
+	CenterLabelStyle Style
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -49,6 +62,14 @@ func (pc DonutChart) GetFont() *truetype.Font {
 	return pc.Font
 }
 
+// GetInnerRadius returns the inner radius fraction or the default value.
+func (pc DonutChart) GetInnerRadius() float64 {
+	if pc.InnerRadius <= 0 || pc.InnerRadius >= 1 {
+		return DefaultDonutInnerRadius
+	}
+	return pc.InnerRadius
+}
+
 // GetWidth returns the chart width or the default value.
 func (pc DonutChart) GetWidth() int {
 	if pc.Width == 0 {
@@ -65,6 +86,12 @@ func (pc DonutChart) GetHeight() int {
 	return pc.Height
 }
 
+// RenderImage renders the chart with a raster renderer provider, like PNG, and returns the image
+// without encoding it.
+func (pc DonutChart) RenderImage(rp RendererProvider) (image.Image, error) {
+	return renderImage(rp, pc.Render)
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (pc DonutChart) Render(rp RendererProvider, w io.Writer) error {
 	if len(pc.Values) == 0 {
@@ -124,62 +151,93 @@ func (pc DonutChart) drawTitle(r Renderer) {
 func (pc DonutChart) drawSlices(r Renderer, canvasBox Box, values []Value) {
 	cx, cy := canvasBox.Center()
 	diameter := MinInt(canvasBox.Width(), canvasBox.Height())
+	if diameter < 2 {
+		// there is no room left for the circle once the padding is taken out.
+		return
+	}
 	radius := float64(diameter>>1) / 1.1
-	labelRadius := (radius * 2.83) / 3.0
+	innerRadius := radius * pc.GetInnerRadius()
+	labelRadius := (radius + innerRadius) / 2.0
 
 	// draw the donut slices
-	var rads, delta, delta2, total float64
-	var lx, ly int
-
-	if len(values) == 1 {
-		pc.styleDonutChartValue(0).WriteToRenderer(r)
-		r.MoveTo(cx, cy)
-		r.Circle(radius, cx, cy)
-	} else {
-		for index, v := range values {
-			v.Style.InheritFrom(pc.styleDonutChartValue(index)).WriteToRenderer(r)
-			r.MoveTo(cx, cy)
-			rads = PercentToRadians(total)
-			delta = PercentToRadians(v.Value)
-
-			r.ArcTo(cx, cy, (radius / 1.25), (radius / 1.25), rads, delta)
-
-			r.LineTo(cx, cy)
-			r.Close()
-			r.FillStroke()
-			total = total + v.Value
-		}
+	var total float64
+	for index, v := range values {
+		v.Style.InheritFrom(pc.styleDonutChartValue(index)).WriteToRenderer(r)
+		pc.drawAnnularSector(r, cx, cy, radius, innerRadius, PercentToRadians(total), PercentToRadians(v.Value))
+		total = total + v.Value
 	}
 
-	//making the donut hole
-	v := Value{Value: 100, Label: "center"}
-	styletemp := pc.SliceStyle.InheritFrom(Style{
-		StrokeColor: ColorWhite, StrokeWidth: 4.0, FillColor: ColorWhite, FontColor: ColorWhite, //Font:        pc.GetFont(),//FontSize:    pc.getScaledFontSize(),
-	})
-	v.Style.InheritFrom(styletemp).WriteToRenderer(r)
-	r.MoveTo(cx, cy)
-	r.ArcTo(cx, cy, (radius / 3.5), (radius / 3.5), DegreesToRadians(0), DegreesToRadians(359))
-	r.LineTo(cx, cy)
-	r.Close()
-	r.FillStroke()
-
 	// draw the labels
 	total = 0
 	for index, v := range values {
 		v.Style.InheritFrom(pc.styleDonutChartValue(index)).WriteToRenderer(r)
 		if len(v.Label) > 0 {
-			delta2 = PercentToRadians(total + (v.Value / 2.0))
-			delta2 = RadianAdd(delta2, _pi2)
-			lx, ly = CirclePoint(cx, cy, labelRadius, delta2)
+			theta := RadianAdd(PercentToRadians(total+(v.Value/2.0)), _pi2)
+			lx, ly := CirclePoint(cx, cy, labelRadius, theta)
 
 			tb := r.MeasureText(v.Label)
-			lx = lx - (tb.Width() >> 1)
-			ly = ly + (tb.Height() >> 1)
-
-			r.Text(v.Label, lx, ly)
+			r.Text(v.Label, lx-(tb.Width()>>1), ly+(tb.Height()>>1))
 		}
 		total = total + v.Value
 	}
+
+	pc.drawCenterLabel(r, cx, cy, innerRadius)
+}
+
+// drawAnnularSector draws the ring segment between the inner and outer radius.
+// A full turn is drawn as two opposing circles so the hole is left unfilled.
+func (pc DonutChart) drawAnnularSector(r Renderer, cx, cy int, outer, inner, start, delta float64) {
+	if delta >= _2pi {
+		r.MoveTo(cx+int(outer), cy)
+		r.ArcTo(cx, cy, outer, outer, 0, _2pi)
+		r.Close()
+		r.MoveTo(cx+int(inner), cy)
+		r.ArcTo(cx, cy, inner, inner, _2pi, -_2pi)
+		r.Close()
+		r.Fill()
+		return
+	}
+
+	r.ArcTo(cx, cy, outer, outer, start, delta)
+	r.ArcTo(cx, cy, inner, inner, start+delta, -delta)
+	r.Close()
+	r.FillStroke()
+}
+
+// drawCenterLabel draws the center label in the hole, stepping the font size down until it fits.
+func (pc DonutChart) drawCenterLabel(r Renderer, cx, cy int, innerRadius float64) {
+	if len(pc.CenterLabel) == 0 || pc.CenterLabelStyle.Hidden {
+		return
+	}
+
+	// the largest box inscribed in the hole.
+	available := int(innerRadius * math.Sqrt2)
+	style := pc.CenterLabelStyle.InheritFrom(pc.styleDefaultsCenterLabel())
+	label, style := pc.fitCenterLabel(r, pc.CenterLabel, available, style)
+	if len(label) == 0 {
+		return
+	}
+
+	style.WriteToRenderer(r)
+	tb := r.MeasureText(label)
+	r.Text(label, cx-(tb.Width()>>1), cy+(tb.Height()>>1))
+}
+
+// fitCenterLabel steps the font size down until the label fits within the given size,
+// truncating it if it still does not fit at the minimum font size.
+func (pc DonutChart) fitCenterLabel(r Renderer, label string, size int, style Style) (string, Style) {
+	for {
+		style.WriteTextOptionsToRenderer(r)
+		tb := r.MeasureText(label)
+		if tb.Width() <= size && tb.Height() <= size {
+			return label, style
+		}
+		if style.FontSize-DefaultDonutCenterLabelFontStep < DefaultDonutCenterLabelMinFontSize {
+			break
+		}
+		style.FontSize = style.FontSize - DefaultDonutCenterLabelFontStep
+	}
+	return Text.Truncate(r, label, size, style), style
 }
 
 func (pc DonutChart) finalizeValues(values []Value) ([]Value, error) {
@@ -196,6 +254,9 @@ func (pc DonutChart) getDefaultCanvasBox() Box {
 
 func (pc DonutChart) getCircleAdjustedCanvasBox(canvasBox Box) Box {
 	circleDiameter := MinInt(canvasBox.Width(), canvasBox.Height())
+	if circleDiameter == 0 {
+		return canvasBox
+	}
 
 	square := Box{
 		Right:  circleDiameter,
@@ -240,6 +301,14 @@ func (pc DonutChart) styleDonutChartValue(index int) Style {
 	})
 }
 
+func (pc DonutChart) styleDefaultsCenterLabel() Style {
+	return Style{
+		FontSize:  pc.getScaledFontSize() * 2.0,
+		FontColor: pc.GetColorPalette().TextColor(),
+		Font:      pc.GetFont(),
+	}
+}
+
 func (pc DonutChart) getScaledFontSize() float64 {
 	effectiveDimension := MinInt(pc.GetWidth(), pc.GetHeight())
 	if effectiveDimension >= 2048 {
@@ -293,23 +362,18 @@ func (pc DonutChart) getTitleFontSize() float64 {
 	return 10
 }
 
+// GetTheme returns the chart theme or the default theme; nil means the package defaults.
+func (pc DonutChart) GetTheme() *Theme {
+	return getTheme(pc.Theme)
+}
+
 // GetColorPalette returns the color palette for the chart.
 func (pc DonutChart) GetColorPalette() ColorPalette {
-	if pc.ColorPalette != nil {
-		return pc.ColorPalette
-	}
-	return AlternateColorPalette
+	return getColorPalette(pc.ColorPalette, pc.GetTheme(), AlternateColorPalette)
 }
 
 // Box returns the chart bounds as a box.
 func (pc DonutChart) Box() Box {
-	dpr := pc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
-	dpb := pc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
-
-	return Box{
-		Top:    pc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
-		Left:   pc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
-		Right:  pc.GetWidth() - dpr,
-		Bottom: pc.GetHeight() - dpb,
-	}
+	padding := pc.Background.InheritFrom(Style{Padding: pc.GetTheme().GetBackgroundPadding()}).Padding
+	return Box{Right: pc.GetWidth(), Bottom: pc.GetHeight()}.Inset(padding)
 }
@@ -2,6 +2,7 @@ package chart
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/blend/go-sdk/assert"
@@ -67,3 +68,66 @@ func TestDonutChartAllZeroValues(t *testing.T) {
 	err := pie.Render(PNG, b)
 	assert.NotNil(err)
 }
+
+func TestDonutChartGetInnerRadius(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(DefaultDonutInnerRadius, DonutChart{}.GetInnerRadius())
+	assert.Equal(DefaultDonutInnerRadius, DonutChart{InnerRadius: 1.5}.GetInnerRadius())
+	assert.Equal(0.25, DonutChart{InnerRadius: 0.25}.GetInnerRadius())
+}
+
+func TestDonutChartCenterLabel(t *testing.T) {
+	assert := assert.New(t)
+
+	pie := DonutChart{
+		CenterLabel: "Total 30",
+		Values: []Value{
+			{Value: 10, Label: "Blue"},
+			{Value: 20, Label: "Green"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	err := pie.Render(SVG, b)
+	assert.Nil(err)
+	assert.True(strings.Contains(b.String(), "Total 30"))
+}
+
+func TestDonutChartSingleValue(t *testing.T) {
+	assert := assert.New(t)
+
+	pie := DonutChart{
+		Values: []Value{
+			{Value: 10, Label: "Blue"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	err := pie.Render(SVG, b)
+	assert.Nil(err)
+	// the ring is drawn as an outer and an inner circle.
+	assert.Equal(2, strings.Count(b.String(), "Z"))
+}
+
+func TestDonutChartFitCenterLabel(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(512, 512)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	pc := DonutChart{Font: f}
+	style := Style{Font: f, FontSize: 48}
+
+	label, fitted := pc.fitCenterLabel(r, "42%", 100, style)
+	assert.Equal("42%", label)
+	assert.True(fitted.FontSize < 48)
+	fitted.WriteTextOptionsToRenderer(r)
+	assert.True(r.MeasureText(label).Width() <= 100)
+
+	label, fitted = pc.fitCenterLabel(r, "a label far too long to ever fit inside the hole", 40, style)
+	assert.Equal(DefaultDonutCenterLabelMinFontSize, fitted.FontSize)
+	assert.True(strings.HasSuffix(label, DefaultTextEllipsis) || len(label) == 0)
+}
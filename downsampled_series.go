@@ -0,0 +1,245 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                 = (*DownsampledSeries)(nil)
+	_ FullValuesProvider     = (*DownsampledSeries)(nil)
+	_ FirstValuesProvider    = (*DownsampledSeries)(nil)
+	_ BoundsProvider         = (*DownsampledSeries)(nil)
+	_ ValueFormatterProvider = (*DownsampledSeries)(nil)
+	_ drawnValuesProvider    = (*DownsampledSeries)(nil)
+)
+
+// DownsampledSeries draws a series with many more points than the canvas has pixels as a line through
+// a representative subset of them, picked with the Largest-Triangle-Three-Buckets algorithm.
+// The first and last points and spikes are kept; ranges and annotations still use all of the values.
+type DownsampledSeries struct {
+	// Inner is the series to downsample; it must provide values.
+	Inner Series
+	// Threshold is the number of points to draw the series with.
+	// It defaults to `DefaultDownsampleRatio` points per pixel of the canvas width.
+	Threshold int
+}
+
+// GetName returns the name of the inner series.
+func (ds DownsampledSeries) GetName() string {
+	return ds.Inner.GetName()
+}
+
+// GetStyle returns the style of the inner series.
+func (ds DownsampledSeries) GetStyle() Style {
+	return ds.Inner.GetStyle()
+}
+
+// GetYAxis returns which YAxis the inner series draws on.
+func (ds DownsampledSeries) GetYAxis() YAxisType {
+	return ds.Inner.GetYAxis()
+}
+
+// Len returns the number of elements in the inner series.
+func (ds DownsampledSeries) Len() int {
+	if typed, isTyped := ds.Inner.(ValuesProvider); isTyped {
+		return typed.Len()
+	}
+	return 0
+}
+
+// GetValues gets the x,y values of the inner series at a given index.
+func (ds DownsampledSeries) GetValues(index int) (x, y float64) {
+	if typed, isTyped := ds.Inner.(ValuesProvider); isTyped {
+		return typed.GetValues(index)
+	}
+	return
+}
+
+// GetFirstValues gets the first x,y values of the inner series.
+func (ds DownsampledSeries) GetFirstValues() (x, y float64) {
+	if typed, isTyped := ds.Inner.(FirstValuesProvider); isTyped {
+		return typed.GetFirstValues()
+	}
+	return ds.GetValues(0)
+}
+
+// GetLastValues gets the last x,y values of the inner series.
+func (ds DownsampledSeries) GetLastValues() (x, y float64) {
+	if typed, isTyped := ds.Inner.(LastValuesProvider); isTyped {
+		return typed.GetLastValues()
+	}
+	return ds.GetValues(ds.Len() - 1)
+}
+
+// GetBounds returns the bounds of all of the finite values of the inner series.
+func (ds DownsampledSeries) GetBounds() (minx, maxx, miny, maxy float64) {
+	if typed, isTyped := ds.Inner.(BoundsProvider); isTyped {
+		return typed.GetBounds()
+	}
+	xvalues, yvalues := ds.values()
+	return bounds(xvalues, yvalues)
+}
+
+// GetValueFormatters returns the value formatters of the inner series.
+func (ds DownsampledSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if typed, isTyped := ds.Inner.(ValueFormatterProvider); isTyped {
+		return typed.GetValueFormatters()
+	}
+	return FloatValueFormatter, defaultValueFormatter
+}
+
+// GetThreshold returns the number of points to draw on a canvas of a given width.
+func (ds DownsampledSeries) GetThreshold(canvasWidth int) int {
+	if ds.Threshold > 0 {
+		return ds.Threshold
+	}
+	return MaxInt(canvasWidth*DefaultDownsampleRatio, 3)
+}
+
+// Render renders the series, downsampled if it has more values than the threshold.
+func (ds DownsampledSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	threshold := ds.GetThreshold(canvasBox.Width())
+	if ds.Len() <= threshold {
+		ds.Inner.Render(r, canvasBox, xrange, yrange, defaults)
+		return
+	}
+
+	style := ds.Inner.GetStyle().InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ds.getDrawnValues(canvasBox))
+}
+
+// getDrawnValues returns the values the series is drawn with on a canvas.
+func (ds DownsampledSeries) getDrawnValues(canvasBox Box) ValuesProvider {
+	threshold := ds.GetThreshold(canvasBox.Width())
+	if ds.Len() <= threshold {
+		if typed, isTyped := ds.Inner.(drawnValuesProvider); isTyped {
+			return typed.getDrawnValues(canvasBox)
+		}
+		return ds
+	}
+	xvalues, yvalues := ds.Downsample(threshold)
+	return ContinuousSeries{XValues: xvalues, YValues: yvalues}
+}
+
+// Downsample returns at most about threshold of the values of the inner series.
+// Runs of finite values are downsampled separately, in proportion to their length,
+// and stay separated by a NaN so gaps in the line are kept.
+func (ds DownsampledSeries) Downsample(threshold int) (xvalues, yvalues []float64) {
+	all, allY := ds.values()
+
+	var finite int
+	for index := range all {
+		if isFinite(all[index]) && isFinite(allY[index]) {
+			finite++
+		}
+	}
+	if finite <= threshold {
+		return all, allY
+	}
+
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		if len(xvalues) > 0 {
+			xvalues = append(xvalues, math.NaN())
+			yvalues = append(yvalues, math.NaN())
+		}
+		runThreshold := int(math.Ceil(float64(threshold) * float64(end-start) / float64(finite)))
+		rx, ry := lttb(all[start:end], allY[start:end], runThreshold)
+		xvalues = append(xvalues, rx...)
+		yvalues = append(yvalues, ry...)
+		start = -1
+	}
+	for index := range all {
+		if isFinite(all[index]) && isFinite(allY[index]) {
+			if start < 0 {
+				start = index
+			}
+			continue
+		}
+		flush(index)
+	}
+	flush(len(all))
+	return
+}
+
+// Validate validates the series.
+func (ds DownsampledSeries) Validate() error {
+	if ds.Inner == nil {
+		return fmt.Errorf("downsampled series; must have an inner series")
+	}
+	if _, isValuesProvider := ds.Inner.(ValuesProvider); !isValuesProvider {
+		return fmt.Errorf("downsampled series; inner series must provide values")
+	}
+	return ds.Inner.Validate()
+}
+
+// values returns the values of the inner series.
+func (ds DownsampledSeries) values() (xvalues, yvalues []float64) {
+	count := ds.Len()
+	xvalues = make([]float64, count)
+	yvalues = make([]float64, count)
+	for index := 0; index < count; index++ {
+		xvalues[index], yvalues[index] = ds.GetValues(index)
+	}
+	return
+}
+
+// lttb downsamples values to threshold points with the Largest-Triangle-Three-Buckets algorithm.
+// The first and last points are kept; every bucket in between contributes the point that forms the
+// largest triangle with the previously kept point and the average of the next bucket.
+func lttb(xvalues, yvalues []float64, threshold int) (sampledX, sampledY []float64) {
+	count := len(xvalues)
+	if threshold >= count {
+		return xvalues, yvalues
+	}
+	if threshold < 3 {
+		return []float64{xvalues[0], xvalues[count-1]}, []float64{yvalues[0], yvalues[count-1]}
+	}
+
+	sampledX = make([]float64, 0, threshold)
+	sampledY = make([]float64, 0, threshold)
+	sampledX = append(sampledX, xvalues[0])
+	sampledY = append(sampledY, yvalues[0])
+
+	// the points between the first and last are split into threshold-2 buckets.
+	bucketSize := float64(count-2) / float64(threshold-2)
+	previous := 0
+	for bucket := 0; bucket < threshold-2; bucket++ {
+		start := int(float64(bucket)*bucketSize) + 1
+		end := int(float64(bucket+1)*bucketSize) + 1
+
+		nextStart, nextEnd := end, MinInt(int(float64(bucket+2)*bucketSize)+1, count)
+		if bucket == threshold-3 {
+			nextStart, nextEnd = count-1, count
+		}
+		var avgX, avgY float64
+		for index := nextStart; index < nextEnd; index++ {
+			avgX += xvalues[index]
+			avgY += yvalues[index]
+		}
+		avgX /= float64(nextEnd - nextStart)
+		avgY /= float64(nextEnd - nextStart)
+
+		px, py := xvalues[previous], yvalues[previous]
+		selected, maxArea := start, -1.0
+		for index := start; index < end; index++ {
+			area := math.Abs((px-avgX)*(yvalues[index]-py) - (px-xvalues[index])*(avgY-py))
+			if area > maxArea {
+				selected, maxArea = index, area
+			}
+		}
+
+		sampledX = append(sampledX, xvalues[selected])
+		sampledY = append(sampledY, yvalues[selected])
+		previous = selected
+	}
+
+	sampledX = append(sampledX, xvalues[count-1])
+	sampledY = append(sampledY, yvalues[count-1])
+	return
+}
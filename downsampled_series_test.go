@@ -0,0 +1,187 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestLTTBKeepsBucketExtremes(t *testing.T) {
+	assert := assert.New(t)
+
+	const count, threshold = 1002, 52
+	bucketSize := float64(count-2) / float64(threshold-2)
+
+	xvalues := LinearRange(0, count-1)
+	yvalues := make([]float64, count)
+	for index := range yvalues {
+		yvalues[index] = 0.01 * math.Sin(float64(index))
+	}
+	// every bucket gets a spike, alternating up and down, which is its max or min.
+	var spikes []float64
+	for bucket := 0; bucket < threshold-2; bucket++ {
+		index := int((float64(bucket)+0.5)*bucketSize) + 1
+		spike := float64(10 + bucket)
+		if bucket%2 == 1 {
+			spike = -spike
+		}
+		yvalues[index] = spike
+		spikes = append(spikes, spike)
+	}
+
+	sampledX, sampledY := lttb(xvalues, yvalues, threshold)
+	assert.Len(sampledX, threshold)
+	assert.Len(sampledY, threshold)
+	assert.Equal(xvalues[0], sampledX[0])
+	assert.Equal(yvalues[0], sampledY[0])
+	assert.Equal(xvalues[count-1], sampledX[threshold-1])
+	assert.Equal(yvalues[count-1], sampledY[threshold-1])
+	assert.Equal(spikes, sampledY[1:threshold-1])
+
+	for index := 1; index < len(sampledX); index++ {
+		assert.True(sampledX[index] > sampledX[index-1])
+	}
+}
+
+func TestLTTBBelowThreshold(t *testing.T) {
+	assert := assert.New(t)
+
+	xvalues, yvalues := []float64{0, 1, 2}, []float64{3, 4, 5}
+	sampledX, sampledY := lttb(xvalues, yvalues, 10)
+	assert.Equal(xvalues, sampledX)
+	assert.Equal(yvalues, sampledY)
+
+	sampledX, sampledY = lttb(xvalues, yvalues, 2)
+	assert.Equal([]float64{0, 2}, sampledX)
+	assert.Equal([]float64{3, 5}, sampledY)
+}
+
+func TestDownsampledSeriesDownsampleKeepsGaps(t *testing.T) {
+	assert := assert.New(t)
+
+	xvalues := LinearRange(0, 199)
+	yvalues := make([]float64, len(xvalues))
+	for index := range yvalues {
+		yvalues[index] = math.Sin(float64(index) / 10)
+	}
+	yvalues[100] = math.NaN()
+
+	ds := DownsampledSeries{Inner: ContinuousSeries{XValues: xvalues, YValues: yvalues}}
+	sampledX, sampledY := ds.Downsample(20)
+	assert.True(len(sampledX) <= 23)
+
+	gap := -1
+	for index := range sampledY {
+		if math.IsNaN(sampledY[index]) {
+			gap = index
+		}
+	}
+	assert.True(gap > 0)
+	// both runs keep their first and last points.
+	assert.Equal(0.0, sampledX[0])
+	assert.Equal(99.0, sampledX[gap-1])
+	assert.Equal(101.0, sampledX[gap+1])
+	assert.Equal(199.0, sampledX[len(sampledX)-1])
+}
+
+func TestDownsampledSeriesPassesThroughValues(t *testing.T) {
+	assert := assert.New(t)
+
+	inner := ContinuousSeries{
+		Name:    "inner",
+		YAxis:   YAxisSecondary,
+		XValues: LinearRange(0, 999),
+		YValues: LinearRange(1000, 1999),
+	}
+	ds := DownsampledSeries{Inner: inner, Threshold: 10}
+
+	assert.Equal("inner", ds.GetName())
+	assert.Equal(YAxisSecondary, ds.GetYAxis())
+	assert.Equal(1000, ds.Len())
+	lx, ly := ds.GetLastValues()
+	assert.Equal(999.0, lx)
+	assert.Equal(1999.0, ly)
+	minx, maxx, miny, maxy := ds.GetBounds()
+	assert.Equal([]float64{0, 999, 1000, 1999}, []float64{minx, maxx, miny, maxy})
+
+	assert.Nil(ds.Validate())
+	assert.NotNil(DownsampledSeries{}.Validate())
+	assert.NotNil(DownsampledSeries{Inner: AnnotationSeries{}}.Validate())
+}
+
+func TestDownsampledSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(s Series) string {
+		c := Chart{
+			Width:          200,
+			Height:         100,
+			TitleStyle:     Hidden(),
+			XAxis:          HideXAxis(),
+			YAxis:          HideYAxis(),
+			YAxisSecondary: HideYAxis(),
+			Series:         []Series{s},
+		}
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(SVG, buffer))
+		return buffer.String()
+	}
+
+	inner := ContinuousSeries{
+		XValues: LinearRange(0, 9999),
+		YValues: make([]float64, 10000),
+	}
+	for index := range inner.YValues {
+		inner.YValues[index] = math.Sin(float64(index) / 100)
+	}
+
+	full := render(inner)
+	downsampled := render(DownsampledSeries{Inner: inner, Threshold: 100})
+	// the background and canvas boxes add a few segments of their own.
+	assert.True(strings.Count(downsampled, "L ") < 120)
+	assert.True(3*strings.Count(downsampled, "L ") < strings.Count(full, "L "))
+
+	// series under the threshold render as they are.
+	small := ContinuousSeries{XValues: []float64{0, 1, 2}, YValues: []float64{1, 3, 2}}
+	assert.Equal(render(small), render(DownsampledSeries{Inner: small}))
+}
+
+func benchmarkSeries(count int) ContinuousSeries {
+	cs := ContinuousSeries{
+		XValues: LinearRange(0, float64(count-1)),
+		YValues: make([]float64, count),
+	}
+	for index := range cs.YValues {
+		cs.YValues[index] = math.Sin(float64(index)/1000) + 0.1*math.Sin(float64(index))
+	}
+	return cs
+}
+
+func BenchmarkLTTB(b *testing.B) {
+	cs := benchmarkSeries(1000000)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		lttb(cs.XValues, cs.YValues, 1600)
+	}
+}
+
+func benchmarkChartRender(b *testing.B, s Series) {
+	c := Chart{Width: 800, Height: 400, Series: []Series{s}}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		c.Render(PNG, bytes.NewBuffer(nil))
+	}
+}
+
+func BenchmarkChartRenderFull(b *testing.B) {
+	benchmarkChartRender(b, benchmarkSeries(1000000))
+}
+
+func BenchmarkChartRenderDownsampled(b *testing.B) {
+	benchmarkChartRender(b, DownsampledSeries{Inner: benchmarkSeries(1000000)})
+}
@@ -1,7 +1,9 @@
 package chart
 
 import (
+	"fmt"
 	"math"
+	"strings"
 )
 
 var (
@@ -12,6 +14,7 @@ var (
 type draw struct{}
 
 // LineSeries draws a line series with a renderer.
+// Non-finite values (NaN or infinite) are treated as missing and leave a gap in the line.
 func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValuesProvider) {
 	if vs.Len() == 0 {
 		return
@@ -20,51 +23,69 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 	cb := canvasBox.Bottom
 	cl := canvasBox.Left
 
-	v0x, v0y := vs.GetValues(0)
-	x0 := cl + xrange.Translate(v0x)
-	y0 := cb - yrange.Translate(v0y)
-
-	yv0 := yrange.Translate(0)
-
-	var vx, vy float64
-	var x, y int
+	// the fill closes down to the zero baseline, clamped to the canvas
+	// so all positive or all negative data fills to the nearest edge.
+	yv0 := math.Max(float64(canvasBox.Top), math.Min(float64(cb), float64(cb)-translateFloat(yrange, 0)))
+
+	// the line goes through fractional positions, so it isn't snapped to whole pixels.
+	var runs [][]floatPoint
+	if style.ShouldDrawFill() || style.ShouldDrawStroke() {
+		// series with many more points than pixel columns are decimated to the points that
+		// shape each column; smoothing needs every point so it isn't.
+		decimate := !style.Smooth && vs.Len() > canvasBox.Width()*DefaultDecimationRatio
+		runs = d.lineSeriesRuns(canvasBox, xrange, yrange, vs, decimate)
+		if style.Smooth {
+			for index := range runs {
+				runs[index] = interpolateMonotoneCubic(runs[index])
+			}
+		}
+	}
 
-	if style.ShouldDrawStroke() && style.ShouldDrawFill() {
+	// lines and fills are clipped to the canvas, e.g. for a range narrower than the values.
+	if style.ShouldDrawFill() {
 		style.GetFillOptions().WriteDrawingOptionsToRenderer(r)
-		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
-			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
+		for _, run := range runs {
+			polygon := append(append([]floatPoint{}, run...), floatPoint{run[len(run)-1].X, yv0}, floatPoint{run[0].X, yv0})
+			polygon = clipPolygon(canvasBox, polygon)
+			if len(polygon) < 3 {
+				continue
+			}
+			d.path(r, polygon)
+			d.lineTo(r, polygon[0])
+			r.Fill()
 		}
-		r.LineTo(x, MinInt(cb, cb-yv0))
-		r.LineTo(x0, MinInt(cb, cb-yv0))
-		r.LineTo(x0, y0)
-		r.Fill()
 	}
 
 	if style.ShouldDrawStroke() {
 		style.GetStrokeOptions().WriteDrawingOptionsToRenderer(r)
-
-		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
-			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
+		for _, run := range runs {
+			for _, clipped := range clipPolyline(canvasBox, run) {
+				d.path(r, clipped)
+			}
 		}
 		r.Stroke()
 	}
 
 	if style.ShouldDrawDot() {
 		defaultDotWidth := style.GetDotWidth()
+		tooltips, hasTooltips := r.(TooltipRenderer)
+		hasTooltips = hasTooltips && style.ShowTooltips
+		xf, yf := FloatValueFormatter, FloatValueFormatter
+		if typed, isTyped := vs.(ValueFormatterProvider); isTyped {
+			xf, yf = typed.GetValueFormatters()
+		}
 
 		style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
+		var vx, vy float64
 		for i := 0; i < vs.Len(); i++ {
 			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
+			if !isFinite(vx) || !isFinite(vy) {
+				continue
+			}
+			p := floatPoint{X: float64(cl) + translateFloat(xrange, vx), Y: float64(cb) - translateFloat(yrange, vy)}
+			if outcode(canvasBox, p.X, p.Y) != 0 {
+				continue
+			}
 
 			dotWidth := defaultDotWidth
 			if style.DotWidthProvider != nil {
@@ -78,10 +99,138 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 				r.SetStrokeColor(dotColor)
 			}
 
-			r.Circle(dotWidth, x, y)
+			if hasTooltips {
+				tooltips.SetTooltip(fmt.Sprintf("x: %s, y: %s", xf(vx), yf(vy)))
+			}
+			d.circle(r, dotWidth, p)
 			r.FillStroke()
 		}
+		if hasTooltips {
+			tooltips.SetTooltip("")
+		}
+	}
+}
+
+// path moves to the first of the points and draws lines through the rest of them.
+func (d draw) path(r Renderer, points []floatPoint) {
+	if typed, isTyped := r.(FloatPathRenderer); isTyped {
+		typed.MoveToFloat(points[0].X, points[0].Y)
+	} else {
+		r.MoveTo(int(math.Round(points[0].X)), int(math.Round(points[0].Y)))
+	}
+	for _, p := range points[1:] {
+		d.lineTo(r, p)
+	}
+}
+
+// lineTo draws a line to a point, rounded to the nearest pixel if the renderer can't draw between pixels.
+func (d draw) lineTo(r Renderer, p floatPoint) {
+	if typed, isTyped := r.(FloatPathRenderer); isTyped {
+		typed.LineToFloat(p.X, p.Y)
+		return
+	}
+	r.LineTo(int(math.Round(p.X)), int(math.Round(p.Y)))
+}
+
+// segment draws the part of a straight line within the box, if any of it is.
+func (d draw) segment(r Renderer, box Box, x0, y0, x1, y1 int) {
+	if from, to, ok := clipSegment(box, floatPoint{float64(x0), float64(y0)}, floatPoint{float64(x1), float64(y1)}); ok {
+		d.path(r, []floatPoint{from, to})
+	}
+}
+
+// circle draws a circle centered on a point, rounded to the nearest pixel if the renderer can't draw between pixels.
+func (d draw) circle(r Renderer, radius float64, p floatPoint) {
+	if typed, isTyped := r.(FloatCircleRenderer); isTyped {
+		typed.CircleFloat(radius, p.X, p.Y)
+		return
+	}
+	r.Circle(radius, int(math.Round(p.X)), int(math.Round(p.Y)))
+}
+
+// lineSeriesRuns translates the values of a series into canvas points,
+// split into runs of consecutive finite values.
+// If decimate is set, consecutive points in the same pixel column are reduced to the first, lowest,
+// highest and last of them, which draws the same line; this needs the x values to be sorted, so
+// unsorted values fall back to every point.
+func (d draw) lineSeriesRuns(canvasBox Box, xrange, yrange Range, vs ValuesProvider, decimate bool) [][]floatPoint {
+	var runs [][]floatPoint
+	var run []floatPoint
+	var column pixelColumn
+	for i := 0; i < vs.Len(); i++ {
+		vx, vy := vs.GetValues(i)
+		if !isFinite(vx) || !isFinite(vy) {
+			if decimate && column.count > 0 {
+				run = column.appendTo(run)
+				column = pixelColumn{}
+			}
+			if len(run) > 0 {
+				runs = append(runs, run)
+				run = nil
+			}
+			continue
+		}
+		p := floatPoint{
+			X: float64(canvasBox.Left) + translateFloat(xrange, vx),
+			Y: float64(canvasBox.Bottom) - translateFloat(yrange, vy),
+		}
+		if !decimate {
+			run = append(run, p)
+			continue
+		}
+		if x := int(math.Floor(p.X)); column.count > 0 && x != column.x {
+			if (x < column.x) != xrange.IsDescending() {
+				return d.lineSeriesRuns(canvasBox, xrange, yrange, vs, false)
+			}
+			run = column.appendTo(run)
+			column = pixelColumn{}
+		}
+		column.add(p)
 	}
+	if decimate && column.count > 0 {
+		run = column.appendTo(run)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// pixelColumn collects the consecutive points of a line series that land in the same pixel column.
+type pixelColumn struct {
+	x, count              int
+	first, last, min, max floatPoint
+	minIndex, maxIndex    int
+}
+
+func (pc *pixelColumn) add(p floatPoint) {
+	if pc.count == 0 {
+		pc.x, pc.first, pc.min, pc.max = int(math.Floor(p.X)), p, p, p
+	}
+	// screen y grows downwards, so the lowest value has the largest y.
+	if p.Y > pc.min.Y {
+		pc.min, pc.minIndex = p, pc.count
+	}
+	if p.Y < pc.max.Y {
+		pc.max, pc.maxIndex = p, pc.count
+	}
+	pc.last = p
+	pc.count++
+}
+
+// appendTo appends the first, lowest and highest in the order they occurred, and last points of the column.
+func (pc pixelColumn) appendTo(run []floatPoint) []floatPoint {
+	points := []floatPoint{pc.first, pc.min, pc.max, pc.last}
+	if pc.maxIndex < pc.minIndex {
+		points[1], points[2] = pc.max, pc.min
+	}
+	for index, p := range points {
+		if index > 0 && p == points[index-1] {
+			continue
+		}
+		run = append(run, p)
+	}
+	return run
 }
 
 // BoundedSeries draws a series that implements BoundedValuesProvider.
@@ -91,47 +240,35 @@ func (d draw) BoundedSeries(r Renderer, canvasBox Box, xrange, yrange Range, sty
 		drawOffsetIndex = drawOffsetIndexes[0]
 	}
 
+	if bbs.Len() <= drawOffsetIndex {
+		return
+	}
+
 	cb := canvasBox.Bottom
 	cl := canvasBox.Left
 
-	v0x, v0y1, v0y2 := bbs.GetBoundedValues(0)
-	x0 := cl + xrange.Translate(v0x)
-	y0 := cb - yrange.Translate(v0y1)
-
-	var vx, vy1, vy2 float64
-	var x, y int
-
-	xvalues := make([]float64, bbs.Len())
-	xvalues[0] = v0x
-	y2values := make([]float64, bbs.Len())
-	y2values[0] = v0y2
-
-	style.GetFillAndStrokeOptions().WriteToRenderer(r)
-	r.MoveTo(x0, y0)
-	for i := 1; i < bbs.Len(); i++ {
-		vx, vy1, vy2 = bbs.GetBoundedValues(i)
-
-		xvalues[i] = vx
-		y2values[i] = vy2
-
-		x = cl + xrange.Translate(vx)
-		y = cb - yrange.Translate(vy1)
-		if i > drawOffsetIndex {
-			r.LineTo(x, y)
-		} else {
-			r.MoveTo(x, y)
-		}
+	// the band is the upper values left to right and then the lower values back again,
+	// clipped to the canvas so values outside the y range don't fill the axes.
+	polygon := make([]floatPoint, 2*(bbs.Len()-drawOffsetIndex))
+	for i, j := drawOffsetIndex, len(polygon)-1; i < bbs.Len(); i, j = i+1, j-1 {
+		vx, vy1, vy2 := bbs.GetBoundedValues(i)
+		x := float64(cl + xrange.Translate(vx))
+		polygon[i-drawOffsetIndex] = floatPoint{x, float64(cb - yrange.Translate(vy1))}
+		polygon[j] = floatPoint{x, float64(cb - yrange.Translate(vy2))}
 	}
-	y = cb - yrange.Translate(vy2)
-	r.LineTo(x, y)
-	for i := bbs.Len() - 1; i >= drawOffsetIndex; i-- {
-		vx, vy2 = xvalues[i], y2values[i]
-		x = cl + xrange.Translate(vx)
-		y = cb - yrange.Translate(vy2)
-		r.LineTo(x, y)
+	polygon = clipPolygon(canvasBox, polygon)
+	if len(polygon) < 3 {
+		return
 	}
+
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	d.path(r, polygon)
 	r.Close()
-	r.FillStroke()
+	if style.ShouldDrawStroke() {
+		r.FillStroke()
+	} else {
+		r.Fill()
+	}
 }
 
 // HistogramSeries draws a value provider as boxes from 0.
@@ -157,15 +294,172 @@ func (d draw) HistogramSeries(r Renderer, canvasBox Box, xrange, yrange Range, s
 		x := cl + xrange.Translate(vx)
 		y := yrange.Translate(vy)
 
-		d.Box(r, Box{
+		if bar, ok := clipBox(canvasBox, Box{
 			Top:    cb - y0,
 			Left:   x - (barWidth >> 1),
 			Right:  x + (barWidth >> 1),
 			Bottom: cb - y,
-		}, style)
+		}); ok {
+			d.Box(r, bar, style)
+		}
 	}
 }
 
+// OHLCSeries draws a candle per value: a wick from the high to the low, and a body from the open to the close.
+// Candles that close at or above their open use the up style, the rest use the down style.
+func (d draw) OHLCSeries(r Renderer, canvasBox Box, xrange, yrange Range, upStyle, downStyle Style, vs OHLCValuesProvider, bodyWidth int) {
+	if vs.Len() == 0 {
+		return
+	}
+
+	cb := canvasBox.Bottom
+	cl := canvasBox.Left
+
+	for index := 0; index < vs.Len(); index++ {
+		vx, open, high, low, close := vs.GetOHLCValues(index)
+		if !isFinite(vx) || !isFinite(open) || !isFinite(high) || !isFinite(low) || !isFinite(close) {
+			continue
+		}
+
+		style := upStyle
+		if close < open {
+			style = downStyle
+		}
+
+		x := cl + xrange.Translate(vx)
+
+		style.GetStrokeOptions().WriteToRenderer(r)
+		d.segment(r, canvasBox, x, cb-yrange.Translate(high), x, cb-yrange.Translate(low))
+		r.Stroke()
+		r.ResetStyle()
+
+		top := cb - yrange.Translate(math.Max(open, close))
+		bottom := cb - yrange.Translate(math.Min(open, close))
+		// candles at the edges of the ranges are clipped to the canvas.
+		if body, ok := clipBox(canvasBox, Box{
+			Top:    top,
+			Left:   x - (bodyWidth >> 1),
+			Right:  x + (bodyWidth >> 1),
+			Bottom: MaxInt(bottom, top+1),
+		}); ok {
+			d.Box(r, body, style)
+		}
+	}
+}
+
+// BoxPlotSeries draws a box per value from the lower to the upper quartile with a line at the median,
+// and whiskers out to the min and max.
+func (d draw) BoxPlotSeries(r Renderer, canvasBox Box, xrange, yrange Range, style, medianStyle Style, vs BoxPlotValuesProvider, boxWidth int) {
+	if vs.Len() == 0 {
+		return
+	}
+
+	cb := canvasBox.Bottom
+	cl := canvasBox.Left
+
+	for index := 0; index < vs.Len(); index++ {
+		vx, min, q1, median, q3, max := vs.GetBoxPlotValues(index)
+		if !isFinite(vx) || !isFinite(min) || !isFinite(q1) || !isFinite(median) || !isFinite(q3) || !isFinite(max) {
+			continue
+		}
+
+		x := cl + xrange.Translate(vx)
+		left := x - (boxWidth >> 1)
+		right := x + (boxWidth >> 1)
+		capLeft := x - (boxWidth >> 2)
+		capRight := x + (boxWidth >> 2)
+
+		ymin := cb - yrange.Translate(min)
+		yq1 := cb - yrange.Translate(q1)
+		yq3 := cb - yrange.Translate(q3)
+		ymax := cb - yrange.Translate(max)
+
+		// box plots at the edges of the ranges are clipped to the canvas.
+		style.GetStrokeOptions().WriteToRenderer(r)
+		d.segment(r, canvasBox, x, ymin, x, yq1)
+		d.segment(r, canvasBox, x, yq3, x, ymax)
+		d.segment(r, canvasBox, capLeft, ymin, capRight, ymin)
+		d.segment(r, canvasBox, capLeft, ymax, capRight, ymax)
+		r.Stroke()
+		r.ResetStyle()
+
+		if box, ok := clipBox(canvasBox, Box{
+			Top:    yq3,
+			Left:   left,
+			Right:  right,
+			Bottom: yq1,
+		}); ok {
+			d.Box(r, box, style)
+		}
+
+		ymedian := cb - yrange.Translate(median)
+		medianStyle.GetStrokeOptions().WriteToRenderer(r)
+		d.segment(r, canvasBox, left, ymedian, right, ymedian)
+		r.Stroke()
+		r.ResetStyle()
+	}
+}
+
+// ValueLabels draws the formatted y value of each point just above it.
+// A label that would overlap the previously drawn label is skipped, and one that would cross the top
+// of the canvas is drawn below its point instead.
+func (d draw) ValueLabels(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValuesProvider) {
+	vf := style.GetValueFormatter(FloatValueFormatter)
+	style = style.GetTextOptions()
+	style.TextHorizontalAlign = TextHorizontalAlignCenter
+	style.TextVerticalAlign = TextVerticalAlignBottom
+
+	var previous Box
+	var hasPrevious bool
+	for index := 0; index < vs.Len(); index++ {
+		vx, vy := vs.GetValues(index)
+		if !isFinite(vx) || !isFinite(vy) {
+			continue
+		}
+		label := vf(vy)
+		if label == "" {
+			continue
+		}
+
+		textBox := d.MeasureText(r, label, style)
+		x := canvasBox.Left + xrange.Translate(vx)
+		y := canvasBox.Bottom - yrange.Translate(vy)
+
+		left := MinInt(MaxInt(x-(textBox.Width()>>1), canvasBox.Left), canvasBox.Right-textBox.Width())
+		baseline := y - DefaultValueLabelPadding
+		if baseline-textBox.Height() < canvasBox.Top {
+			baseline = y + DefaultValueLabelPadding + textBox.Height()
+		}
+
+		labelBox := Box{
+			Top:    baseline - textBox.Height(),
+			Left:   left,
+			Right:  left + textBox.Width(),
+			Bottom: baseline,
+		}
+		if hasPrevious && previous.Intersects(labelBox) {
+			continue
+		}
+		d.TextAligned(r, label, labelBox.Left+textBox.Width()>>1, labelBox.Bottom, style)
+		previous, hasPrevious = labelBox, true
+	}
+}
+
+// bodyWidth returns the pixel width of a candle or box body, based on the smallest spacing between the x values.
+func (d draw) bodyWidth(xrange Range, vs ValuesProvider) int {
+	spacing := DefaultBarWidth
+	var previous int
+	for index := 0; index < vs.Len(); index++ {
+		vx, _ := vs.GetValues(index)
+		x := xrange.Translate(vx)
+		if index > 0 && x != previous {
+			spacing = MinInt(spacing, AbsInt(x-previous))
+		}
+		previous = x
+	}
+	return MaxInt(int(float64(spacing)*DefaultBodyWidthRatio), 1)
+}
+
 // MeasureAnnotation measures how big an annotation would be.
 func (d draw) MeasureAnnotation(r Renderer, canvasBox Box, style Style, lx, ly int, label string) Box {
 	style.WriteToRenderer(r)
@@ -176,16 +470,13 @@ func (d draw) MeasureAnnotation(r Renderer, canvasBox Box, style Style, lx, ly i
 	textHeight := textBox.Height()
 	halfTextHeight := textHeight >> 1
 
-	pt := style.Padding.GetTop(DefaultAnnotationPadding.Top)
-	pl := style.Padding.GetLeft(DefaultAnnotationPadding.Left)
-	pr := style.Padding.GetRight(DefaultAnnotationPadding.Right)
-	pb := style.Padding.GetBottom(DefaultAnnotationPadding.Bottom)
+	padding := annotationPadding(style)
 
 	strokeWidth := style.GetStrokeWidth()
 
-	top := ly - (pt + halfTextHeight)
-	right := lx + pl + pr + textWidth + DefaultAnnotationDeltaWidth + int(strokeWidth)
-	bottom := ly + (pb + halfTextHeight)
+	top := ly - (padding.Top + halfTextHeight)
+	right := lx + padding.Left + padding.Right + textWidth + DefaultAnnotationDeltaWidth + int(strokeWidth)
+	bottom := ly + (padding.Bottom + halfTextHeight)
 
 	return Box{
 		Top:    top,
@@ -197,6 +488,11 @@ func (d draw) MeasureAnnotation(r Renderer, canvasBox Box, style Style, lx, ly i
 
 // Annotation draws an anotation with a renderer.
 func (d draw) Annotation(r Renderer, canvasBox Box, style Style, lx, ly int, label string) {
+	d.Callout(r, style, lx, ly, d.annotationLabelBox(r, style, lx, ly, label), label)
+}
+
+// annotationLabelBox returns the box of an annotation label placed to the right of the point it points to.
+func (d draw) annotationLabelBox(r Renderer, style Style, lx, ly int, label string) Box {
 	style.GetTextOptions().WriteToRenderer(r)
 	defer r.ResetStyle()
 
@@ -204,39 +500,48 @@ func (d draw) Annotation(r Renderer, canvasBox Box, style Style, lx, ly int, lab
 	textWidth := textBox.Width()
 	halfTextHeight := textBox.Height() >> 1
 
-	style.GetFillAndStrokeOptions().WriteToRenderer(r)
-
-	pt := style.Padding.GetTop(DefaultAnnotationPadding.Top)
-	pl := style.Padding.GetLeft(DefaultAnnotationPadding.Left)
-	pr := style.Padding.GetRight(DefaultAnnotationPadding.Right)
-	pb := style.Padding.GetBottom(DefaultAnnotationPadding.Bottom)
+	padding := annotationPadding(style)
 
-	textX := lx + pl + DefaultAnnotationDeltaWidth
-	textY := ly + halfTextHeight
+	return Box{
+		Top:    ly - (padding.Top + halfTextHeight),
+		Left:   lx + DefaultAnnotationDeltaWidth,
+		Right:  lx + padding.Left + padding.Right + textWidth + DefaultAnnotationDeltaWidth,
+		Bottom: ly + (padding.Bottom + halfTextHeight),
+	}
+}
 
-	ltx := lx + DefaultAnnotationDeltaWidth
-	lty := ly - (pt + halfTextHeight)
+// Callout draws a label in a box with a pointer to a given point.
+// The pointer comes out of whichever side of the box faces the point.
+func (d draw) Callout(r Renderer, style Style, lx, ly int, box Box, label string) {
+	style.GetTextOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
 
-	rtx := lx + pl + pr + textWidth + DefaultAnnotationDeltaWidth
-	rty := ly - (pt + halfTextHeight)
+	halfTextHeight := r.MeasureText(label).Height() >> 1
 
-	rbx := lx + pl + pr + textWidth + DefaultAnnotationDeltaWidth
-	rby := ly + (pb + halfTextHeight)
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
 
-	lbx := lx + DefaultAnnotationDeltaWidth
-	lby := ly + (pb + halfTextHeight)
+	near, far := box.Left, box.Right
+	if lx > box.Right {
+		near, far = box.Right, box.Left
+	}
 
 	r.MoveTo(lx, ly)
-	r.LineTo(ltx, lty)
-	r.LineTo(rtx, rty)
-	r.LineTo(rbx, rby)
-	r.LineTo(lbx, lby)
+	r.LineTo(near, box.Top)
+	r.LineTo(far, box.Top)
+	r.LineTo(far, box.Bottom)
+	r.LineTo(near, box.Bottom)
 	r.LineTo(lx, ly)
 	r.Close()
 	r.FillStroke()
 
+	content := box.Inset(annotationPadding(style))
 	style.GetTextOptions().WriteToRenderer(r)
-	r.Text(label, textX, textY)
+	r.Text(label, content.Left, content.Top+(halfTextHeight<<1))
+}
+
+// annotationPadding returns the padding between an annotation box and its label.
+func annotationPadding(style Style) Box {
+	return style.InheritFrom(Style{Padding: DefaultAnnotationPadding}).Padding
 }
 
 // Box draws a box with a given style.
@@ -276,6 +581,48 @@ func (d draw) Text(r Renderer, text string, x, y int, style Style) {
 	r.Text(text, x, y)
 }
 
+// TextAligned draws text at a point, aligned to it by the `TextHorizontalAlign` and `TextVerticalAlign` of the
+// style; by default the point is the left end of the baseline, as with `Text`. Each `\n` starts a new line,
+// `TextLineSpacing` below the last, and each line is aligned on its own.
+// Rotated text isn't aligned; it's drawn from the point as with `Text`.
+func (d draw) TextAligned(r Renderer, text string, x, y int, style Style) {
+	style.GetTextOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	if style.TextRotationDegrees != 0 {
+		r.Text(text, x, y)
+		return
+	}
+
+	lines := strings.Split(text, "\n")
+	linesBox := Text.MeasureLines(r, lines, style)
+
+	var top int
+	switch style.GetTextVerticalAlign() {
+	case TextVerticalAlignTop:
+		top = y
+	case TextVerticalAlignMiddle, TextVerticalAlignMiddleBaseline:
+		top = y + linesBox.Height()>>1 - linesBox.Height()
+	case TextVerticalAlignBottom:
+		top = y - linesBox.Height()
+	default:
+		top = y - r.MeasureText(lines[0]).Height()
+	}
+
+	for _, line := range lines {
+		lineBox := r.MeasureText(line)
+		tx := x
+		switch style.GetTextHorizontalAlign() {
+		case TextHorizontalAlignCenter:
+			tx = x - lineBox.Width()>>1
+		case TextHorizontalAlignRight:
+			tx = x - lineBox.Width()
+		}
+		r.Text(line, tx, top+lineBox.Height())
+		top += lineBox.Height() + style.GetTextLineSpacing()
+	}
+}
+
 func (d draw) MeasureText(r Renderer, text string, style Style) Box {
 	style.GetTextOptions().WriteToRenderer(r)
 	defer r.ResetStyle()
@@ -285,10 +632,16 @@ func (d draw) MeasureText(r Renderer, text string, style Style) Box {
 
 // TextWithin draws the text within a given box.
 func (d draw) TextWithin(r Renderer, text string, box Box, style Style) {
+	style.GetTextOptions().WriteToRenderer(r)
+	lines := Text.WrapFit(r, text, box.Width(), style)
+	d.TextLinesWithin(r, lines, box, style)
+}
+
+// TextLinesWithin draws lines of text within a box, aligned by the style.
+func (d draw) TextLinesWithin(r Renderer, lines []string, box Box, style Style) {
 	style.GetTextOptions().WriteToRenderer(r)
 	defer r.ResetStyle()
 
-	lines := Text.WrapFit(r, text, box.Width(), style)
 	linesBox := Text.MeasureLines(r, lines, style)
 
 	y := box.Top
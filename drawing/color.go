@@ -2,7 +2,9 @@ package drawing
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 )
 
 var (
@@ -25,24 +27,37 @@ var (
 	ColorBlue = Color{R: 0, G: 0, B: 255, A: 255}
 )
 
-func parseHex(hex string) uint8 {
-	v, _ := strconv.ParseInt(hex, 16, 16)
-	return uint8(v)
+// ParseColorHex returns a color from a css hex code, e.g. "#2e86de" or "fff".
+// The code has 3 or 6 digits of either case and an optional leading '#'.
+func ParseColorHex(hex string) (Color, error) {
+	digits := strings.TrimPrefix(hex, "#")
+	if len(digits) != 3 && len(digits) != 6 {
+		return ColorTransparent, fmt.Errorf("invalid color hex code %q; must have 3 or 6 digits", hex)
+	}
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return ColorTransparent, fmt.Errorf("invalid color hex code %q; must be hexadecimal", hex)
+	}
+	if len(digits) == 3 {
+		return Color{
+			R: uint8(value>>8&0xf) * 0x11,
+			G: uint8(value>>4&0xf) * 0x11,
+			B: uint8(value&0xf) * 0x11,
+			A: 255,
+		}, nil
+	}
+	return Color{
+		R: uint8(value >> 16),
+		G: uint8(value >> 8),
+		B: uint8(value),
+		A: 255,
+	}, nil
 }
 
-// ColorFromHex returns a color from a css hex code.
+// ColorFromHex returns a color from a css hex code, or `ColorTransparent` if the code is invalid.
+// See `ParseColorHex` for the codes it takes.
 func ColorFromHex(hex string) Color {
-	var c Color
-	if len(hex) == 3 {
-		c.R = parseHex(string(hex[0])) * 0x11
-		c.G = parseHex(string(hex[1])) * 0x11
-		c.B = parseHex(string(hex[2])) * 0x11
-	} else {
-		c.R = parseHex(string(hex[0:2]))
-		c.G = parseHex(string(hex[2:4]))
-		c.B = parseHex(string(hex[4:6]))
-	}
-	c.A = 255
+	c, _ := ParseColorHex(hex)
 	return c
 }
 
@@ -119,8 +134,37 @@ func (c Color) AverageWith(other Color) Color {
 	}
 }
 
+// Interpolate returns the color a given fraction (from 0 to 1) of the way from the color to another.
+func (c Color) Interpolate(other Color, t float64) Color {
+	if t <= 0 {
+		return c
+	}
+	if t >= 1 {
+		return other
+	}
+	lerp := func(from, to uint8) uint8 {
+		return uint8(math.Round(float64(from) + (float64(to)-float64(from))*t))
+	}
+	return Color{
+		R: lerp(c.R, other.R),
+		G: lerp(c.G, other.G),
+		B: lerp(c.B, other.B),
+		A: lerp(c.A, other.A),
+	}
+}
+
+// Luminance returns the perceived brightness of the color, from 0 (black) to 1 (white).
+func (c Color) Luminance() float64 {
+	return (0.2126*float64(c.R) + 0.7152*float64(c.G) + 0.0722*float64(c.B)) / 255.0
+}
+
 // String returns a css string representation of the color.
 func (c Color) String() string {
 	fa := float64(c.A) / float64(255)
 	return fmt.Sprintf("rgba(%v,%v,%v,%.1f)", c.R, c.G, c.B, fa)
 }
+
+// Hex returns the css hex code of the color, e.g. "#2e86de"; the alpha is not included.
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -51,3 +51,61 @@ func TestColorFromAlphaMixedRGBA(t *testing.T) {
 	white := ColorFromAlphaMixedRGBA(color.White.RGBA())
 	assert.True(white.Equals(ColorWhite), white.String())
 }
+
+func TestColorInterpolate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(ColorBlack, ColorBlack.Interpolate(ColorWhite, -1))
+	assert.Equal(ColorWhite, ColorBlack.Interpolate(ColorWhite, 2))
+	assert.Equal(Color{R: 128, G: 128, B: 128, A: 255}, ColorBlack.Interpolate(ColorWhite, 0.5))
+	assert.Equal(Color{R: 191, G: 0, B: 64, A: 255}, ColorRed.Interpolate(ColorBlue, 0.25))
+}
+
+func TestColorLuminance(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Zero(ColorBlack.Luminance())
+	assert.InDelta(1.0, ColorWhite.Luminance(), 0.0001)
+	assert.True(ColorGreen.Luminance() > ColorBlue.Luminance())
+}
+
+func TestParseColorHex(t *testing.T) {
+	assert := assert.New(t)
+
+	expected := Color{R: 0x2e, G: 0x86, B: 0xde, A: 255}
+	for _, hex := range []string{"#2e86de", "2e86de", "#2E86DE", "2E86de"} {
+		c, err := ParseColorHex(hex)
+		assert.Nil(err, hex)
+		assert.Equal(expected, c, hex)
+	}
+
+	c, err := ParseColorHex("#fA0")
+	assert.Nil(err)
+	assert.Equal(Color{R: 0xff, G: 0xaa, B: 0x00, A: 255}, c)
+
+	for _, hex := range []string{"", "#", "ff", "#ffff", "fffffff", "#ggg", "-12345", "+12345", "0x1234", "12_456"} {
+		c, err = ParseColorHex(hex)
+		assert.NotNil(err, hex)
+		assert.Equal(ColorTransparent, c, hex)
+		assert.Equal(ColorTransparent, ColorFromHex(hex), hex)
+	}
+}
+
+func TestColorHexRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, hex := range []string{"#000000", "#ffffff", "#2e86de", "#0074d9", "#010203", "#fedcba"} {
+		assert.Equal(hex, ColorFromHex(hex).Hex())
+	}
+	assert.Equal("#2e86de", ColorFromHex("2E86DE").Hex())
+	assert.Equal("#ffaa00", ColorFromHex("fa0").Hex())
+	// the alpha isn't part of the hex code.
+	assert.Equal("#2e86de", ColorFromHex("2e86de").WithAlpha(64).Hex())
+}
+
+func TestColorWithAlpha(t *testing.T) {
+	assert := assert.New(t)
+
+	c := ColorFromHex("#2e86de").WithAlpha(64)
+	assert.Equal(Color{R: 0x2e, G: 0x86, B: 0xde, A: 64}, c)
+}
@@ -36,6 +36,7 @@ func NewRasterGraphicContextWithPainter(img draw.Image, painter Painter) *Raster
 		raster.NewRasterizer(width, height),
 		&truetype.GlyphBuf{},
 		DefaultDPI,
+		nil,
 	}
 }
 
@@ -48,6 +49,28 @@ type RasterGraphicContext struct {
 	strokeRasterizer *raster.Rasterizer
 	glyphBuf         *truetype.GlyphBuf
 	DPI              float64
+	fallbackFonts    []*truetype.Font
+}
+
+// SetFallbackFonts sets the fonts, in order, that glyphs missing from the current font are drawn with.
+func (rgc *RasterGraphicContext) SetFallbackFonts(fonts []*truetype.Font) {
+	rgc.fallbackFonts = fonts
+}
+
+// fontFor returns the font to draw a rune with, and the index of its glyph in it: the current font
+// unless it's missing the glyph and one of the fallback fonts has it.
+func (rgc *RasterGraphicContext) fontFor(r rune) (*truetype.Font, truetype.Index) {
+	f := rgc.GetFont()
+	index := f.Index(r)
+	if index != 0 {
+		return f, index
+	}
+	for _, fallback := range rgc.fallbackFonts {
+		if fallbackIndex := fallback.Index(r); fallbackIndex != 0 {
+			return fallback, fallbackIndex
+		}
+	}
+	return f, index
 }
 
 // SetDPI sets the screen resolution in dots per inch.
@@ -61,6 +84,14 @@ func (rgc *RasterGraphicContext) GetDPI() float64 {
 	return rgc.DPI
 }
 
+// ClipTo paints paths and text only within a rectangle of the image, until it's called again; the bounds
+// of the image paint all of it. It only clips contexts of an *image.RGBA, and replaces their painter.
+func (rgc *RasterGraphicContext) ClipTo(rect image.Rectangle) {
+	if rgba, isRGBA := rgc.img.(*image.RGBA); isRGBA {
+		rgc.painter = raster.NewRGBAPainter(rgba.SubImage(rect).(*image.RGBA))
+	}
+}
+
 // Clear fills the current canvas with a default transparent color
 func (rgc *RasterGraphicContext) Clear() {
 	width, height := rgc.img.Bounds().Dx(), rgc.img.Bounds().Dy()
@@ -104,8 +135,8 @@ func (rgc *RasterGraphicContext) StrokeStringAt(text string, x, y float64) (curs
 	return
 }
 
-func (rgc *RasterGraphicContext) drawGlyph(glyph truetype.Index, dx, dy float64) error {
-	if err := rgc.glyphBuf.Load(rgc.current.Font, fixed.Int26_6(rgc.current.Scale), glyph, font.HintingNone); err != nil {
+func (rgc *RasterGraphicContext) drawGlyph(f *truetype.Font, glyph truetype.Index, dx, dy float64) error {
+	if err := rgc.glyphBuf.Load(f, fixed.Int26_6(rgc.current.Scale), glyph, font.HintingNone); err != nil {
 		return err
 	}
 	e0 := 0
@@ -131,19 +162,20 @@ func (rgc *RasterGraphicContext) CreateStringPath(s string, x, y float64) (curso
 	rgc.recalc()
 
 	startx := x
-	prev, hasPrev := truetype.Index(0), false
+	var prevFont *truetype.Font
+	prev := truetype.Index(0)
 	for _, rc := range s {
-		index := f.Index(rc)
-		if hasPrev {
-			x += fUnitsToFloat64(f.Kern(fixed.Int26_6(rgc.current.Scale), prev, index))
+		glyphFont, index := rgc.fontFor(rc)
+		if glyphFont == prevFont {
+			x += fUnitsToFloat64(glyphFont.Kern(fixed.Int26_6(rgc.current.Scale), prev, index))
 		}
-		err = rgc.drawGlyph(index, x, y)
+		err = rgc.drawGlyph(glyphFont, index, x, y)
 		if err != nil {
 			cursor = x - startx
 			return
 		}
-		x += fUnitsToFloat64(f.HMetric(fixed.Int26_6(rgc.current.Scale), index).AdvanceWidth)
-		prev, hasPrev = index, true
+		x += fUnitsToFloat64(glyphFont.HMetric(fixed.Int26_6(rgc.current.Scale), index).AdvanceWidth)
+		prevFont, prev = glyphFont, index
 	}
 	cursor = x - startx
 	return
@@ -162,14 +194,15 @@ func (rgc *RasterGraphicContext) GetStringBounds(s string) (left, top, right, bo
 	top = math.MaxFloat64
 
 	cursor := 0.0
-	prev, hasPrev := truetype.Index(0), false
+	var prevFont *truetype.Font
+	prev := truetype.Index(0)
 	for _, rc := range s {
-		index := f.Index(rc)
-		if hasPrev {
-			cursor += fUnitsToFloat64(f.Kern(fixed.Int26_6(rgc.current.Scale), prev, index))
+		glyphFont, index := rgc.fontFor(rc)
+		if glyphFont == prevFont {
+			cursor += fUnitsToFloat64(glyphFont.Kern(fixed.Int26_6(rgc.current.Scale), prev, index))
 		}
 
-		if err = rgc.glyphBuf.Load(rgc.current.Font, fixed.Int26_6(rgc.current.Scale), index, font.HintingNone); err != nil {
+		if err = rgc.glyphBuf.Load(glyphFont, fixed.Int26_6(rgc.current.Scale), index, font.HintingNone); err != nil {
 			return
 		}
 		e0 := 0
@@ -184,8 +217,8 @@ func (rgc *RasterGraphicContext) GetStringBounds(s string) (left, top, right, bo
 			}
 			e0 = e1
 		}
-		cursor += fUnitsToFloat64(f.HMetric(fixed.Int26_6(rgc.current.Scale), index).AdvanceWidth)
-		prev, hasPrev = index, true
+		cursor += fUnitsToFloat64(glyphFont.HMetric(fixed.Int26_6(rgc.current.Scale), index).AdvanceWidth)
+		prevFont, prev = glyphFont, index
 	}
 	return
 }
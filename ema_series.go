@@ -1,6 +1,9 @@
 package chart
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 const (
 	// DefaultEMAPeriod is the default EMA period used in the sigma calculation.
@@ -23,26 +26,27 @@ type EMASeries struct {
 	Period      int
 	InnerSeries ValuesProvider
 
+	lock  sync.Mutex
 	cache []float64
 }
 
 // GetName returns the name of the time series.
-func (ema EMASeries) GetName() string {
+func (ema *EMASeries) GetName() string {
 	return ema.Name
 }
 
 // GetStyle returns the line style.
-func (ema EMASeries) GetStyle() Style {
+func (ema *EMASeries) GetStyle() Style {
 	return ema.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (ema EMASeries) GetYAxis() YAxisType {
+func (ema *EMASeries) GetYAxis() YAxisType {
 	return ema.YAxis
 }
 
 // GetPeriod returns the window size.
-func (ema EMASeries) GetPeriod() int {
+func (ema *EMASeries) GetPeriod() int {
 	if ema.Period == 0 {
 		return DefaultEMAPeriod
 	}
@@ -50,12 +54,12 @@ func (ema EMASeries) GetPeriod() int {
 }
 
 // Len returns the number of elements in the series.
-func (ema EMASeries) Len() int {
+func (ema *EMASeries) Len() int {
 	return ema.InnerSeries.Len()
 }
 
 // GetSigma returns the smoothing factor for the serise.
-func (ema EMASeries) GetSigma() float64 {
+func (ema *EMASeries) GetSigma() float64 {
 	return 2.0 / (float64(ema.GetPeriod()) + 1)
 }
 
@@ -64,56 +68,61 @@ func (ema *EMASeries) GetValues(index int) (x, y float64) {
 	if ema.InnerSeries == nil {
 		return
 	}
-	if len(ema.cache) == 0 {
-		ema.ensureCachedValues()
-	}
+	cache := ema.getCachedValues()
 	vx, _ := ema.InnerSeries.GetValues(index)
 	x = vx
-	y = ema.cache[index]
+	y = cache[index]
 	return
 }
 
 // GetFirstValues computes the first moving average value.
 func (ema *EMASeries) GetFirstValues() (x, y float64) {
-	if ema.InnerSeries == nil {
+	if ema.InnerSeries == nil || ema.InnerSeries.Len() == 0 {
 		return
 	}
-	if len(ema.cache) == 0 {
-		ema.ensureCachedValues()
-	}
+	cache := ema.getCachedValues()
 	x, _ = ema.InnerSeries.GetValues(0)
-	y = ema.cache[0]
+	y = cache[0]
 	return
 }
 
 // GetLastValues computes the last moving average value but walking back window size samples,
 // and recomputing the last moving average chunk.
 func (ema *EMASeries) GetLastValues() (x, y float64) {
-	if ema.InnerSeries == nil {
+	if ema.InnerSeries == nil || ema.InnerSeries.Len() == 0 {
 		return
 	}
-	if len(ema.cache) == 0 {
-		ema.ensureCachedValues()
-	}
-	lastIndex := ema.InnerSeries.Len() - 1
+	cache := ema.getCachedValues()
+	lastIndex := len(cache) - 1
 	x, _ = ema.InnerSeries.GetValues(lastIndex)
-	y = ema.cache[lastIndex]
+	y = cache[lastIndex]
 	return
 }
 
-func (ema *EMASeries) ensureCachedValues() {
+// getCachedValues returns the running EMA values, computed once, and again only if the length of the inner series
+// changes, so that random access to the values doesn't cost O(n) per point.
+// They're computed with the series locked, so charts with the series can be rendered concurrently.
+func (ema *EMASeries) getCachedValues() []float64 {
+	ema.lock.Lock()
+	defer ema.lock.Unlock()
+
 	seriesLength := ema.InnerSeries.Len()
-	ema.cache = make([]float64, seriesLength)
+	if ema.cache != nil && len(ema.cache) == seriesLength {
+		return ema.cache
+	}
+	cache := make([]float64, seriesLength)
 	sigma := ema.GetSigma()
 	for x := 0; x < seriesLength; x++ {
 		_, y := ema.InnerSeries.GetValues(x)
 		if x == 0 {
-			ema.cache[x] = y
+			cache[x] = y
 			continue
 		}
-		previousEMA := ema.cache[x-1]
-		ema.cache[x] = ((y - previousEMA) * sigma) + previousEMA
+		previousEMA := cache[x-1]
+		cache[x] = ((y - previousEMA) * sigma) + previousEMA
 	}
+	ema.cache = cache
+	return cache
 }
 
 // Render renders the series.
@@ -103,3 +103,62 @@ func TestEMASeries(t *testing.T) {
 	assert.Equal(50.0, lvx)
 	assert.InDelta(lvy, emaExpected[49], emaDelta)
 }
+
+type countingValuesProvider struct {
+	mockValuesProvider
+	calls *int
+}
+
+func (c countingValuesProvider) GetValues(index int) (x, y float64) {
+	*c.calls++
+	return c.mockValuesProvider.GetValues(index)
+}
+
+func TestEMASeriesCachesValues(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int
+	ema := &EMASeries{
+		InnerSeries: countingValuesProvider{
+			mockValuesProvider: mockValuesProvider{emaXValues, emaYValues},
+			calls:              &calls,
+		},
+		Period: 26,
+	}
+
+	for x := 0; x < ema.Len(); x++ {
+		ema.GetValues(x)
+	}
+	// one pass to compute the running values, plus one lookup of each x value.
+	assert.Equal(2*len(emaYValues), calls)
+}
+
+func TestEMASeriesInvalidatesCache(t *testing.T) {
+	assert := assert.New(t)
+
+	ema := &EMASeries{
+		InnerSeries: mockValuesProvider{emaXValues[:10], emaYValues[:10]},
+		Period:      26,
+	}
+	_, y := ema.GetLastValues()
+	assert.InDelta(y, emaExpected[9], emaDelta)
+
+	ema.InnerSeries = mockValuesProvider{emaXValues, emaYValues}
+	x, y := ema.GetLastValues()
+	assert.Equal(50.0, x)
+	assert.InDelta(y, emaExpected[49], emaDelta)
+}
+
+func TestEMASeriesEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	ema := &EMASeries{
+		InnerSeries: mockValuesProvider{},
+	}
+	x, y := ema.GetLastValues()
+	assert.Zero(x)
+	assert.Zero(y)
+	x, y = ema.GetFirstValues()
+	assert.Zero(x)
+	assert.Zero(y)
+}
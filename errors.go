@@ -0,0 +1,26 @@
+package chart
+
+import "strings"
+
+// errorList is a set of errors reported together, e.g. by a validation.
+type errorList []error
+
+// Error implements error.
+func (el errorList) Error() string {
+	messages := make([]string, len(el))
+	for index, err := range el {
+		messages[index] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// errorOrNil returns the list as an error, or nil if it is empty.
+func (el errorList) errorOrNil() error {
+	if len(el) == 0 {
+		return nil
+	}
+	if len(el) == 1 {
+		return el[0]
+	}
+	return el
+}
@@ -16,10 +16,7 @@ func main() {
 			},
 		},
 	}
-	collector := &chart.ImageWriter{}
-	graph.Render(chart.PNG, collector)
-
-	image, err := collector.Image()
+	image, err := graph.RenderImage(chart.PNG)
 	if err != nil {
 		log.Fatal(err)
 	}
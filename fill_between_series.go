@@ -0,0 +1,111 @@
+package chart
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*FillBetweenSeries)(nil)
+	_ BoundedValuesProvider = (*FillBetweenSeries)(nil)
+	_ bandSeries            = (*FillBetweenSeries)(nil)
+)
+
+// FillBetweenSeries shades the area between an upper and a lower series, for example a confidence band.
+// The band follows the x values of the upper series; if the lower series has a different length or x values,
+// it is linearly interpolated at those x values, and held at its first or last value beyond its own x range.
+type FillBetweenSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Upper ValuesProvider
+	Lower ValuesProvider
+}
+
+// GetName returns the name of the series.
+func (fbs FillBetweenSeries) GetName() string {
+	return fbs.Name
+}
+
+// GetStyle returns the series style.
+func (fbs FillBetweenSeries) GetStyle() Style {
+	return fbs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (fbs FillBetweenSeries) GetYAxis() YAxisType {
+	return fbs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (fbs FillBetweenSeries) Len() int {
+	if fbs.Upper == nil || fbs.Lower == nil || fbs.Lower.Len() == 0 {
+		return 0
+	}
+	return fbs.Upper.Len()
+}
+
+// GetBoundedValues gets the upper and (interpolated) lower value at a given index of the upper series.
+func (fbs FillBetweenSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x, y1 = fbs.Upper.GetValues(index)
+	y2 = fbs.lowerValueAt(x)
+	return
+}
+
+// Render renders the series.
+func (fbs FillBetweenSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if fbs.Len() == 0 {
+		return
+	}
+	style := fbs.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeWidth: 1.0,
+		StrokeColor: DefaultAxisColor.WithAlpha(64),
+		FillColor:   DefaultAxisColor.WithAlpha(DefaultBandAlpha),
+	}))
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, style, fbs)
+}
+
+// band marks the series as a band, which charts fill in the axis color of their theme.
+func (fbs FillBetweenSeries) band() {}
+
+// Validate validates the series.
+func (fbs FillBetweenSeries) Validate() error {
+	if fbs.Upper == nil {
+		return fmt.Errorf("fill between series requires Upper to be set")
+	}
+	if fbs.Lower == nil {
+		return fmt.Errorf("fill between series requires Lower to be set")
+	}
+	return nil
+}
+
+// lowerValueAt returns the lower series value at x, assuming its x values are sorted (ascending or descending).
+func (fbs FillBetweenSeries) lowerValueAt(x float64) float64 {
+	count := fbs.Lower.Len()
+	firstX, firstY := fbs.Lower.GetValues(0)
+	lastX, lastY := fbs.Lower.GetValues(count - 1)
+	descending := lastX < firstX
+
+	index := sort.Search(count, func(i int) bool {
+		vx, _ := fbs.Lower.GetValues(i)
+		if descending {
+			return vx <= x
+		}
+		return vx >= x
+	})
+	if index == 0 {
+		return firstY
+	}
+	if index == count {
+		return lastY
+	}
+
+	x0, y0 := fbs.Lower.GetValues(index - 1)
+	x1, y1 := fbs.Lower.GetValues(index)
+	if x1 == x0 {
+		return y1
+	}
+	return y0 + (y1-y0)*(x-x0)/(x1-x0)
+}
@@ -0,0 +1,83 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestFillBetweenSeriesGetBoundedValues(t *testing.T) {
+	assert := assert.New(t)
+
+	fbs := FillBetweenSeries{
+		Upper: ContinuousSeries{
+			XValues: []float64{0, 1, 2, 3, 4},
+			YValues: []float64{10, 11, 12, 13, 14},
+		},
+		Lower: ContinuousSeries{
+			XValues: []float64{1, 3},
+			YValues: []float64{2, 4},
+		},
+	}
+	assert.Equal(5, fbs.Len())
+
+	var lower []float64
+	for index := 0; index < fbs.Len(); index++ {
+		x, y1, y2 := fbs.GetBoundedValues(index)
+		assert.Equal(float64(index), x)
+		assert.Equal(10+float64(index), y1)
+		lower = append(lower, y2)
+	}
+	assert.Equal([]float64{2, 2, 3, 4, 4}, lower)
+}
+
+func TestFillBetweenSeriesDescending(t *testing.T) {
+	assert := assert.New(t)
+
+	fbs := FillBetweenSeries{
+		Upper: ContinuousSeries{
+			XValues: []float64{3, 2, 1},
+			YValues: []float64{10, 10, 10},
+		},
+		Lower: ContinuousSeries{
+			XValues: []float64{4, 0},
+			YValues: []float64{8, 0},
+		},
+	}
+	_, _, y2 := fbs.GetBoundedValues(1)
+	assert.Equal(4.0, y2)
+}
+
+func TestFillBetweenSeriesRange(t *testing.T) {
+	assert := assert.New(t)
+
+	upper := ContinuousSeries{
+		XValues: []float64{0, 1, 2, 3},
+		YValues: []float64{5, 6, 7, 8},
+	}
+	lower := ContinuousSeries{
+		XValues: []float64{0, 1.5, 3},
+		YValues: []float64{1, 2, 3},
+	}
+	c := Chart{
+		Series: []Series{
+			FillBetweenSeries{Upper: upper, Lower: lower},
+			upper,
+			lower,
+		},
+	}
+
+	xr, yr, _ := c.getRanges()
+	assert.Equal(0.0, xr.GetMin())
+	assert.Equal(3.0, xr.GetMax())
+	assert.Equal(1.0, yr.GetMin())
+	assert.Equal(8.0, yr.GetMax())
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	assert.NotNil(FillBetweenSeries{Upper: upper}.Validate())
+	assert.NotNil(FillBetweenSeries{Lower: lower}.Validate())
+}
@@ -1,6 +1,8 @@
 package chart
 
 import (
+	"errors"
+	"fmt"
 	"sync"
 
 	"github.com/golang/freetype/truetype"
@@ -8,22 +10,124 @@ import (
 )
 
 var (
-	_defaultFontLock sync.Mutex
+	_defaultFontLock sync.RWMutex
 	_defaultFont     *truetype.Font
 )
 
-// GetDefaultFont returns the default font (Roboto-Medium).
+// GetDefaultFont returns the default font, Roboto-Medium unless it's been changed with `SetDefaultFont`.
+// The font is parsed once and shared by every chart.
 func GetDefaultFont() (*truetype.Font, error) {
+	_defaultFontLock.RLock()
+	font := _defaultFont
+	_defaultFontLock.RUnlock()
+	if font != nil {
+		return font, nil
+	}
+
+	_defaultFontLock.Lock()
+	defer _defaultFontLock.Unlock()
 	if _defaultFont == nil {
-		_defaultFontLock.Lock()
-		defer _defaultFontLock.Unlock()
-		if _defaultFont == nil {
-			font, err := truetype.Parse(roboto.Roboto)
-			if err != nil {
-				return nil, err
-			}
-			_defaultFont = font
+		font, err := truetype.Parse(roboto.Roboto)
+		if err != nil {
+			return nil, err
 		}
+		_defaultFont = font
 	}
 	return _defaultFont, nil
 }
+
+// SetDefaultFont sets the font used by charts that don't have a font set.
+// Setting it to nil restores Roboto-Medium.
+func SetDefaultFont(font *truetype.Font) {
+	_defaultFontLock.Lock()
+	defer _defaultFontLock.Unlock()
+	_defaultFont = font
+}
+
+// SetDefaultFontBytes parses a TrueType font and sets it as the default font.
+func SetDefaultFontBytes(ttf []byte) error {
+	font, err := truetype.Parse(ttf)
+	if err != nil {
+		return err
+	}
+	SetDefaultFont(font)
+	return nil
+}
+
+var (
+	_registeredFontsLock sync.RWMutex
+	_registeredFonts     map[string]*truetype.Font
+	_fallbackFonts       []*truetype.Font
+	_fallbackFontNames   []string
+)
+
+// RegisterFont parses a TrueType font and registers it by name, so styles can use it with `Style.FontName`
+// and it can be one of the fallback fonts. Registering a font under a name already in use replaces it,
+// including as a fallback font.
+func RegisterFont(name string, ttf []byte) error {
+	if name == "" {
+		return errors.New("register font; please provide a name")
+	}
+	font, err := truetype.Parse(ttf)
+	if err != nil {
+		return fmt.Errorf("register font; %s: %v", name, err)
+	}
+
+	_registeredFontsLock.Lock()
+	defer _registeredFontsLock.Unlock()
+	if _registeredFonts == nil {
+		_registeredFonts = make(map[string]*truetype.Font)
+	}
+	_registeredFonts[name] = font
+
+	for index, fallbackName := range _fallbackFontNames {
+		if fallbackName != name {
+			continue
+		}
+		// copied, as renderers may be using the fallback fonts they were given.
+		fonts := append([]*truetype.Font(nil), _fallbackFonts...)
+		fonts[index] = font
+		_fallbackFonts = fonts
+		_textMeasureCache.clear()
+	}
+	return nil
+}
+
+// GetRegisteredFont returns the font registered with a name, or nil if there isn't one.
+func GetRegisteredFont(name string) *truetype.Font {
+	if name == "" {
+		return nil
+	}
+	_registeredFontsLock.RLock()
+	defer _registeredFontsLock.RUnlock()
+	return _registeredFonts[name]
+}
+
+// SetFallbackFonts sets the registered fonts, in order, that characters missing from the font of a style
+// are drawn with; e.g. a CJK font for labels in a Latin font. Calling it with no names clears them.
+// The raster renderers pick the font for each character, and the vector renderers list the fallback fonts
+// in the font family of the text.
+func SetFallbackFonts(names ...string) error {
+	_registeredFontsLock.Lock()
+	defer _registeredFontsLock.Unlock()
+
+	fonts := make([]*truetype.Font, 0, len(names))
+	for _, name := range names {
+		font, ok := _registeredFonts[name]
+		if !ok {
+			return fmt.Errorf("set fallback fonts; no font is registered as %q", name)
+		}
+		fonts = append(fonts, font)
+	}
+	_fallbackFonts, _fallbackFontNames = fonts, names
+	// measurements are cached by the font of the text, and depend on the fallback fonts as well.
+	_textMeasureCache.clear()
+	return nil
+}
+
+// getFallbackFonts returns the fallback fonts, in order.
+func getFallbackFonts() []*truetype.Font {
+	_registeredFontsLock.RLock()
+	defer _registeredFontsLock.RUnlock()
+	return _fallbackFonts
+}
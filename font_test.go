@@ -0,0 +1,206 @@
+package chart
+
+import (
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/roboto"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestGetDefaultFontConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	SetDefaultFont(nil)
+
+	fonts := make([]*truetype.Font, 16)
+	var wg sync.WaitGroup
+	for index := range fonts {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			fonts[index], _ = GetDefaultFont()
+		}(index)
+	}
+	wg.Wait()
+
+	assert.NotNil(fonts[0])
+	for _, font := range fonts {
+		assert.True(font == fonts[0])
+	}
+}
+
+func TestSetDefaultFont(t *testing.T) {
+	assert := assert.New(t)
+	defer SetDefaultFont(nil)
+
+	custom, err := truetype.Parse(roboto.Roboto)
+	assert.Nil(err)
+	SetDefaultFont(custom)
+
+	font, err := GetDefaultFont()
+	assert.Nil(err)
+	assert.True(font == custom)
+
+	SetDefaultFont(nil)
+	font, err = GetDefaultFont()
+	assert.Nil(err)
+	assert.True(font != custom)
+}
+
+func TestSetDefaultFontBytes(t *testing.T) {
+	assert := assert.New(t)
+	defer SetDefaultFont(nil)
+
+	before, err := GetDefaultFont()
+	assert.Nil(err)
+
+	assert.NotNil(SetDefaultFontBytes([]byte("not a font")))
+	font, err := GetDefaultFont()
+	assert.Nil(err)
+	assert.True(font == before)
+
+	assert.Nil(SetDefaultFontBytes(roboto.Roboto))
+	font, err = GetDefaultFont()
+	assert.Nil(err)
+	assert.True(font != before)
+}
+
+// fontRecordingRenderer records the font each text is drawn with.
+type fontRecordingRenderer struct {
+	Renderer
+	font  *truetype.Font
+	fonts map[string]*truetype.Font
+}
+
+func (fr *fontRecordingRenderer) SetFont(f *truetype.Font) {
+	fr.font = f
+	fr.Renderer.SetFont(f)
+}
+
+func (fr *fontRecordingRenderer) Text(body string, x, y int) {
+	fr.fonts[body] = fr.font
+	fr.Renderer.Text(body, x, y)
+}
+
+func TestChartElementFonts(t *testing.T) {
+	assert := assert.New(t)
+
+	heavy, err := truetype.Parse(roboto.Roboto)
+	assert.Nil(err)
+	condensed, err := truetype.Parse(roboto.Roboto)
+	assert.Nil(err)
+
+	c := Chart{
+		Title:      "Title",
+		TitleStyle: Style{Font: heavy},
+		XAxis:      XAxis{Name: "Time", NameStyle: Style{Font: heavy}, TickStyle: Style{Font: condensed}, Ticks: []Tick{{Value: 0, Label: "x-start"}, {Value: 1, Label: "x-end"}}},
+		YAxis:      YAxis{Style: Style{Font: condensed}, Ticks: []Tick{{Value: 0, Label: "y-start"}, {Value: 1, Label: "y-end"}}},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{0, 1}, YValues: []float64{0, 1}},
+		},
+	}
+	assert.False(c.needsDefaultFont())
+
+	r, err := SVG(c.GetWidth(), c.GetHeight())
+	assert.Nil(err)
+	fr := &fontRecordingRenderer{Renderer: r, fonts: map[string]*truetype.Font{}}
+	assert.Nil(c.renderTo(fr, io.Discard))
+	assert.True(fr.fonts["Title"] == heavy)
+	assert.True(fr.fonts["Time"] == heavy)
+	assert.True(fr.fonts["x-start"] == condensed)
+	assert.True(fr.fonts["y-end"] == condensed)
+
+	// any text without a font of its own falls back to the default font.
+	c.Subtitle = "Subtitle"
+	assert.False(c.needsDefaultFont())
+	c.YAxis.Name = "Value"
+	assert.True(c.needsDefaultFont())
+	c.YAxis.Name = ""
+	c.XMarkers = []XMarker{{Value: 0.5, Label: "release"}}
+	assert.True(c.needsDefaultFont())
+	c.XMarkers[0].Style.Hidden = true
+	assert.False(c.needsDefaultFont())
+	c.Watermark.Text = "draft"
+	assert.True(c.needsDefaultFont())
+	c.Watermark.Style.Font = heavy
+	assert.False(c.needsDefaultFont())
+	c.Series[0] = ContinuousSeries{Style: Style{ShowValues: true}, XValues: []float64{0, 1}, YValues: []float64{0, 1}}
+	assert.True(c.needsDefaultFont())
+	c.Series[0] = ContinuousSeries{XValues: []float64{0, 1}, YValues: []float64{0, 1}}
+	c.Elements = []Renderable{Legend(&c)}
+	assert.True(c.needsDefaultFont())
+
+	c.Font = heavy
+	assert.False(c.needsDefaultFont())
+}
+
+func TestRegisterFont(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(RegisterFont("", goregular.TTF))
+	assert.NotNil(RegisterFont("broken", []byte("not a font")))
+	assert.Nil(GetRegisteredFont("broken"))
+
+	assert.Nil(RegisterFont("go", goregular.TTF))
+	font := GetRegisteredFont("go")
+	assert.NotNil(font)
+	assert.Equal("Go", font.Name(truetype.NameIDFontFamily))
+
+	// the font of a style comes before its font name, which comes before the defaults.
+	roboto, err := GetDefaultFont()
+	assert.Nil(err)
+	assert.True(Style{FontName: "go"}.GetFont(roboto) == font)
+	assert.True(Style{Font: roboto, FontName: "go"}.GetFont() == roboto)
+	assert.True(Style{FontName: "unknown"}.GetFont(roboto) == roboto)
+	assert.True(Style{FontName: "go"}.InheritFrom(Style{Font: roboto}).Font == font)
+	assert.Equal("go", Style{}.InheritFrom(Style{FontName: "go"}).FontName)
+}
+
+func TestSetFallbackFonts(t *testing.T) {
+	assert := assert.New(t)
+	defer SetFallbackFonts()
+
+	assert.Nil(RegisterFont("go", goregular.TTF))
+	assert.NotNil(SetFallbackFonts("go", "unknown"))
+	assert.Empty(getFallbackFonts())
+
+	font, err := GetDefaultFont()
+	assert.Nil(err)
+	// roboto doesn't have the triangles, so without a fallback font they're measured as the missing glyph.
+	measure := func(rp RendererProvider) int {
+		r, err := rp(200, 100)
+		assert.Nil(err)
+		r.SetFont(font)
+		r.SetFontSize(12)
+		return r.MeasureText("▲▲▲▲").Width()
+	}
+	rasterWithout, vectorWithout := measure(PNG), measure(SVG)
+
+	assert.Nil(SetFallbackFonts("go"))
+	assert.Len(getFallbackFonts(), 1)
+	assert.NotEqual(rasterWithout, measure(PNG))
+	assert.NotEqual(vectorWithout, measure(SVG))
+
+	c := Chart{
+		Title: "▲ up",
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	assert.Contains(renderFrameTestChart(t, c), "font-family:'Roboto Medium','Go',sans-serif")
+	_, err = c.RenderImage(PNG)
+	assert.Nil(err)
+
+	// registering the font again replaces it as a fallback font, and measures the text with it.
+	regular := measure(PNG)
+	assert.Nil(RegisterFont("go", gomono.TTF))
+	defer RegisterFont("go", goregular.TTF)
+	assert.Len(getFallbackFonts(), 1)
+	assert.True(getFallbackFonts()[0] == GetRegisteredFont("go"))
+	assert.NotEqual(regular, measure(PNG))
+}
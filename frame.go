@@ -0,0 +1,61 @@
+package chart
+
+// Frame is a border around all four sides of the canvas.
+type Frame struct {
+	// Show draws the frame; it is off by default.
+	Show bool
+	// Style is the frame line style; unset fields use the axis line style.
+	Style Style
+	// MirrorTicks draws the tick marks of the x axis along the top of the frame and the tick marks of the
+	// primary y axis along the side opposite it, pointing into the canvas and without labels.
+	// Ticks aren't mirrored onto a side the secondary y axis is drawn on.
+	MirrorTicks bool
+}
+
+// Render renders the frame along the edges of the canvas box, i.e. on top of the edges of the canvas fill.
+func (f Frame) Render(r Renderer, canvasBox Box, xrange, yrange Range, xticks, yticks []Tick, yposition YAxisPosition, mirrorY bool, defaults Style) {
+	style := f.Style.InheritFrom(defaults)
+	if !f.Show || style.Hidden {
+		return
+	}
+
+	style.GetStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	// the frame runs through the corners of the canvas box, like the canvas fill does,
+	// so the two line up at any stroke width; closing the path joins the first corner too.
+	r.MoveTo(canvasBox.Left, canvasBox.Top)
+	r.LineTo(canvasBox.Right, canvasBox.Top)
+	r.LineTo(canvasBox.Right, canvasBox.Bottom)
+	r.LineTo(canvasBox.Left, canvasBox.Bottom)
+	r.Close()
+	r.Stroke()
+
+	if !f.MirrorTicks {
+		return
+	}
+	for _, t := range xticks {
+		x := float64(canvasBox.Left) + translateFloat(xrange, t.Value)
+		if x < float64(canvasBox.Left) || x > float64(canvasBox.Right) {
+			continue
+		}
+		Draw.path(r, []floatPoint{{X: x, Y: float64(canvasBox.Top)}, {X: x, Y: float64(canvasBox.Top + DefaultVerticalTickHeight)}})
+	}
+	r.Stroke()
+
+	if !mirrorY {
+		return
+	}
+	for _, t := range yticks {
+		y := float64(canvasBox.Bottom) - translateFloat(yrange, t.Value)
+		if y < float64(canvasBox.Top) || y > float64(canvasBox.Bottom) {
+			continue
+		}
+		if yposition == YAxisPositionLeft {
+			Draw.path(r, []floatPoint{{X: float64(canvasBox.Right), Y: y}, {X: float64(canvasBox.Right - DefaultHorizontalTickWidth), Y: y}})
+		} else {
+			Draw.path(r, []floatPoint{{X: float64(canvasBox.Left), Y: y}, {X: float64(canvasBox.Left + DefaultHorizontalTickWidth), Y: y}})
+		}
+	}
+	r.Stroke()
+}
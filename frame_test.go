@@ -0,0 +1,84 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func frameTestChart(frame Frame) Chart {
+	return Chart{
+		Width:  300,
+		Height: 200,
+		Frame:  frame,
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+		},
+	}
+}
+
+func renderFrameTestChart(t *testing.T, c Chart) string {
+	buffer := bytes.NewBuffer(nil)
+	if err := c.Render(SVG, buffer); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.String()
+}
+
+func TestFrameHiddenByDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	plain := renderFrameTestChart(t, frameTestChart(Frame{}))
+	assert.Equal(plain, renderFrameTestChart(t, frameTestChart(Frame{MirrorTicks: true, Style: Style{StrokeWidth: 3}})))
+	assert.Equal(plain, renderFrameTestChart(t, frameTestChart(Frame{Show: true, Style: Hidden()})))
+}
+
+func TestFrameMatchesCanvas(t *testing.T) {
+	assert := assert.New(t)
+
+	svg := renderFrameTestChart(t, frameTestChart(Frame{Show: true, Style: Style{StrokeWidth: 5}}))
+	// the second path is the canvas, after the background.
+	canvas := strings.Split(strings.Split(svg, `<path  d="`)[2], `"`)[0]
+	lines := strings.Split(canvas, "\n")
+	assert.Len(lines, 5)
+	frame := strings.Join(append(lines[:4], "Z"), "\n")
+	assert.Contains(svg, frame+`" style="stroke-width:5;stroke:`+DefaultAxisColor.Hex())
+}
+
+func TestFrameMirrorTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	framed := renderFrameTestChart(t, frameTestChart(Frame{Show: true}))
+	mirrored := renderFrameTestChart(t, frameTestChart(Frame{Show: true, MirrorTicks: true}))
+	// one path of tick marks along the top and one along the side.
+	assert.Equal(strings.Count(framed, "<path")+2, strings.Count(mirrored, "<path"))
+
+	c := frameTestChart(Frame{Show: true, MirrorTicks: true})
+	c.Series = append(c.Series, ContinuousSeries{YAxis: YAxisSecondary, XValues: []float64{1, 2, 3}, YValues: []float64{3, 1, 2}})
+	secondary := renderFrameTestChart(t, c)
+	c.Frame.MirrorTicks = false
+	// the secondary axis has the side to itself.
+	assert.Equal(strings.Count(renderFrameTestChart(t, c), "<path")+1, strings.Count(secondary, "<path"))
+}
+
+func TestFrameRaster(t *testing.T) {
+	assert := assert.New(t)
+
+	c := frameTestChart(Frame{Show: true, Style: Style{StrokeColor: drawing.ColorRed, StrokeWidth: 4}})
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	i, err := png.Decode(buffer)
+	assert.Nil(err)
+
+	layout, err := c.Measure(PNG)
+	assert.Nil(err)
+	canvasBox := layout.Canvas
+	assert.Equal(drawing.ColorRed, at(i, canvasBox.Left, canvasBox.Top))
+	assert.Equal(drawing.ColorRed, at(i, canvasBox.Left-1, canvasBox.Top-1))
+	assert.Equal(drawing.ColorRed, at(i, canvasBox.Right, canvasBox.Bottom))
+	assert.Equal(drawing.ColorRed, at(i, canvasBox.Left+1, (canvasBox.Top+canvasBox.Bottom)/2))
+}
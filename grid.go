@@ -0,0 +1,271 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// Grid renders charts into the cells of a grid in a single image, i.e. small multiples.
+// Charts fill the cells row by row, and are sized to fit their cell; their own width and height are ignored.
+// Each chart is clipped to its cell, if the renderer can clip.
+type Grid struct {
+	Title      string
+	TitleStyle Style
+
+	Width  int
+	Height int
+	DPI    float64
+	Font   *truetype.Font
+
+	// Theme sets the colors of the background and title, and is the theme of the charts without one of their own.
+	// It defaults to the default theme.
+	Theme *Theme
+
+	Background Style
+
+	// Columns is the number of cells in a row; by default there are about as many columns as rows.
+	Columns int
+	// Rows is the number of rows; by default there are as many as the charts need.
+	Rows int
+	// CellPadding is the space around each chart within its cell.
+	// It defaults to `DefaultGridCellPadding`; use `BoxZero` for none.
+	CellPadding Box
+
+	// SharedXRange sets the x range of the charts without an x range or ticks of their own
+	// to one spanning all of their values, so the cells line up for comparison.
+	SharedXRange bool
+
+	Charts []*Chart
+}
+
+// GetWidth returns the grid width or the default value.
+func (g Grid) GetWidth() int {
+	if g.Width == 0 {
+		return DefaultChartWidth
+	}
+	return g.Width
+}
+
+// GetHeight returns the grid height or the default value.
+func (g Grid) GetHeight() int {
+	if g.Height == 0 {
+		return DefaultChartHeight
+	}
+	return g.Height
+}
+
+// GetDPI returns the dpi for the grid.
+func (g Grid) GetDPI(defaults ...float64) float64 {
+	if g.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return g.DPI
+}
+
+// GetTheme returns the theme for the grid.
+func (g Grid) GetTheme() *Theme {
+	return getTheme(g.Theme)
+}
+
+// GetColumns returns the number of columns or the default value.
+func (g Grid) GetColumns() int {
+	if g.Columns > 0 {
+		return g.Columns
+	}
+	if g.Rows > 0 {
+		return MaxInt(1, int(math.Ceil(float64(len(g.Charts))/float64(g.Rows))))
+	}
+	return MaxInt(1, int(math.Ceil(math.Sqrt(float64(len(g.Charts))))))
+}
+
+// GetRows returns the number of rows or the default value.
+func (g Grid) GetRows() int {
+	if g.Rows > 0 {
+		return g.Rows
+	}
+	return MaxInt(1, int(math.Ceil(float64(len(g.Charts))/float64(g.GetColumns()))))
+}
+
+// GetCellPadding returns the cell padding or the default value.
+func (g Grid) GetCellPadding() Box {
+	if g.CellPadding.IsZero() {
+		return DefaultGridCellPadding
+	}
+	return g.CellPadding
+}
+
+// Validate checks the grid can be rendered, and returns an error describing every problem it finds.
+func (g Grid) Validate() error {
+	var errs errorList
+	if g.Width < 0 || g.Height < 0 {
+		errs = append(errs, fmt.Errorf("grid; width and height must not be negative, got %dx%d", g.Width, g.Height))
+	}
+	if g.Columns < 0 || g.Rows < 0 {
+		errs = append(errs, fmt.Errorf("grid; columns and rows must not be negative, got %dx%d", g.Columns, g.Rows))
+	}
+	if len(g.Charts) == 0 {
+		errs = append(errs, errors.New("grid; please provide at least one chart"))
+	} else if cells := g.GetColumns() * g.GetRows(); cells < len(g.Charts) {
+		errs = append(errs, fmt.Errorf("grid; %d cells can't fit %d charts", cells, len(g.Charts)))
+	}
+	for index, c := range g.Charts {
+		if c == nil {
+			errs = append(errs, fmt.Errorf("grid; chart %d is nil", index))
+			continue
+		}
+		if err := c.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("grid; chart %d: %v", index, err))
+		}
+	}
+	return errs.errorOrNil()
+}
+
+// RenderImage renders the grid with a raster renderer provider, like PNG, and returns the image
+// without encoding it.
+func (g Grid) RenderImage(rp RendererProvider) (image.Image, error) {
+	return renderImage(rp, g.Render)
+}
+
+// Render renders the grid with the given renderer to the given io.Writer.
+// Every chart is drawn onto its cell of the one renderer.
+func (g Grid) Render(rp RendererProvider, w io.Writer) error {
+	if err := g.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(g.GetWidth(), g.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if g.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		g.Font = defaultFont
+	}
+	r.SetDPI(g.GetDPI(DefaultDPI))
+
+	Draw.Box(r, Box{Right: g.GetWidth(), Bottom: g.GetHeight()}, g.Background.InheritFrom(styleDefaultsCombinedBackground(g.GetTheme())))
+	top := g.drawTitle(r)
+
+	charts := g.getCharts()
+	for index, cell := range g.GetCells(top) {
+		if index == len(charts) {
+			break
+		}
+		c := charts[index]
+		c.Width, c.Height = cell.Width(), cell.Height()
+		vr := viewportRenderer{Renderer: r, viewport: cell, scope: fmt.Sprintf("chart-%d-", index)}
+		vr.clipToViewport()
+		err := c.renderTo(vr, w)
+		vr.EndClip()
+		if err != nil {
+			return fmt.Errorf("grid; chart %d: %v", index, err)
+		}
+	}
+
+	return r.Save(w)
+}
+
+// GetCells returns the boxes the charts are drawn in, row by row, below a given top; the title is above it.
+func (g Grid) GetCells(top int) []Box {
+	columns, rows := g.GetColumns(), g.GetRows()
+	width, height := g.GetWidth()/columns, (g.GetHeight()-top)/rows
+
+	cells := make([]Box, 0, columns*rows)
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			cells = append(cells, Box{
+				Top:    top + row*height,
+				Left:   column * width,
+				Right:  (column + 1) * width,
+				Bottom: top + (row+1)*height,
+			}.Inset(g.GetCellPadding()))
+		}
+	}
+	return cells
+}
+
+// getCharts returns copies of the charts to draw, with the shared x range set if it applies.
+func (g Grid) getCharts() []Chart {
+	charts := make([]Chart, len(g.Charts))
+	for index, c := range g.Charts {
+		charts[index] = *c
+		if charts[index].Font == nil {
+			charts[index].Font = g.Font
+		}
+		if charts[index].DPI == 0 {
+			charts[index].DPI = g.GetDPI()
+		}
+		if charts[index].Theme == nil {
+			charts[index].Theme = g.Theme
+		}
+	}
+	if !g.SharedXRange {
+		return charts
+	}
+
+	shared := func(c Chart) bool {
+		return c.XAxis.Range == nil && len(c.XAxis.Ticks) == 0
+	}
+	min, max := math.MaxFloat64, -math.MaxFloat64
+	for _, c := range charts {
+		if shared(c) {
+			xrange, _, _ := c.getRanges()
+			min, max = math.Min(min, xrange.GetMin()), math.Max(max, xrange.GetMax())
+		}
+	}
+	for index := range charts {
+		if shared(charts[index]) {
+			charts[index].XAxis.Range = &ContinuousRange{Min: min, Max: max}
+		}
+	}
+	return charts
+}
+
+// drawTitle draws the title, and returns the bottom of the space it takes up.
+func (g Grid) drawTitle(r Renderer) int {
+	if g.TitleStyle.Hidden {
+		return 0
+	}
+	return drawCenteredTitle(r, g.Title, g.TitleStyle.InheritFrom(styleDefaultsCombinedTitle(g.Font, g.GetTheme())), g.GetWidth())
+}
+
+// drawCenteredTitle draws the title of charts combined into one image, like a `Grid`, centered across the
+// top of it, and returns the bottom of the space it takes up. Each `\n` in the title starts a new line.
+func drawCenteredTitle(r Renderer, title string, style Style, width int) int {
+	if len(title) == 0 {
+		return 0
+	}
+	style.TextHorizontalAlign, style.TextVerticalAlign = TextHorizontalAlignCenter, TextVerticalAlignTop
+	Draw.TextAligned(r, title, width>>1, style.Padding.Top, style)
+	return style.Padding.Top + Text.MeasureLines(r, strings.Split(title, "\n"), style).Height() + style.Padding.Bottom
+}
+
+func styleDefaultsCombinedBackground(theme *Theme) Style {
+	return Style{
+		FillColor:   theme.GetBackgroundColor(),
+		StrokeColor: theme.GetBackgroundStrokeColor(),
+		StrokeWidth: DefaultBackgroundStrokeWidth,
+	}
+}
+
+func styleDefaultsCombinedTitle(font *truetype.Font, theme *Theme) Style {
+	return Style{
+		Font:      font,
+		FontColor: theme.GetTextColor(),
+		FontSize:  theme.GetTitleFontSize(),
+		Padding:   Box{Top: DefaultTitleTop, Bottom: DefaultTitleBottom},
+	}
+}
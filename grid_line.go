@@ -22,27 +22,39 @@ func (gl GridLine) Minor() bool {
 	return gl.IsMinor
 }
 
-// Render renders the gridline
+// Render renders the gridline.
+// Lines whose value translates outside the canvas box are skipped.
 func (gl GridLine) Render(r Renderer, canvasBox Box, ra Range, isVertical bool, defaults Style) {
+	if isVertical {
+		lineLeft := float64(canvasBox.Left) + translateFloat(ra, gl.Value)
+		if lineLeft < float64(canvasBox.Left) || lineLeft > float64(canvasBox.Right) {
+			return
+		}
+	} else {
+		lineHeight := float64(canvasBox.Bottom) - translateFloat(ra, gl.Value)
+		if lineHeight < float64(canvasBox.Top) || lineHeight > float64(canvasBox.Bottom) {
+			return
+		}
+	}
+
 	r.SetStrokeColor(gl.Style.GetStrokeColor(defaults.GetStrokeColor()))
 	r.SetStrokeWidth(gl.Style.GetStrokeWidth(defaults.GetStrokeWidth()))
 	r.SetStrokeDashArray(gl.Style.GetStrokeDashArray(defaults.GetStrokeDashArray()))
 
+	// grid lines are drawn between pixels like the series lines, so the lines meet them.
 	if isVertical {
-		lineLeft := canvasBox.Left + ra.Translate(gl.Value)
-		lineBottom := canvasBox.Bottom
-		lineTop := canvasBox.Top
+		lineLeft := float64(canvasBox.Left) + translateFloat(ra, gl.Value)
+		lineBottom := float64(canvasBox.Bottom)
+		lineTop := float64(canvasBox.Top)
 
-		r.MoveTo(lineLeft, lineBottom)
-		r.LineTo(lineLeft, lineTop)
+		Draw.path(r, []floatPoint{{X: lineLeft, Y: lineBottom}, {X: lineLeft, Y: lineTop}})
 		r.Stroke()
 	} else {
-		lineLeft := canvasBox.Left
-		lineRight := canvasBox.Right
-		lineHeight := canvasBox.Bottom - ra.Translate(gl.Value)
+		lineLeft := float64(canvasBox.Left)
+		lineRight := float64(canvasBox.Right)
+		lineHeight := float64(canvasBox.Bottom) - translateFloat(ra, gl.Value)
 
-		r.MoveTo(lineLeft, lineHeight)
-		r.LineTo(lineRight, lineHeight)
+		Draw.path(r, []floatPoint{{X: lineLeft, Y: lineHeight}, {X: lineRight, Y: lineHeight}})
 		r.Stroke()
 	}
 }
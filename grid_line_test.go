@@ -1,6 +1,8 @@
 package chart
 
 import (
+	"bytes"
+	"image/png"
 	"testing"
 
 	"github.com/blend/go-sdk/assert"
@@ -22,3 +24,38 @@ func TestGenerateGridLines(t *testing.T) {
 	assert.Equal(2.0, gl[0].Value)
 	assert.Equal(3.0, gl[1].Value)
 }
+
+func TestGridLineRenderClipsToCanvas(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+
+	canvasBox := NewBox(10, 10, 90, 90)
+	ra := &ContinuousRange{Min: 0, Max: 10, Domain: canvasBox.Width()}
+	style := Style{StrokeColor: ColorRed, StrokeWidth: 1}
+
+	GridLine{Value: 5}.Render(r, canvasBox, ra, true, style)
+	GridLine{Value: 20}.Render(r, canvasBox, ra, true, style)
+	GridLine{Value: -5}.Render(r, canvasBox, ra, false, style)
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+	img, err := png.Decode(buffer)
+	assert.Nil(err)
+
+	var inside, outside int
+	for x := 0; x < 100; x++ {
+		for y := 0; y < 100; y++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a > 0 {
+				if x >= canvasBox.Left && x <= canvasBox.Right && y >= canvasBox.Top && y <= canvasBox.Bottom {
+					inside++
+				} else {
+					outside++
+				}
+			}
+		}
+	}
+	assert.NotZero(inside)
+	assert.Zero(outside)
+}
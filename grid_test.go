@@ -0,0 +1,204 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func gridTestChart(min, max float64) *Chart {
+	return &Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: LinearRange(min, max),
+				YValues: LinearRange(min, max),
+			},
+		},
+	}
+}
+
+func TestGridLayout(t *testing.T) {
+	assert := assert.New(t)
+
+	charts := []*Chart{gridTestChart(0, 1), gridTestChart(0, 1), gridTestChart(0, 1), gridTestChart(0, 1), gridTestChart(0, 1)}
+	assert.Equal(3, Grid{Charts: charts}.GetColumns())
+	assert.Equal(2, Grid{Charts: charts}.GetRows())
+	assert.Equal(4, Grid{Charts: charts, Columns: 4}.GetColumns())
+	assert.Equal(2, Grid{Charts: charts, Columns: 4}.GetRows())
+	assert.Equal(5, Grid{Charts: charts, Rows: 1}.GetColumns())
+
+	g := Grid{Width: 400, Height: 220, Columns: 2, Rows: 2, CellPadding: BoxZero}
+	assert.Equal([]Box{
+		{Top: 20, Left: 0, Right: 200, Bottom: 120},
+		{Top: 20, Left: 200, Right: 400, Bottom: 120},
+		{Top: 120, Left: 0, Right: 200, Bottom: 220},
+		{Top: 120, Left: 200, Right: 400, Bottom: 220},
+	}, g.GetCells(20))
+
+	g.CellPadding = Box{}
+	assert.Equal(Box{Top: 5, Left: 5, Right: 195, Bottom: 105}, g.GetCells(0)[0])
+}
+
+func TestGridValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(Grid{}.Validate())
+	assert.NotNil(Grid{Charts: []*Chart{nil}}.Validate())
+	assert.NotNil(Grid{Charts: []*Chart{gridTestChart(0, 1), gridTestChart(0, 1)}, Columns: 1, Rows: 1}.Validate())
+	assert.NotNil(Grid{Charts: []*Chart{{}}}.Validate())
+	assert.Nil(Grid{Charts: []*Chart{gridTestChart(0, 1)}}.Validate())
+}
+
+func TestGridRender(t *testing.T) {
+	assert := assert.New(t)
+
+	g := Grid{
+		Title:       "Hosts",
+		Width:       800,
+		Height:      400,
+		Columns:     4,
+		CellPadding: BoxZero,
+	}
+	for index := 0; index < 8; index++ {
+		c := gridTestChart(0, float64(index+1))
+		c.Title = fmt.Sprintf("host-%d", index)
+		g.Charts = append(g.Charts, c)
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(g.Render(SVG, buffer))
+	svg := buffer.String()
+	assert.Equal(1, strings.Count(svg, "<svg"))
+	assert.Equal(1, strings.Count(svg, "</svg>"))
+	assert.Contains(svg, ">Hosts</text>")
+
+	// every chart draws its background across its cell, below the title.
+	font, err := GetDefaultFont()
+	assert.Nil(err)
+	g.Font = font
+	r, err := SVG(g.GetWidth(), g.GetHeight())
+	assert.Nil(err)
+	for index, cell := range g.GetCells(g.drawTitle(r)) {
+		assert.Contains(svg, fmt.Sprintf(">host-%d</text>", index))
+		assert.Contains(svg, fmt.Sprintf("M %d %d\nL %d %d\nL %d %d", cell.Left, cell.Top, cell.Right, cell.Top, cell.Right, cell.Bottom))
+	}
+
+	img, err := g.RenderImage(PNG)
+	assert.Nil(err)
+	assert.Equal(800, img.Bounds().Dx())
+	assert.Equal(400, img.Bounds().Dy())
+}
+
+func TestGridSharedXRange(t *testing.T) {
+	assert := assert.New(t)
+
+	g := Grid{Charts: []*Chart{gridTestChart(0, 10), gridTestChart(5, 20)}}
+	for _, c := range g.getCharts() {
+		assert.Nil(c.XAxis.Range)
+	}
+
+	g.SharedXRange = true
+	g.Charts = append(g.Charts, gridTestChart(100, 200))
+	g.Charts[2].XAxis.Range = &ContinuousRange{Min: 100, Max: 200}
+	charts := g.getCharts()
+	for _, c := range charts[:2] {
+		assert.Equal(0.0, c.XAxis.Range.GetMin())
+		assert.Equal(20.0, c.XAxis.Range.GetMax())
+	}
+	// a chart with a range of its own keeps it, and isn't part of the shared range.
+	assert.Equal(100.0, charts[2].XAxis.Range.GetMin())
+	assert.Nil(g.Charts[0].XAxis.Range)
+}
+
+func TestGridMultilineTitle(t *testing.T) {
+	assert := assert.New(t)
+
+	font, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := SVG(400, 300)
+	assert.Nil(err)
+
+	single := Grid{Font: font, Title: "Hosts"}.drawTitle(r)
+	double := Grid{Font: font, Title: "Hosts\nlast hour"}.drawTitle(r)
+	assert.True(double > single+DefaultLineSpacing)
+}
+
+func TestGridClipsChartsToCells(t *testing.T) {
+	assert := assert.New(t)
+
+	g := Grid{
+		Width:       400,
+		Height:      200,
+		Columns:     2,
+		CellPadding: Box{Top: 20, Left: 20, Right: 20, Bottom: 20},
+		Charts:      []*Chart{gridTestChart(0, 1), gridTestChart(0, 1)},
+	}
+	// the element runs well past the chart, over the padding around its cell and into the next one.
+	g.Charts[0].Elements = []Renderable{func(r Renderer, canvasBox Box, defaults Style) {
+		Draw.Box(r, NewBox(-100, -100, 300, 300), Style{FillColor: ColorRed})
+	}}
+
+	img, err := g.RenderImage(PNG)
+	assert.Nil(err)
+	cells := g.GetCells(0)
+	inCell := func(x, y int) bool {
+		for _, cell := range cells {
+			if x >= cell.Left && x < cell.Right && y >= cell.Top && y < cell.Bottom {
+				return true
+			}
+		}
+		return false
+	}
+	background := color.RGBAModel.Convert(DefaultBackgroundColor)
+	for x := 4; x < 396; x++ {
+		for y := 4; y < 196; y++ {
+			if !inCell(x, y) {
+				assert.Equal(background, img.At(x, y), fmt.Sprintf("%d,%d", x, y))
+			}
+		}
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(g.Render(SVG, buffer))
+	assert.Equal(2, strings.Count(buffer.String(), `clip-path="url(#clip-`))
+}
+
+func TestGridTheme(t *testing.T) {
+	assert := assert.New(t)
+
+	g := Grid{Title: "Hosts", Theme: ThemeDark, Charts: []*Chart{gridTestChart(0, 1), gridTestChart(0, 1)}}
+	g.Charts[1].Theme = &Theme{CanvasColor: ColorRed}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(g.Render(SVG, buffer))
+	svg := buffer.String()
+	assert.Contains(svg, "fill:#1e1e1e")
+	assert.Contains(svg, `style="stroke-width:0;stroke:none;fill:#e0e0e0;font-size:`)
+
+	// charts without a theme of their own use the grid's.
+	charts := g.getCharts()
+	assert.Equal(ThemeDark, charts[0].Theme)
+	assert.Equal(ColorRed, charts[1].Theme.CanvasColor)
+}
+
+func TestGridGroupIDs(t *testing.T) {
+	assert := assert.New(t)
+
+	g := Grid{Charts: []*Chart{gridTestChart(0, 1), gridTestChart(0, 1)}}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(g.Render(SVGWithClassPrefix("p-"), buffer))
+	svg := buffer.String()
+
+	// the ids are scoped to each chart, and the classes are shared.
+	assert.Equal(1, strings.Count(svg, `<g id="p-chart-0-series-0" class="p-series p-series-0">`))
+	assert.Equal(1, strings.Count(svg, `<g id="p-chart-1-series-0" class="p-series p-series-0">`))
+	assert.NotContains(svg, `id="p-series-0"`)
+	for _, match := range regexp.MustCompile(`id="([^"]+)"`).FindAllStringSubmatch(svg, -1) {
+		assert.Equal(1, strings.Count(svg, match[0]), match[1])
+	}
+}
@@ -0,0 +1,389 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// HeatmapChart is a chart that draws a grid of cells colored by their values.
+type HeatmapChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+	// Theme sets the background, text colors, font sizes and padding of the chart; it defaults to `GetDefaultTheme()`.
+	// The cells are colored by the color scale, not the theme. A ColorPalette takes precedence over the theme colors.
+	Theme *Theme
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+	CellStyle  Style
+	LabelStyle Style
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	// Values are the cell values, by row and then column. NaN values are drawn in the NoDataColor.
+	Values       [][]float64
+	RowLabels    []string
+	ColumnLabels []string
+
+	// LowColor and HighColor are the colors of the smallest and largest values.
+	// They are interpolated between unless a ColorProvider (e.g. Viridis) is set.
+	LowColor      drawing.Color
+	HighColor     drawing.Color
+	ColorProvider ColorProvider
+	NoDataColor   drawing.Color
+
+	// ShowValues draws the formatted value in each cell, in black or white depending on the cell color.
+	ShowValues     bool
+	ValueFormatter ValueFormatter
+
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (hc HeatmapChart) GetDPI(defaults ...float64) float64 {
+	if hc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return hc.DPI
+}
+
+// GetFont returns the text font.
+func (hc HeatmapChart) GetFont() *truetype.Font {
+	if hc.Font == nil {
+		return hc.defaultFont
+	}
+	return hc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (hc HeatmapChart) GetWidth() int {
+	if hc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return hc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (hc HeatmapChart) GetHeight() int {
+	if hc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return hc.Height
+}
+
+// GetColorProvider returns the color provider for the cells, interpolating from the low to the high color by default.
+func (hc HeatmapChart) GetColorProvider() ColorProvider {
+	if hc.ColorProvider != nil {
+		return hc.ColorProvider
+	}
+	low, high := hc.LowColor, hc.HighColor
+	if low.IsZero() {
+		low = ColorWhite
+	}
+	if high.IsZero() {
+		high = ColorBlue
+	}
+	return InterpolatedColorProvider(low, high)
+}
+
+// GetNoDataColor returns the color of cells without a value or the default.
+func (hc HeatmapChart) GetNoDataColor() drawing.Color {
+	if hc.NoDataColor.IsZero() {
+		return ColorLightGray
+	}
+	return hc.NoDataColor
+}
+
+// GetValueFormatter returns the cell value formatter or the default.
+func (hc HeatmapChart) GetValueFormatter() ValueFormatter {
+	if hc.ValueFormatter != nil {
+		return hc.ValueFormatter
+	}
+	return FloatValueFormatter
+}
+
+// GetTheme returns the chart theme or the default theme; nil means the package defaults.
+func (hc HeatmapChart) GetTheme() *Theme {
+	return getTheme(hc.Theme)
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (hc HeatmapChart) GetColorPalette() ColorPalette {
+	return getColorPalette(hc.ColorPalette, hc.GetTheme(), DefaultColorPalette)
+}
+
+// Validate validates the chart values.
+func (hc HeatmapChart) Validate() error {
+	if len(hc.Values) == 0 || len(hc.Values[0]) == 0 {
+		return errors.New("please provide at least one value")
+	}
+	columns := len(hc.Values[0])
+	for index, row := range hc.Values {
+		if len(row) != columns {
+			return fmt.Errorf("heatmap chart row %d has %d values, expected %d", index, len(row), columns)
+		}
+	}
+	if len(hc.RowLabels) > len(hc.Values) {
+		return fmt.Errorf("heatmap chart has more row labels than rows")
+	}
+	if len(hc.ColumnLabels) > columns {
+		return fmt.Errorf("heatmap chart has more column labels than columns")
+	}
+	return nil
+}
+
+// RenderImage renders the chart with a raster renderer provider, like PNG, and returns the image
+// without encoding it.
+func (hc HeatmapChart) RenderImage(rp RendererProvider) (image.Image, error) {
+	return renderImage(rp, hc.Render)
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (hc HeatmapChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := hc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(hc.GetWidth(), hc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if hc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		hc.defaultFont = defaultFont
+	}
+	r.SetDPI(hc.GetDPI(DefaultDPI))
+
+	canvasBox := hc.getAdjustedCanvasBox(r)
+
+	hc.drawBackground(r)
+	hc.drawCanvas(r, canvasBox)
+	hc.drawCells(r, canvasBox)
+	hc.drawLabels(r, canvasBox)
+	hc.drawTitle(r)
+	for _, a := range hc.Elements {
+		a(r, canvasBox, hc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+func (hc HeatmapChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  hc.GetWidth(),
+		Bottom: hc.GetHeight(),
+	}, hc.getBackgroundStyle())
+}
+
+func (hc HeatmapChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, hc.getCanvasStyle())
+}
+
+func (hc HeatmapChart) drawTitle(r Renderer) {
+	if len(hc.Title) > 0 && !hc.TitleStyle.Hidden {
+		Draw.TextWithin(r, hc.Title, hc.Box(), hc.styleDefaultsTitle())
+	}
+}
+
+func (hc HeatmapChart) drawCells(r Renderer, canvasBox Box) {
+	vmin, vmax := hc.getValueBounds()
+	colors := hc.GetColorProvider()
+	vf := hc.GetValueFormatter()
+
+	for row, values := range hc.Values {
+		for column, value := range values {
+			cell := hc.getCellBox(canvasBox, row, column)
+
+			fill := hc.GetNoDataColor()
+			if isFinite(value) {
+				fill = colors(value, vmin, vmax)
+			}
+			style := hc.CellStyle.InheritFrom(hc.styleDefaultsCell())
+			style.FillColor = fill
+			Draw.Box(r, cell, style)
+
+			if hc.ShowValues && isFinite(value) {
+				valueStyle := hc.LabelStyle.InheritFrom(hc.styleDefaultsLabels())
+				valueStyle.FontColor = hc.getContrastingTextColor(fill)
+				label := Text.Truncate(r, vf(value), cell.Width(), valueStyle)
+				tb := Draw.MeasureText(r, label, valueStyle)
+				cx, cy := cell.Center()
+				Draw.Text(r, label, cx-(tb.Width()>>1), cy+(tb.Height()>>1), valueStyle)
+			}
+		}
+	}
+}
+
+func (hc HeatmapChart) drawLabels(r Renderer, canvasBox Box) {
+	style := hc.LabelStyle.InheritFrom(hc.styleDefaultsLabels())
+	labelWidth := canvasBox.Left - hc.Box().Left - DefaultYAxisMargin
+
+	for row, label := range hc.RowLabels {
+		if len(label) == 0 {
+			continue
+		}
+		cell := hc.getCellBox(canvasBox, row, 0)
+		label = Text.Truncate(r, label, labelWidth, style)
+		tb := Draw.MeasureText(r, label, style)
+		_, cy := cell.Center()
+		Draw.Text(r, label, canvasBox.Left-DefaultYAxisMargin-tb.Width(), cy+(tb.Height()>>1), style)
+	}
+
+	for column, label := range hc.ColumnLabels {
+		if len(label) == 0 {
+			continue
+		}
+		cell := hc.getCellBox(canvasBox, 0, column)
+		label = Text.Truncate(r, label, cell.Width(), style)
+		tb := Draw.MeasureText(r, label, style)
+		cx, _ := cell.Center()
+		Draw.Text(r, label, cx-(tb.Width()>>1), canvasBox.Bottom+DefaultXAxisMargin+tb.Height(), style)
+	}
+}
+
+// getCellBox returns the box of the cell at the given row and column.
+func (hc HeatmapChart) getCellBox(canvasBox Box, row, column int) Box {
+	rows := len(hc.Values)
+	columns := len(hc.Values[0])
+	return Box{
+		Top:    canvasBox.Top + (row*canvasBox.Height())/rows,
+		Left:   canvasBox.Left + (column*canvasBox.Width())/columns,
+		Right:  canvasBox.Left + ((column+1)*canvasBox.Width())/columns,
+		Bottom: canvasBox.Top + ((row+1)*canvasBox.Height())/rows,
+	}
+}
+
+// getValueBounds returns the smallest and largest finite values.
+func (hc HeatmapChart) getValueBounds() (vmin, vmax float64) {
+	vmin, vmax = math.MaxFloat64, -math.MaxFloat64
+	for _, values := range hc.Values {
+		for _, value := range values {
+			if isFinite(value) {
+				vmin = math.Min(vmin, value)
+				vmax = math.Max(vmax, value)
+			}
+		}
+	}
+	if vmin > vmax {
+		return 0, 0
+	}
+	return
+}
+
+// getContrastingTextColor returns black or white, whichever reads better on the given color.
+func (hc HeatmapChart) getContrastingTextColor(background drawing.Color) drawing.Color {
+	if background.Luminance() > 0.5 {
+		return ColorBlack
+	}
+	return ColorWhite
+}
+
+// getAdjustedCanvasBox returns the cell grid box, leaving room for the title and the row and column labels.
+func (hc HeatmapChart) getAdjustedCanvasBox(r Renderer) Box {
+	canvasBox := hc.Box()
+
+	if len(hc.Title) > 0 && !hc.TitleStyle.Hidden {
+		tb := Draw.MeasureText(r, hc.Title, hc.styleDefaultsTitle())
+		canvasBox.Top = canvasBox.Top + tb.Height() + DefaultTitleTop
+	}
+
+	style := hc.LabelStyle.InheritFrom(hc.styleDefaultsLabels())
+	if len(hc.RowLabels) > 0 {
+		var labelWidth int
+		for _, label := range hc.RowLabels {
+			labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, label, style).Width())
+		}
+		labelWidth = MinInt(labelWidth, canvasBox.Width()/3)
+		canvasBox.Left = canvasBox.Left + labelWidth + DefaultYAxisMargin
+	}
+	if len(hc.ColumnLabels) > 0 {
+		var labelHeight int
+		for _, label := range hc.ColumnLabels {
+			labelHeight = MaxInt(labelHeight, Draw.MeasureText(r, label, style).Height())
+		}
+		canvasBox.Bottom = canvasBox.Bottom - (labelHeight + DefaultXAxisMargin)
+	}
+	return canvasBox
+}
+
+func (hc HeatmapChart) getBackgroundStyle() Style {
+	return hc.Background.InheritFrom(hc.styleDefaultsBackground())
+}
+
+func (hc HeatmapChart) getCanvasStyle() Style {
+	return hc.Canvas.InheritFrom(hc.styleDefaultsCanvas())
+}
+
+func (hc HeatmapChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   hc.GetColorPalette().BackgroundColor(),
+		StrokeColor: hc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (hc HeatmapChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   hc.GetColorPalette().CanvasColor(),
+		StrokeColor: hc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (hc HeatmapChart) styleDefaultsCell() Style {
+	return Style{
+		StrokeColor: hc.GetColorPalette().BackgroundColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+	}
+}
+
+func (hc HeatmapChart) styleDefaultsLabels() Style {
+	return Style{
+		Font:      hc.GetFont(),
+		FontSize:  hc.GetTheme().GetAxisFontSize(),
+		FontColor: hc.GetColorPalette().TextColor(),
+	}
+}
+
+func (hc HeatmapChart) styleDefaultsElements() Style {
+	return Style{
+		Font: hc.GetFont(),
+	}
+}
+
+func (hc HeatmapChart) styleDefaultsTitle() Style {
+	return hc.TitleStyle.InheritFrom(Style{
+		FontColor:           hc.GetColorPalette().TextColor(),
+		Font:                hc.GetFont(),
+		FontSize:            hc.GetTheme().GetTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+// Box returns the chart bounds as a box.
+func (hc HeatmapChart) Box() Box {
+	padding := hc.Background.InheritFrom(Style{Padding: hc.GetTheme().GetBackgroundPadding()}).Padding
+	return Box{Right: hc.GetWidth(), Bottom: hc.GetHeight()}.Inset(padding)
+}
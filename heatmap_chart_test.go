@@ -0,0 +1,107 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestHeatmapChartRender(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := HeatmapChart{
+		Title:        "Test Title",
+		ShowValues:   true,
+		RowLabels:    []string{"Mon", "Tue"},
+		ColumnLabels: []string{"00:00", "06:00", "12:00"},
+		Values: [][]float64{
+			{1, 2, 3},
+			{4, math.NaN(), 6},
+		},
+	}
+
+	buf := bytes.NewBuffer(nil)
+	assert.Nil(hc.Render(SVG, buf))
+	svg := buf.String()
+	assert.True(strings.Contains(svg, "Tue"))
+	assert.True(strings.Contains(svg, "12:00"))
+	assert.True(strings.Contains(svg, "6.00"))
+	assert.True(strings.Contains(svg, ColorLightGray.Hex()))
+
+	buf = bytes.NewBuffer(nil)
+	assert.Nil(hc.Render(PNG, buf))
+	assert.NotZero(buf.Len())
+}
+
+func TestHeatmapChartValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(HeatmapChart{}.Validate())
+	assert.NotNil(HeatmapChart{Values: [][]float64{{1, 2}, {3}}}.Validate())
+	assert.NotNil(HeatmapChart{Values: [][]float64{{1, 2}}, RowLabels: []string{"a", "b"}}.Validate())
+	assert.NotNil(HeatmapChart{Values: [][]float64{{1, 2}}, ColumnLabels: []string{"a", "b", "c"}}.Validate())
+	assert.Nil(HeatmapChart{Values: [][]float64{{1, 2}, {3, 4}}}.Validate())
+}
+
+func TestHeatmapChartGetValueBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	vmin, vmax := HeatmapChart{Values: [][]float64{{3, math.NaN()}, {-2, math.Inf(1)}}}.getValueBounds()
+	assert.Equal(-2.0, vmin)
+	assert.Equal(3.0, vmax)
+
+	vmin, vmax = HeatmapChart{Values: [][]float64{{math.NaN()}}}.getValueBounds()
+	assert.Zero(vmin)
+	assert.Zero(vmax)
+}
+
+func TestHeatmapChartGetColorProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := HeatmapChart{LowColor: ColorBlack, HighColor: ColorWhite}
+	colors := hc.GetColorProvider()
+	assert.Equal(ColorBlack, colors(0, 0, 10))
+	assert.Equal(ColorWhite, colors(10, 0, 10))
+	assert.Equal(ColorBlack.Interpolate(ColorWhite, 0.5), colors(5, 0, 10))
+
+	hc.ColorProvider = Viridis
+	assert.Equal(Viridis(5, 0, 10), hc.GetColorProvider()(5, 0, 10))
+}
+
+func TestHeatmapChartGetCellBox(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := HeatmapChart{Values: [][]float64{{1, 2, 3}, {4, 5, 6}}}
+	canvasBox := Box{Top: 10, Left: 10, Right: 310, Bottom: 110}
+	assert.Equal(Box{Top: 10, Left: 10, Right: 110, Bottom: 60}, hc.getCellBox(canvasBox, 0, 0))
+	assert.Equal(Box{Top: 60, Left: 210, Right: 310, Bottom: 110}, hc.getCellBox(canvasBox, 1, 2))
+}
+
+func TestHeatmapChartContrastingTextColor(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := HeatmapChart{}
+	assert.Equal(ColorBlack, hc.getContrastingTextColor(ColorWhite))
+	assert.Equal(ColorWhite, hc.getContrastingTextColor(ColorBlack))
+}
+
+func TestInterpolatedColorProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	colors := InterpolatedColorProvider(ColorRed, ColorWhite, ColorBlue)
+	assert.Equal(ColorRed, colors(-1, 0, 10))
+	assert.Equal(ColorWhite, colors(5, 0, 10))
+	assert.Equal(ColorBlue, colors(11, 0, 10))
+	assert.Equal(ColorRed.Interpolate(ColorWhite, 0.5), colors(2.5, 0, 10))
+	assert.Equal(ColorRed, colors(5, 5, 5))
+
+	// values that aren't between finite bounds are transparent, rather than out of range of the colors.
+	assert.Equal(drawing.ColorTransparent, colors(math.NaN(), 0, 1))
+	assert.Equal(drawing.ColorTransparent, colors(0.5, math.NaN(), 1))
+	assert.Equal(drawing.ColorTransparent, colors(0.5, 0, math.NaN()))
+	assert.Equal(drawing.ColorTransparent, colors(math.Inf(1), 0, 1))
+}
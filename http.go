@@ -0,0 +1,49 @@
+package chart
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// ChartRenderer is a chart that renders itself with a renderer provider, e.g. Chart, BarChart or PieChart.
+type ChartRenderer interface {
+	Render(rp RendererProvider, w io.Writer) error
+}
+
+// contentTypeProvider is a renderer that knows the mime type of what it saves.
+type contentTypeProvider interface {
+	contentType() string
+}
+
+// ServeChart renders a chart and writes it to an http response with the content type of the renderer.
+// The chart is rendered to a buffer first, so a failed render responds with a 500 and the error
+// rather than a partial image.
+func ServeChart(res http.ResponseWriter, c ChartRenderer, rp RendererProvider) {
+	var contentType string
+	provider := func(width, height int) (Renderer, error) {
+		r, err := rp(width, height)
+		if typed, isTyped := r.(contentTypeProvider); isTyped {
+			contentType = typed.contentType()
+		}
+		return r, err
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	if err := c.Render(provider, buffer); err != nil {
+		http.Error(res, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if contentType == "" {
+		contentType = http.DetectContentType(buffer.Bytes())
+	}
+	res.Header().Set("Content-Type", contentType)
+	res.Write(buffer.Bytes())
+}
+
+// ChartHandler returns an http.Handler that serves a chart with `ServeChart`.
+func ChartHandler(c ChartRenderer, rp RendererProvider) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, _ *http.Request) {
+		ServeChart(res, c, rp)
+	})
+}
@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestServeChart(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 1, 2, 3},
+				YValues: []float64{1, 3, 2, 4},
+			},
+		},
+	}
+
+	res := httptest.NewRecorder()
+	ServeChart(res, c, PNG)
+	assert.Equal(http.StatusOK, res.Code)
+	assert.Equal(ContentTypePNG, res.Header().Get("Content-Type"))
+	_, err := png.Decode(bytes.NewReader(res.Body.Bytes()))
+	assert.Nil(err)
+
+	res = httptest.NewRecorder()
+	ServeChart(res, PieChart{Values: []Value{{Value: 1}, {Value: 2}}}, SVG)
+	assert.Equal(http.StatusOK, res.Code)
+	assert.Equal(ContentTypeSVG, res.Header().Get("Content-Type"))
+	assert.True(strings.HasPrefix(res.Body.String(), "<svg"))
+}
+
+func TestServeChartError(t *testing.T) {
+	assert := assert.New(t)
+
+	// a chart without series fails to render and shouldn't send any image data.
+	res := httptest.NewRecorder()
+	ServeChart(res, Chart{}, PNG)
+	assert.Equal(http.StatusInternalServerError, res.Code)
+	assert.NotEqual(ContentTypePNG, res.Header().Get("Content-Type"))
+	assert.NotEmpty(res.Body.String())
+	assert.False(strings.HasPrefix(res.Body.String(), "\x89PNG"))
+}
+
+func TestChartHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := ChartHandler(BarChart{
+		Width:  300,
+		Height: 200,
+		Bars:   []Value{{Value: 1, Label: "a"}, {Value: 2, Label: "b"}},
+	}, SVG)
+
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(http.StatusOK, res.Code)
+	assert.Equal(ContentTypeSVG, res.Header().Get("Content-Type"))
+}
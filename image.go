@@ -0,0 +1,34 @@
+package chart
+
+import "image"
+
+// ImageElement is a raster image drawn onto the chart, e.g. a logo.
+type ImageElement struct {
+	Image image.Image
+	// Box is where the image is drawn, in chart coordinates, and it's scaled to fit.
+	// If it has no width or height, e.g. only its top and left are set, the image is drawn at its own size.
+	// Anything outside of the chart is clipped.
+	Box Box
+	// AboveSeries draws the image over the series rather than under them.
+	AboveSeries bool
+}
+
+// GetBox returns the box the image is drawn in.
+func (ie ImageElement) GetBox() Box {
+	box := ie.Box
+	if box.Right <= box.Left || box.Bottom <= box.Top {
+		box.Right = box.Left + ie.Image.Bounds().Dx()
+		box.Bottom = box.Top + ie.Image.Bounds().Dy()
+	}
+	return box
+}
+
+// Render draws the image, if the renderer can draw images; see `ImageRenderer`.
+func (ie ImageElement) Render(r Renderer) {
+	if ie.Image == nil || ie.Image.Bounds().Empty() {
+		return
+	}
+	if typed, isTyped := r.(ImageRenderer); isTyped {
+		typed.DrawImage(ie.Image, ie.GetBox())
+	}
+}
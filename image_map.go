@@ -0,0 +1,95 @@
+package chart
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+)
+
+// ImageMapOptions are the options of the html image map of a chart; see `Chart.RenderWithImageMap`.
+type ImageMapOptions struct {
+	// Name is the name of the map, for the `usemap` attribute of the image; it defaults to "chart".
+	Name string
+	// Radius is the radius of the area around each point; it defaults to `DefaultImageMapRadius`.
+	Radius int
+	// MaxAreas is the most areas a series has; series with more points get areas for every so many of them.
+	// It defaults to `DefaultImageMapMaxAreas`.
+	MaxAreas int
+	// Area returns the title and link of the area of a point of a series; points with neither have no area.
+	// By default the title is the formatted x and y values, and there's no link.
+	Area func(seriesIndex int, p PointLayout) (title, href string)
+}
+
+// GetName returns the name of the map or the default value.
+func (imo ImageMapOptions) GetName() string {
+	if imo.Name == "" {
+		return "chart"
+	}
+	return imo.Name
+}
+
+// GetRadius returns the radius of the areas or the default value.
+func (imo ImageMapOptions) GetRadius() int {
+	if imo.Radius == 0 {
+		return DefaultImageMapRadius
+	}
+	return imo.Radius
+}
+
+// GetMaxAreas returns the most areas of a series or the default value.
+func (imo ImageMapOptions) GetMaxAreas() int {
+	if imo.MaxAreas == 0 {
+		return DefaultImageMapMaxAreas
+	}
+	return imo.MaxAreas
+}
+
+// RenderWithImageMap renders the chart like `Render`, and writes an html `<map>` with a circle `<area>` for each
+// point drawn to another writer, so pages can show titles and follow links without scripts.
+// The areas are where the points are drawn, in the units of the chart width and height, so the image is shown
+// at the width and height of the chart whatever the scale factor.
+func (c Chart) RenderWithImageMap(rp RendererProvider, imageWriter, mapWriter io.Writer, opts ImageMapOptions) error {
+	layout, err := c.Measure(rp)
+	if err != nil {
+		return err
+	}
+	if err := c.Render(rp, imageWriter); err != nil {
+		return err
+	}
+
+	area := opts.Area
+	if area == nil {
+		area = func(seriesIndex int, p PointLayout) (string, string) {
+			xf, yf := FloatValueFormatter, FloatValueFormatter
+			if typed, isTyped := c.Series[seriesIndex].(ValueFormatterProvider); isTyped {
+				xf, yf = typed.GetValueFormatters()
+			}
+			return fmt.Sprintf("x: %s, y: %s", xf(p.XValue), yf(p.YValue)), ""
+		}
+	}
+
+	var areas []string
+	for seriesIndex, s := range layout.Series {
+		step := int(math.Ceil(float64(len(s.Points)) / float64(opts.GetMaxAreas())))
+		for pointIndex := 0; pointIndex < len(s.Points); pointIndex += MaxInt(step, 1) {
+			p := s.Points[pointIndex]
+			title, href := area(seriesIndex, p)
+			if title == "" && href == "" {
+				continue
+			}
+			attributes := fmt.Sprintf(`shape="circle" coords="%d,%d,%d"`, int(math.Round(p.X)), int(math.Round(p.Y)), opts.GetRadius())
+			if title != "" {
+				attributes += fmt.Sprintf(` title="%s" alt="%s"`, html.EscapeString(title), html.EscapeString(title))
+			}
+			if href != "" {
+				attributes += fmt.Sprintf(` href="%s"`, html.EscapeString(href))
+			}
+			areas = append(areas, fmt.Sprintf("<area %s>", attributes))
+		}
+	}
+
+	_, err = io.WriteString(mapWriter, fmt.Sprintf("<map name=\"%s\">\n%s\n</map>", html.EscapeString(opts.GetName()), strings.Join(areas, "\n")))
+	return err
+}
@@ -0,0 +1,58 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestChartRenderWithImageMap(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Width:  400,
+		Height: 300,
+		Series: []Series{
+			ContinuousSeries{Name: "a", XValues: []float64{1, 2, 3}, YValues: []float64{10, 20, 30}},
+			ContinuousSeries{Name: "b", XValues: LinearRange(1, 3000), YValues: LinearRange(1, 3000)},
+		},
+	}
+
+	imageBuffer, mapBuffer := bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+	assert.Nil(graph.RenderWithImageMap(PNG, imageBuffer, mapBuffer, ImageMapOptions{MaxAreas: 100}))
+	_, err := png.Decode(imageBuffer)
+	assert.Nil(err)
+
+	html := mapBuffer.String()
+	assert.True(strings.HasPrefix(html, `<map name="chart">`))
+	assert.True(strings.HasSuffix(html, `</map>`))
+	assert.Equal(3+100, strings.Count(html, "<area "))
+
+	// the areas are where the points are drawn.
+	layout, err := graph.Measure(PNG)
+	assert.Nil(err)
+	p := layout.Series[0].Points[1]
+	assert.Contains(html, fmt.Sprintf(`<area shape="circle" coords="%d,%d,5" title="x: 2.00, y: 20.00" alt="x: 2.00, y: 20.00">`, int(p.X+0.5), int(p.Y+0.5)))
+
+	mapBuffer.Reset()
+	assert.Nil(graph.RenderWithImageMap(PNG, imageBuffer, mapBuffer, ImageMapOptions{
+		Name:   "sales",
+		Radius: 3,
+		Area: func(seriesIndex int, p PointLayout) (string, string) {
+			if seriesIndex != 0 {
+				return "", ""
+			}
+			return "<" + graph.Series[seriesIndex].GetName() + ">", fmt.Sprintf("/points?x=%v&y=%v", p.XValue, p.YValue)
+		},
+	}))
+	html = mapBuffer.String()
+	assert.Equal(3, strings.Count(html, "<area "))
+	assert.Contains(html, `<map name="sales">`)
+	assert.Contains(html, `title="&lt;a&gt;" alt="&lt;a&gt;" href="/points?x=2&amp;y=20"`)
+
+	assert.NotNil(Chart{}.RenderWithImageMap(PNG, imageBuffer, mapBuffer, ImageMapOptions{}))
+}
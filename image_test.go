@@ -0,0 +1,114 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func imageTestLogo(c color.Color) image.Image {
+	logo := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 2; y++ {
+			logo.Set(x, y, c)
+		}
+	}
+	return logo
+}
+
+func imageTestChart(images ...ImageElement) Chart {
+	return Chart{
+		Width:  300,
+		Height: 200,
+		Images: images,
+		Series: []Series{
+			ContinuousSeries{XValues: LinearRange(0, 10), YValues: LinearRange(0, 10)},
+		},
+	}
+}
+
+func TestImageElementGetBox(t *testing.T) {
+	assert := assert.New(t)
+
+	logo := imageTestLogo(color.Black)
+	assert.Equal(Box{Top: 10, Left: 20, Right: 24, Bottom: 12}, ImageElement{Image: logo, Box: Box{Top: 10, Left: 20}}.GetBox())
+	assert.Equal(Box{Top: 10, Left: 20, Right: 60, Bottom: 30}, ImageElement{Image: logo, Box: Box{Top: 10, Left: 20, Right: 60, Bottom: 30}}.GetBox())
+}
+
+func TestImageElementSVG(t *testing.T) {
+	assert := assert.New(t)
+
+	logo := imageTestLogo(color.RGBA{R: 255, A: 255})
+	svg := renderFrameTestChart(t, imageTestChart(ImageElement{Image: logo, Box: Box{Top: 20, Left: 10, Right: 50, Bottom: 40}}))
+	match := regexp.MustCompile(`<image x="10" y="20" width="40" height="20" preserveAspectRatio="none" xlink:href="data:image/png;base64,([^"]+)"/>`).FindStringSubmatch(svg)
+	assert.Len(match, 2)
+
+	data, err := base64.StdEncoding.DecodeString(match[1])
+	assert.Nil(err)
+	embedded, err := png.Decode(bytes.NewReader(data))
+	assert.Nil(err)
+	assert.Equal(logo.Bounds(), embedded.Bounds())
+}
+
+func TestImageElementOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	logo := imageTestLogo(color.Black)
+	series := "stroke:" + DefaultColors[0].Hex()
+
+	svg := renderFrameTestChart(t, imageTestChart(ImageElement{Image: logo}))
+	assert.True(strings.Index(svg, "<image") < strings.Index(svg, series))
+
+	svg = renderFrameTestChart(t, imageTestChart(ImageElement{Image: logo, AboveSeries: true}))
+	assert.True(strings.Index(svg, "<image") > strings.Index(svg, series))
+}
+
+func TestImageElementRaster(t *testing.T) {
+	assert := assert.New(t)
+
+	red := color.RGBA{R: 255, A: 255}
+	translucent := color.NRGBA{B: 255, A: 128}
+	c := imageTestChart(
+		ImageElement{Image: imageTestLogo(red), Box: Box{Top: 100, Left: 100, Right: 140, Bottom: 120}, AboveSeries: true},
+		// out of bounds, it's clipped.
+		ImageElement{Image: imageTestLogo(translucent), Box: Box{Top: -10, Left: -20, Right: 20, Bottom: 10}, AboveSeries: true},
+	)
+	img, err := c.RenderImage(PNG)
+	assert.Nil(err)
+
+	// scaled to fill the box.
+	assert.Equal(color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(img.At(100, 100)))
+	assert.Equal(color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(img.At(139, 119)))
+	assert.NotEqual(color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(img.At(140, 120)))
+
+	// composited over the white background.
+	r, g, b, _ := img.At(0, 0).RGBA()
+	assert.True(r>>8 > 120 && r>>8 < 135)
+	assert.True(g>>8 > 120 && g>>8 < 135)
+	assert.Equal(uint32(255), b>>8)
+}
+
+func TestImageElementViewport(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := SVG(100, 100)
+	assert.Nil(err)
+	ImageElement{Image: imageTestLogo(color.Black), Box: Box{Top: 1, Left: 2}}.Render(viewportRenderer{Renderer: r, viewport: Box{Top: 10, Left: 20}})
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+	assert.Contains(buffer.String(), `<image x="22" y="11" width="4" height="2"`)
+}
+
+func TestImageElementValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(imageTestChart(ImageElement{}).Validate())
+	assert.Nil(imageTestChart(ImageElement{Image: imageTestLogo(color.Black)}).Validate())
+}
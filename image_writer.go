@@ -5,6 +5,7 @@ import (
 	"errors"
 	"image"
 	"image/png"
+	"io"
 )
 
 // RGBACollector is a render target for a chart.
@@ -40,3 +41,13 @@ func (ir *ImageWriter) Image() (image.Image, error) {
 	}
 	return nil, errors.New("no valid sources for image data, cannot continue")
 }
+
+// renderImage renders a chart with a raster renderer provider and returns the resulting image.
+// Each render draws to a new image, so the result stays valid after rendering.
+func renderImage(rp RendererProvider, render func(RendererProvider, io.Writer) error) (image.Image, error) {
+	collector := &ImageWriter{}
+	if err := render(rp, collector); err != nil {
+		return nil, err
+	}
+	return collector.Image()
+}
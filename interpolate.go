@@ -0,0 +1,68 @@
+package chart
+
+import "math"
+
+// interpolateMonotoneCubic returns the points of a monotone cubic (Fritsch-Carlson) spline
+// through the given points, flattened into line segments about a pixel apart.
+// The spline never overshoots neighboring points, so it stays within the min and max of the data.
+// Fewer than (3) points, or points whose x values aren't strictly increasing or decreasing,
+// are returned as is.
+func interpolateMonotoneCubic(points []floatPoint) []floatPoint {
+	n := len(points)
+	if n < 3 {
+		return points
+	}
+
+	dx := make([]float64, n-1)
+	slopes := make([]float64, n-1)
+	for i := 0; i < n-1; i++ {
+		dx[i] = points[i+1].X - points[i].X
+		if dx[i] == 0 || (i > 0 && (dx[i] > 0) != (dx[i-1] > 0)) {
+			return points
+		}
+		slopes[i] = (points[i+1].Y - points[i].Y) / dx[i]
+	}
+
+	tangents := make([]float64, n)
+	tangents[0] = slopes[0]
+	tangents[n-1] = slopes[n-2]
+	for i := 1; i < n-1; i++ {
+		if slopes[i-1]*slopes[i] > 0 {
+			tangents[i] = (slopes[i-1] + slopes[i]) / 2
+		}
+	}
+
+	for i := 0; i < n-1; i++ {
+		if slopes[i] == 0 {
+			tangents[i] = 0
+			tangents[i+1] = 0
+			continue
+		}
+		alpha := tangents[i] / slopes[i]
+		beta := tangents[i+1] / slopes[i]
+		if magnitude := alpha*alpha + beta*beta; magnitude > 9 {
+			tau := 3 / math.Sqrt(magnitude)
+			tangents[i] = tau * alpha * slopes[i]
+			tangents[i+1] = tau * beta * slopes[i]
+		}
+	}
+
+	output := []floatPoint{points[0]}
+	for i := 0; i < n-1; i++ {
+		x0, y0 := points[i].X, points[i].Y
+		y1 := points[i+1].Y
+		steps := MaxInt(1, int(math.Abs(dx[i])))
+		for step := 1; step < steps; step++ {
+			t := float64(step) / float64(steps)
+			t2, t3 := t*t, t*t*t
+			h10 := t3 - 2*t2 + t
+			h01 := -2*t3 + 3*t2
+			h11 := t3 - t2
+			// h00 is 1-h01; written this way a flat segment stays exactly flat.
+			y := y0 + h01*(y1-y0) + h10*dx[i]*tangents[i] + h11*dx[i]*tangents[i+1]
+			output = append(output, floatPoint{X: x0 + t*dx[i], Y: y})
+		}
+		output = append(output, points[i+1])
+	}
+	return output
+}
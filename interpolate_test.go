@@ -0,0 +1,41 @@
+package chart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestInterpolateMonotoneCubic(t *testing.T) {
+	assert := assert.New(t)
+
+	points := []floatPoint{{0, 100}, {10, 50}, {20, 50}, {30, 0}, {40, 90}}
+	output := interpolateMonotoneCubic(points)
+	assert.True(len(output) > len(points))
+	assert.Equal(points[0], output[0])
+	assert.Equal(points[len(points)-1], output[len(output)-1])
+
+	// every input point is on the curve, and the curve between two points stays between them.
+	cursor := 0
+	for i := 0; i < len(points)-1; i++ {
+		for output[cursor] != points[i] {
+			cursor++
+		}
+		lo, hi := math.Min(points[i].Y, points[i+1].Y), math.Max(points[i].Y, points[i+1].Y)
+		for output[cursor] != points[i+1] {
+			assert.True(output[cursor].Y >= lo && output[cursor].Y <= hi)
+			cursor++
+		}
+	}
+}
+
+func TestInterpolateMonotoneCubicFallback(t *testing.T) {
+	assert := assert.New(t)
+
+	short := []floatPoint{{0, 0}, {10, 10}}
+	assert.Equal(short, interpolateMonotoneCubic(short))
+
+	unordered := []floatPoint{{0, 0}, {10, 10}, {5, 20}}
+	assert.Equal(unordered, interpolateMonotoneCubic(unordered))
+}
@@ -3,6 +3,7 @@ package chart
 import "fmt"
 
 // LastValueAnnotationSeries returns an annotation series of just the last value of a value provider.
+// The label is drawn at the last point; see `ConnectedLastValueAnnotationSeries` for one next to the y axis.
 func LastValueAnnotationSeries(innerSeries ValuesProvider, vfs ...ValueFormatter) AnnotationSeries {
 	var vf ValueFormatter
 	if len(vfs) > 0 {
@@ -30,8 +31,39 @@ func LastValueAnnotationSeries(innerSeries ValuesProvider, vfs ...ValueFormatter
 	}
 
 	return AnnotationSeries{
-		Name:        seriesName,
-		Style:       seriesStyle,
-		Annotations: []Value2{lastValue},
+		Name:          seriesName,
+		Style:         seriesStyle,
+		Annotations:   []Value2{lastValue},
+		AllowOverflow: true,
+		lastValueOf:   &lastValueSource{series: innerSeries, formatter: vf},
 	}
 }
+
+// ConnectedLastValueAnnotationSeries returns an annotation series of just the last value of a value provider, with the label
+// at the right edge of the canvas, level with the last point, and a thin line from the point to the label. It keeps the label
+// next to the y axis connected to the line when the series ends before the edge of the canvas.
+// The line is the color of the label; set a StrokeDashArray on the `ConnectorStyle` for a dashed line.
+func ConnectedLastValueAnnotationSeries(innerSeries ValuesProvider, vfs ...ValueFormatter) AnnotationSeries {
+	as := LastValueAnnotationSeries(innerSeries, vfs...)
+	as.AlignRight = true
+	as.ShowConnectors = true
+	as.ConnectorStyle = Style{StrokeWidth: 1}
+	return as
+}
+
+// lastValueSource is the series a last value annotation labels, and how it formats the value.
+type lastValueSource struct {
+	series    ValuesProvider
+	formatter ValueFormatter
+}
+
+// within returns the last value with an x value within the given bounds and a finite y value.
+func (lvs lastValueSource) within(min, max float64) (Value2, bool) {
+	for index := lvs.series.Len() - 1; index >= 0; index-- {
+		x, y := lvs.series.GetValues(index)
+		if x >= min && x <= max && isFinite(y) {
+			return Value2{XValue: x, YValue: y, Label: lvs.formatter(y)}, true
+		}
+	}
+	return Value2{}, false
+}
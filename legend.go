@@ -1,17 +1,24 @@
 package chart
 
-import (
-	"github.com/wcharczuk/go-chart/drawing"
-)
-
 // Legend returns a legend renderable function.
+// The legend is drawn in the top left corner of the canvas.
 func Legend(c *Chart, userDefaults ...Style) Renderable {
+	return legend(c, false, userDefaults...)
+}
+
+// LegendRight returns a legend renderable function that draws
+// the legend in the top right corner of the canvas.
+func LegendRight(c *Chart, userDefaults ...Style) Renderable {
+	return legend(c, true, userDefaults...)
+}
+
+func legend(c *Chart, alignRight bool, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
 		legendDefaults := Style{
-			FillColor:   drawing.ColorWhite,
-			FontColor:   DefaultTextColor,
+			FillColor:   c.GetColorPalette().CanvasColor(),
+			FontColor:   c.GetColorPalette().TextColor(),
 			FontSize:    8.0,
-			StrokeColor: DefaultAxisColor,
+			StrokeColor: c.GetColorPalette().AxisStrokeColor(),
 			StrokeWidth: DefaultAxisLineWidth,
 		}
 
@@ -58,6 +65,12 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 
 		legendStyle.GetTextOptions().WriteToRenderer(r)
 
+		// labels too long for the canvas are truncated.
+		labelWidth := cb.Width() - (legendPadding.Left + legendPadding.Right + lineTextGap + lineLengthMinimum)
+		for x := range labels {
+			labels[x] = Text.Truncate(r, labels[x], labelWidth, legendStyle)
+		}
+
 		// measure
 		labelCount := 0
 		for x := 0; x < len(labels); x++ {
@@ -77,6 +90,12 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 		legend.Right = legendContent.Right + legendPadding.Right
 		legend.Bottom = legendContent.Bottom + legendPadding.Bottom
 
+		if alignRight {
+			dx := cb.Right - legend.Right
+			legend = legend.Shift(dx, 0)
+			legendContent = legendContent.Shift(dx, 0)
+		}
+
 		Draw.Box(r, legend, legendStyle)
 
 		legendStyle.GetTextOptions().WriteToRenderer(r)
@@ -122,10 +141,10 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
 		legendDefaults := Style{
-			FillColor:   drawing.ColorWhite,
-			FontColor:   DefaultTextColor,
+			FillColor:   c.GetColorPalette().CanvasColor(),
+			FontColor:   c.GetColorPalette().TextColor(),
 			FontSize:    8.0,
-			StrokeColor: DefaultAxisColor,
+			StrokeColor: c.GetColorPalette().AxisStrokeColor(),
 			StrokeWidth: DefaultAxisLineWidth,
 			Padding: Box{
 				Top:    2,
@@ -220,10 +239,10 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
 		legendDefaults := Style{
-			FillColor:   drawing.ColorWhite,
-			FontColor:   DefaultTextColor,
+			FillColor:   c.GetColorPalette().CanvasColor(),
+			FontColor:   c.GetColorPalette().TextColor(),
 			FontSize:    8.0,
-			StrokeColor: DefaultAxisColor,
+			StrokeColor: c.GetColorPalette().AxisStrokeColor(),
 			StrokeWidth: DefaultAxisLineWidth,
 		}
 
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/blend/go-sdk/assert"
+
+	"github.com/wcharczuk/go-chart/drawing"
 )
 
 func TestLegend(t *testing.T) {
@@ -29,3 +31,67 @@ func TestLegend(t *testing.T) {
 	assert.Nil(err)
 	assert.NotZero(buf.Len())
 }
+
+func TestLegendTruncatesLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Width: 200,
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "A series with a name far too long to fit in the legend of a small chart",
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+			},
+		},
+	}
+	graph.Elements = []Renderable{
+		Legend(&graph),
+	}
+	buf := bytes.NewBuffer([]byte{})
+	assert.Nil(graph.Render(SVG, buf))
+	assert.Contains(buf.String(), DefaultTextEllipsis+"</text>")
+	assert.NotContains(buf.String(), "small chart</text>")
+}
+
+func TestLegendRight(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Background: Style{FillColor: ColorWhite},
+		Canvas:     Style{FillColor: drawing.ColorBlue},
+		XAxis:      HideXAxis(),
+		YAxis:      HideYAxis(),
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "A test series",
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+			},
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{5.0, 4.0, 3.0, 2.0, 1.0},
+			},
+		},
+	}
+	graph.Elements = []Renderable{
+		LegendRight(&graph),
+	}
+
+	collector := &ImageWriter{}
+	assert.Nil(graph.Render(PNG, collector))
+	img, err := collector.Image()
+	assert.Nil(err)
+
+	// the legend box is white and sits against the right side of the canvas.
+	r, g, b, _ := img.At(graph.GetWidth()-DefaultBackgroundPadding.Right-10, DefaultBackgroundPadding.Top+3).RGBA()
+	assert.Equal(uint32(0xffff), r)
+	assert.Equal(uint32(0xffff), g)
+	assert.Equal(uint32(0xffff), b)
+
+	// the top left of the canvas is left uncovered.
+	r, g, b, _ = img.At(DefaultBackgroundPadding.Left+10, DefaultBackgroundPadding.Top+10).RGBA()
+	assert.Zero(r)
+	assert.Zero(g)
+	assert.Equal(uint32(0xffff), b)
+}
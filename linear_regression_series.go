@@ -2,6 +2,7 @@ package chart
 
 import (
 	"fmt"
+	"sync"
 )
 
 // Interface Assertions.
@@ -23,16 +24,34 @@ type LinearRegressionSeries struct {
 	Offset      int
 	InnerSeries ValuesProvider
 
-	m       float64
-	b       float64
-	avgx    float64
-	stddevx float64
+	lock     sync.Mutex
+	computed bool
+	window   linearRegressionWindow
+	m        float64
+	b        float64
+	avgx     float64
+	stddevx  float64
+}
+
+// linearRegressionWindow is what the fit of a linear regression series was computed for:
+// the offset and length of the window, and the length of the inner series.
+type linearRegressionWindow struct {
+	offset, length, innerLength int
 }
 
 // Coefficients returns the linear coefficients for the series.
-func (lrs LinearRegressionSeries) Coefficients() (m, b, stdev, avg float64) {
-	if lrs.IsZero() {
+// They're computed on first use, and again if the `Offset`, `Limit` or the length of the inner series change.
+// The values of the inner series are otherwise taken to be fixed once the series is used; call `ResetCoefficients`
+// after changing them in place.
+// The series is locked while they're computed, so charts rendered concurrently can share it.
+func (lrs *LinearRegressionSeries) Coefficients() (m, b, stdev, avg float64) {
+	window := lrs.getWindow()
+
+	lrs.lock.Lock()
+	defer lrs.lock.Unlock()
+	if !lrs.computed || lrs.window != window {
 		lrs.computeCoefficients()
+		lrs.computed, lrs.window = true, window
 	}
 
 	m = lrs.m
@@ -42,43 +61,60 @@ func (lrs LinearRegressionSeries) Coefficients() (m, b, stdev, avg float64) {
 	return
 }
 
+// ResetCoefficients forgets the coefficients computed, so they're computed again; e.g. after changing values in place.
+func (lrs *LinearRegressionSeries) ResetCoefficients() {
+	lrs.lock.Lock()
+	defer lrs.lock.Unlock()
+	lrs.computed = false
+}
+
+// Slope returns the slope of the fit line, in units of the inner series.
+func (lrs *LinearRegressionSeries) Slope() float64 {
+	m, _, stdev, _ := lrs.Coefficients()
+	return m / stdev
+}
+
+// Intercept returns the y value of the fit line where x is zero, in units of the inner series.
+func (lrs *LinearRegressionSeries) Intercept() float64 {
+	m, b, stdev, avg := lrs.Coefficients()
+	return b - (m*avg)/stdev
+}
+
 // GetName returns the name of the time series.
-func (lrs LinearRegressionSeries) GetName() string {
+func (lrs *LinearRegressionSeries) GetName() string {
 	return lrs.Name
 }
 
 // GetStyle returns the line style.
-func (lrs LinearRegressionSeries) GetStyle() Style {
+func (lrs *LinearRegressionSeries) GetStyle() Style {
 	return lrs.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (lrs LinearRegressionSeries) GetYAxis() YAxisType {
+func (lrs *LinearRegressionSeries) GetYAxis() YAxisType {
 	return lrs.YAxis
 }
 
 // Len returns the number of elements in the series.
-func (lrs LinearRegressionSeries) Len() int {
+func (lrs *LinearRegressionSeries) Len() int {
 	return MinInt(lrs.GetLimit(), lrs.InnerSeries.Len()-lrs.GetOffset())
 }
 
 // GetLimit returns the window size.
-func (lrs LinearRegressionSeries) GetLimit() int {
+func (lrs *LinearRegressionSeries) GetLimit() int {
 	if lrs.Limit == 0 {
 		return lrs.InnerSeries.Len()
 	}
 	return lrs.Limit
 }
 
-// GetEndIndex returns the effective limit end.
-func (lrs LinearRegressionSeries) GetEndIndex() int {
-	windowEnd := lrs.GetOffset() + lrs.GetLimit()
-	innerSeriesLastIndex := lrs.InnerSeries.Len() - 1
-	return MinInt(windowEnd, innerSeriesLastIndex)
+// GetEndIndex returns the index of the last inner series value in the window.
+func (lrs *LinearRegressionSeries) GetEndIndex() int {
+	return lrs.GetOffset() + lrs.Len() - 1
 }
 
 // GetOffset returns the data offset.
-func (lrs LinearRegressionSeries) GetOffset() int {
+func (lrs *LinearRegressionSeries) GetOffset() int {
 	if lrs.Offset == 0 {
 		return 0
 	}
@@ -90,13 +126,11 @@ func (lrs *LinearRegressionSeries) GetValues(index int) (x, y float64) {
 	if lrs.InnerSeries == nil || lrs.InnerSeries.Len() == 0 {
 		return
 	}
-	if lrs.IsZero() {
-		lrs.computeCoefficients()
-	}
+	m, b, stdev, avg := lrs.Coefficients()
 	offset := lrs.GetOffset()
-	effectiveIndex := MinInt(index+offset, lrs.InnerSeries.Len())
+	effectiveIndex := MinInt(index+offset, lrs.InnerSeries.Len()-1)
 	x, y = lrs.InnerSeries.GetValues(effectiveIndex)
-	y = (lrs.m * lrs.normalize(x)) + lrs.b
+	y = (m * ((x - avg) / stdev)) + b
 	return
 }
 
@@ -105,11 +139,9 @@ func (lrs *LinearRegressionSeries) GetFirstValues() (x, y float64) {
 	if lrs.InnerSeries == nil || lrs.InnerSeries.Len() == 0 {
 		return
 	}
-	if lrs.IsZero() {
-		lrs.computeCoefficients()
-	}
-	x, y = lrs.InnerSeries.GetValues(0)
-	y = (lrs.m * lrs.normalize(x)) + lrs.b
+	m, b, stdev, avg := lrs.Coefficients()
+	x, y = lrs.InnerSeries.GetValues(lrs.GetOffset())
+	y = (m * ((x - avg) / stdev)) + b
 	return
 }
 
@@ -118,12 +150,10 @@ func (lrs *LinearRegressionSeries) GetLastValues() (x, y float64) {
 	if lrs.InnerSeries == nil || lrs.InnerSeries.Len() == 0 {
 		return
 	}
-	if lrs.IsZero() {
-		lrs.computeCoefficients()
-	}
+	m, b, stdev, avg := lrs.Coefficients()
 	endIndex := lrs.GetEndIndex()
 	x, y = lrs.InnerSeries.GetValues(endIndex)
-	y = (lrs.m * lrs.normalize(x)) + lrs.b
+	y = (m * ((x - avg) / stdev)) + b
 	return
 }
 
@@ -138,12 +168,20 @@ func (lrs *LinearRegressionSeries) Validate() error {
 	if lrs.InnerSeries == nil {
 		return fmt.Errorf("linear regression series requires InnerSeries to be set")
 	}
+	if lrs.Len() < 2 {
+		return fmt.Errorf("linear regression series requires at least (2) values in the window")
+	}
+	if lrs.windowXValues().StdDev() == 0 {
+		return fmt.Errorf("linear regression series requires the x values in the window to vary")
+	}
 	return nil
 }
 
 // IsZero returns if we've computed the coefficients or not.
 func (lrs *LinearRegressionSeries) IsZero() bool {
-	return lrs.m == 0 && lrs.b == 0
+	lrs.lock.Lock()
+	defer lrs.lock.Unlock()
+	return !lrs.computed
 }
 
 //
@@ -154,24 +192,37 @@ func (lrs *LinearRegressionSeries) normalize(xvalue float64) float64 {
 	return (xvalue - lrs.avgx) / lrs.stddevx
 }
 
-// computeCoefficients computes the `m` and `b` terms in the linear formula given by `y = mx+b`.
-func (lrs *LinearRegressionSeries) computeCoefficients() {
-	startIndex := lrs.GetOffset()
-	endIndex := lrs.GetEndIndex()
-
-	p := float64(endIndex - startIndex)
+// getWindow returns what the fit is computed for, to tell if it needs computing again.
+func (lrs *LinearRegressionSeries) getWindow() (window linearRegressionWindow) {
+	if lrs.InnerSeries == nil {
+		return
+	}
+	window.offset, window.length, window.innerLength = lrs.GetOffset(), lrs.Len(), lrs.InnerSeries.Len()
+	return
+}
 
-	xvalues := NewValueBufferWithCapacity(lrs.Len())
-	for index := startIndex; index < endIndex; index++ {
+func (lrs *LinearRegressionSeries) windowXValues() Seq {
+	xvalues := NewValueBufferWithCapacity(MaxInt(lrs.Len(), 1))
+	for index := lrs.GetOffset(); index <= lrs.GetEndIndex(); index++ {
 		x, _ := lrs.InnerSeries.GetValues(index)
 		xvalues.Enqueue(x)
 	}
+	return Seq{xvalues}
+}
+
+// computeCoefficients computes the `m` and `b` terms in the linear formula given by `y = mx+b`; the series must be locked.
+func (lrs *LinearRegressionSeries) computeCoefficients() {
+	startIndex := lrs.GetOffset()
+	endIndex := lrs.GetEndIndex()
+
+	p := float64(lrs.Len())
 
-	lrs.avgx = Seq{xvalues}.Average()
-	lrs.stddevx = Seq{xvalues}.StdDev()
+	xvalues := lrs.windowXValues()
+	lrs.avgx = xvalues.Average()
+	lrs.stddevx = xvalues.StdDev()
 
 	var sumx, sumy, sumxx, sumxy float64
-	for index := startIndex; index < endIndex; index++ {
+	for index := startIndex; index <= endIndex; index++ {
 		x, y := lrs.InnerSeries.GetValues(index)
 
 		x = lrs.normalize(x)
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"bytes"
 	"testing"
 
 	assert "github.com/blend/go-sdk/assert"
@@ -72,6 +73,129 @@ func TestLinearRegressionSeriesWindowAndOffset(t *testing.T) {
 	assert.InDelta(90.0, lry0, 0.0000001)
 
 	lrxn, lryn := linRegSeries.GetLastValues()
-	assert.InDelta(80.0, lrxn, 0.0000001)
-	assert.InDelta(80.0, lryn, 0.0000001)
+	assert.InDelta(81.0, lrxn, 0.0000001)
+	assert.InDelta(81.0, lryn, 0.0000001)
+
+	lrxl, lryl := linRegSeries.GetValues(linRegSeries.Len() - 1)
+	assert.Equal(lrxn, lrxl)
+	assert.Equal(lryn, lryl)
+}
+
+func TestLinearRegressionSeriesSlopeIntercept(t *testing.T) {
+	assert := assert.New(t)
+
+	xvalues := LinearRange(1.0, 10.0)
+	yvalues := make([]float64, len(xvalues))
+	for index, x := range xvalues {
+		yvalues[index] = 2*x + 3
+	}
+
+	linRegSeries := LinearRegressionSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: xvalues,
+			YValues: yvalues,
+		},
+	}
+	assert.InDelta(2.0, linRegSeries.Slope(), 0.0000001)
+	assert.InDelta(3.0, linRegSeries.Intercept(), 0.0000001)
+
+	// fitting only the last two values still lands on the same line.
+	linRegSeries.Offset = 8
+	assert.Equal(2, linRegSeries.Len())
+	assert.InDelta(2.0, linRegSeries.Slope(), 0.0000001)
+	assert.InDelta(3.0, linRegSeries.Intercept(), 0.0000001)
+
+	// a flat line through the origin is computed once, like any other.
+	flat := &LinearRegressionSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: xvalues,
+			YValues: make([]float64, len(xvalues)),
+		},
+	}
+	assert.True(flat.IsZero())
+	assert.Zero(flat.Slope())
+	assert.Zero(flat.Intercept())
+	assert.False(flat.IsZero())
+}
+
+func TestLinearRegressionSeriesRecomputes(t *testing.T) {
+	assert := assert.New(t)
+
+	// y = x for the first five values, and y = 10 - x after.
+	xvalues := LinearRange(0, 9)
+	yvalues := make([]float64, len(xvalues))
+	for index, x := range xvalues {
+		if x < 5 {
+			yvalues[index] = x
+		} else {
+			yvalues[index] = 10 - x
+		}
+	}
+	lrs := &LinearRegressionSeries{
+		InnerSeries: ContinuousSeries{XValues: xvalues, YValues: yvalues},
+		Limit:       5,
+	}
+	c := Chart{Series: []Series{lrs}}
+
+	first := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, first))
+	assert.InDelta(1.0, lrs.Slope(), 0.0000001)
+
+	// the fit follows the window when it changes between renders.
+	lrs.Limit = 0
+	second := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, second))
+	assert.NotEqual(first.String(), second.String())
+	all := &LinearRegressionSeries{InnerSeries: lrs.InnerSeries}
+	assert.InDelta(all.Slope(), lrs.Slope(), 0.0000001)
+
+	lrs.Offset, lrs.Limit = 5, 5
+	assert.InDelta(-1.0, lrs.Slope(), 0.0000001)
+
+	// as does a line through it.
+	ls := &LinearSeries{XValues: xvalues, InnerSeries: lrs}
+	_, y := ls.GetValues(9)
+	assert.InDelta(1.0, y, 0.0000001)
+	lrs.Offset, lrs.Limit = 0, 5
+	_, y = ls.GetValues(9)
+	assert.InDelta(9.0, y, 0.0000001)
+
+	// values changed in place need the coefficients reset.
+	for index := range yvalues {
+		yvalues[index] = 2 * xvalues[index]
+	}
+	assert.InDelta(1.0, lrs.Slope(), 0.0000001)
+	lrs.ResetCoefficients()
+	assert.InDelta(2.0, lrs.Slope(), 0.0000001)
+}
+
+func TestLinearRegressionSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil((&LinearRegressionSeries{}).Validate())
+
+	vertical := &LinearRegressionSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{1, 1, 1},
+			YValues: []float64{1, 2, 3},
+		},
+	}
+	assert.NotNil(vertical.Validate())
+
+	single := &LinearRegressionSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{1, 2, 3},
+			YValues: []float64{1, 2, 3},
+		},
+		Offset: 2,
+	}
+	assert.NotNil(single.Validate())
+
+	single.Offset = 1
+	assert.Nil(single.Validate())
+
+	c := Chart{
+		Series: []Series{vertical.InnerSeries.(ContinuousSeries), vertical},
+	}
+	assert.NotNil(c.validateSeries())
 }
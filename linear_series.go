@@ -2,6 +2,7 @@ package chart
 
 import (
 	"fmt"
+	"sync"
 )
 
 // Interface Assertions.
@@ -20,6 +21,7 @@ type LinearSeries struct {
 	XValues     []float64
 	InnerSeries LinearCoefficientProvider
 
+	lock  sync.Mutex
 	m     float64
 	b     float64
 	stdev float64
@@ -27,27 +29,27 @@ type LinearSeries struct {
 }
 
 // GetName returns the name of the time series.
-func (ls LinearSeries) GetName() string {
+func (ls *LinearSeries) GetName() string {
 	return ls.Name
 }
 
 // GetStyle returns the line style.
-func (ls LinearSeries) GetStyle() Style {
+func (ls *LinearSeries) GetStyle() Style {
 	return ls.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (ls LinearSeries) GetYAxis() YAxisType {
+func (ls *LinearSeries) GetYAxis() YAxisType {
 	return ls.YAxis
 }
 
 // Len returns the number of elements in the series.
-func (ls LinearSeries) Len() int {
+func (ls *LinearSeries) Len() int {
 	return len(ls.XValues)
 }
 
 // GetEndIndex returns the effective limit end.
-func (ls LinearSeries) GetEndIndex() int {
+func (ls *LinearSeries) GetEndIndex() int {
 	return len(ls.XValues) - 1
 }
 
@@ -56,11 +58,9 @@ func (ls *LinearSeries) GetValues(index int) (x, y float64) {
 	if ls.InnerSeries == nil || len(ls.XValues) == 0 {
 		return
 	}
-	if ls.IsZero() {
-		ls.computeCoefficients()
-	}
+	m, b, stdev, avg := ls.getCoefficients()
 	x = ls.XValues[index]
-	y = (ls.m * ls.normalize(x)) + ls.b
+	y = (m * ls.normalize(x, stdev, avg)) + b
 	return
 }
 
@@ -69,9 +69,6 @@ func (ls *LinearSeries) GetFirstValues() (x, y float64) {
 	if ls.InnerSeries == nil || len(ls.XValues) == 0 {
 		return
 	}
-	if ls.IsZero() {
-		ls.computeCoefficients()
-	}
 	x, y = ls.GetValues(0)
 	return
 }
@@ -81,9 +78,6 @@ func (ls *LinearSeries) GetLastValues() (x, y float64) {
 	if ls.InnerSeries == nil || len(ls.XValues) == 0 {
 		return
 	}
-	if ls.IsZero() {
-		ls.computeCoefficients()
-	}
 	x, y = ls.GetValues(ls.GetEndIndex())
 	return
 }
@@ -94,7 +88,7 @@ func (ls *LinearSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range,
 }
 
 // Validate validates the series.
-func (ls LinearSeries) Validate() error {
+func (ls *LinearSeries) Validate() error {
 	if ls.InnerSeries == nil {
 		return fmt.Errorf("linear regression series requires InnerSeries to be set")
 	}
@@ -102,18 +96,26 @@ func (ls LinearSeries) Validate() error {
 }
 
 // IsZero returns if the linear series has computed coefficients or not.
-func (ls LinearSeries) IsZero() bool {
+func (ls *LinearSeries) IsZero() bool {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
 	return ls.m == 0 && ls.b == 0
 }
 
-// computeCoefficients computes the `m` and `b` terms in the linear formula given by `y = mx+b`.
-func (ls *LinearSeries) computeCoefficients() {
-	ls.m, ls.b, ls.stdev, ls.avg = ls.InnerSeries.Coefficients()
+// getCoefficients returns the `m` and `b` terms in the linear formula given by `y = mx+b` of the inner series.
+// They're asked for every time, so they follow the inner series if its fit changes, and kept for `IsZero`.
+func (ls *LinearSeries) getCoefficients() (m, b, stdev, avg float64) {
+	m, b, stdev, avg = ls.InnerSeries.Coefficients()
+
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	ls.m, ls.b, ls.stdev, ls.avg = m, b, stdev, avg
+	return
 }
 
-func (ls *LinearSeries) normalize(xvalue float64) float64 {
-	if ls.avg > 0 && ls.stdev > 0 {
-		return (xvalue - ls.avg) / ls.stdev
+func (ls *LinearSeries) normalize(xvalue, stdev, avg float64) float64 {
+	if avg > 0 && stdev > 0 {
+		return (xvalue - avg) / stdev
 	}
 	return xvalue
 }
@@ -0,0 +1,181 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Range           = (*LogarithmicRange)(nil)
+	_ FloatTranslator = (*LogarithmicRange)(nil)
+	_ TicksProvider   = (*LogarithmicRange)(nil)
+)
+
+// LogarithmicRange represents a boundary for a set of numbers mapped on a base 10 log scale.
+// The min must be positive; values that are zero or negative are clamped to the min.
+type LogarithmicRange struct {
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+}
+
+// IsDescending returns if the range is descending.
+func (r LogarithmicRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the LogarithmicRange has been set or not.
+func (r LogarithmicRange) IsZero() bool {
+	return (r.Min == 0 || math.IsNaN(r.Min)) &&
+		(r.Max == 0 || math.IsNaN(r.Max)) &&
+		r.Domain == 0
+}
+
+// IsDegenerate returns if the range has no delta, i.e. min and max are equal.
+// Values translate to the middle of the domain of a degenerate range.
+func (r LogarithmicRange) IsDegenerate() bool {
+	return r.Min == r.Max
+}
+
+// GetMin gets the min value for the logarithmic range.
+func (r LogarithmicRange) GetMin() float64 {
+	return r.Min
+}
+
+// SetMin sets the min value for the logarithmic range.
+func (r *LogarithmicRange) SetMin(min float64) {
+	r.Min = min
+}
+
+// GetMax returns the max value for the logarithmic range.
+func (r LogarithmicRange) GetMax() float64 {
+	return r.Max
+}
+
+// SetMax sets the max value for the logarithmic range.
+func (r *LogarithmicRange) SetMax(max float64) {
+	r.Max = max
+}
+
+// GetDelta returns the difference between the min and max value.
+func (r LogarithmicRange) GetDelta() float64 {
+	return r.Max - r.Min
+}
+
+// GetDomain returns the range domain.
+func (r LogarithmicRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *LogarithmicRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// String returns a simple string for the LogarithmicRange.
+func (r LogarithmicRange) String() string {
+	if r.GetDelta() == 0 {
+		return "LogarithmicRange [empty]"
+	}
+	return fmt.Sprintf("LogarithmicRange [%.2f,%.2f] => %d", r.Min, r.Max, r.Domain)
+}
+
+// Validate validates the range.
+func (r LogarithmicRange) Validate() error {
+	if r.Min <= 0 {
+		return fmt.Errorf("logarithmic range requires a positive min, got %v", r.Min)
+	}
+	return nil
+}
+
+// Round rounds the range out to the nearest powers of 10.
+func (r *LogarithmicRange) Round() {
+	if r.Min > 0 {
+		r.Min = math.Pow(10, math.Floor(math.Log10(r.Min)))
+	}
+	if r.Max > 0 {
+		r.Max = math.Pow(10, math.Ceil(math.Log10(r.Max)))
+	}
+}
+
+// Translate maps a given value into the LogarithmicRange space.
+func (r LogarithmicRange) Translate(value float64) int {
+	if r.IsDegenerate() {
+		return r.Domain / 2
+	}
+	if value <= 0 {
+		value = r.Min
+	}
+
+	logMin := math.Log10(r.Min)
+	ratio := (math.Log10(value) - logMin) / (math.Log10(r.Max) - logMin)
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// TranslateFloat maps a given value into the LogarithmicRange space without rounding to a whole pixel.
+func (r LogarithmicRange) TranslateFloat(value float64) float64 {
+	if r.IsDegenerate() {
+		return float64(r.Domain) / 2
+	}
+	if value <= 0 {
+		value = r.Min
+	}
+
+	logMin := math.Log10(r.Min)
+	ratio := (math.Log10(value) - logMin) / (math.Log10(r.Max) - logMin)
+	if r.IsDescending() {
+		return float64(r.Domain) * (1 - ratio)
+	}
+
+	return ratio * float64(r.Domain)
+}
+
+// GetTicks returns the ticks for the range.
+// Ticks land on powers of 10, with 2 and 5 subdivisions if the range spans two decades or less.
+func (r LogarithmicRange) GetTicks(_ Renderer, _ Style, vf ValueFormatter) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	if r.Min <= 0 || r.Max <= r.Min {
+		return nil
+	}
+
+	minExponent := int(math.Floor(math.Log10(r.Min)))
+	maxExponent := int(math.Ceil(math.Log10(r.Max)))
+
+	multipliers := []float64{1}
+	if maxExponent-minExponent <= 2 {
+		multipliers = []float64{1, 2, 5}
+	}
+
+	values := []float64{r.Min}
+	for exponent := minExponent; exponent <= maxExponent; exponent++ {
+		for _, multiplier := range multipliers {
+			value := multiplier * math.Pow(10, float64(exponent))
+			if value > r.Min && value < r.Max {
+				values = append(values, value)
+			}
+		}
+	}
+	values = append(values, r.Max)
+
+	ticks := make([]Tick, len(values))
+	for index := range values {
+		value := values[index]
+		if r.IsDescending() {
+			value = values[len(values)-1-index]
+		}
+		ticks[index] = Tick{
+			Value: value,
+			Label: vf(value),
+		}
+	}
+	return ticks
+}
@@ -0,0 +1,95 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestLogarithmicRangeTranslate(t *testing.T) {
+	assert := assert.New(t)
+
+	r := LogarithmicRange{Min: 1, Max: 1000, Domain: 300}
+	assert.Equal(0, r.Translate(1))
+	assert.Equal(100, r.Translate(10))
+	assert.Equal(200, r.Translate(100))
+	assert.Equal(300, r.Translate(1000))
+
+	// non-positive values are clamped to the min.
+	assert.Equal(0, r.Translate(0))
+	assert.Equal(0, r.Translate(-10))
+
+	r.Descending = true
+	assert.Equal(300, r.Translate(1))
+	assert.Equal(0, r.Translate(1000))
+}
+
+func TestLogarithmicRangeRound(t *testing.T) {
+	assert := assert.New(t)
+
+	r := LogarithmicRange{Min: 0.0013, Max: 8700}
+	r.Round()
+	assert.InDelta(0.001, r.Min, 0.0000001)
+	assert.InDelta(10000, r.Max, 0.0000001)
+}
+
+func TestLogarithmicRangeValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(LogarithmicRange{Min: 1, Max: 10}.Validate())
+	assert.NotNil(LogarithmicRange{Min: 0, Max: 10}.Validate())
+	assert.NotNil(LogarithmicRange{Min: -1, Max: 10}.Validate())
+}
+
+func TestLogarithmicRangeGetTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	r := LogarithmicRange{Min: 1, Max: 10000, Domain: 400}
+	ticks := r.GetTicks(nil, Style{}, FloatValueFormatter)
+	assert.Len(ticks, 5)
+	assert.Equal(1.0, ticks[0].Value)
+	assert.Equal(10.0, ticks[1].Value)
+	assert.Equal(10000.0, ticks[4].Value)
+
+	// two decades or less gets 2 and 5 subdivisions.
+	r = LogarithmicRange{Min: 1, Max: 100, Domain: 400}
+	ticks = r.GetTicks(nil, Style{}, FloatValueFormatter)
+	var values []float64
+	for _, t := range ticks {
+		values = append(values, t.Value)
+	}
+	assert.Equal([]float64{1, 2, 5, 10, 20, 50, 100}, values)
+}
+
+func TestChartLogarithmicYAxis(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		YAxis: YAxis{
+			Range: &LogarithmicRange{},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3, 4, 5},
+				YValues: []float64{0.0013, 0.04, 2.5, 130, 8700},
+			},
+		},
+	}
+
+	_, yr, _ := c.getRanges()
+	assert.InDelta(0.001, yr.GetMin(), 0.0000001)
+	assert.InDelta(10000, yr.GetMax(), 0.0000001)
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+
+	c.Series = []Series{
+		ContinuousSeries{
+			XValues: []float64{1, 2, 3},
+			YValues: []float64{0, 10, 100},
+		},
+	}
+	c.YAxis.Range = &LogarithmicRange{}
+	assert.NotNil(c.Render(PNG, bytes.NewBuffer(nil)))
+}
@@ -1,6 +1,9 @@
 package chart
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 const (
 	// DefaultMACDPeriodPrimary is the long window.
@@ -23,21 +26,26 @@ type MACDSeries struct {
 	SecondaryPeriod int
 	SignalPeriod    int
 
+	lock   sync.Mutex
 	signal *MACDSignalSeries
 	macdl  *MACDLineSeries
 }
 
 // Validate validates the series.
-func (macd MACDSeries) Validate() error {
+func (macd *MACDSeries) Validate() error {
+	macd.lock.Lock()
+	signal, macdl := macd.signal, macd.macdl
+	macd.lock.Unlock()
+
 	var err error
-	if macd.signal != nil {
-		err = macd.signal.Validate()
+	if signal != nil {
+		err = signal.Validate()
 	}
 	if err != nil {
 		return err
 	}
-	if macd.macdl != nil {
-		err = macd.macdl.Validate()
+	if macdl != nil {
+		err = macdl.Validate()
 	}
 	if err != nil {
 		return err
@@ -46,7 +54,7 @@ func (macd MACDSeries) Validate() error {
 }
 
 // GetPeriods returns the primary and secondary periods.
-func (macd MACDSeries) GetPeriods() (w1, w2, sig int) {
+func (macd *MACDSeries) GetPeriods() (w1, w2, sig int) {
 	if macd.PrimaryPeriod == 0 {
 		w1 = DefaultMACDPeriodPrimary
 	} else {
@@ -66,22 +74,22 @@ func (macd MACDSeries) GetPeriods() (w1, w2, sig int) {
 }
 
 // GetName returns the name of the time series.
-func (macd MACDSeries) GetName() string {
+func (macd *MACDSeries) GetName() string {
 	return macd.Name
 }
 
 // GetStyle returns the line style.
-func (macd MACDSeries) GetStyle() Style {
+func (macd *MACDSeries) GetStyle() Style {
 	return macd.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (macd MACDSeries) GetYAxis() YAxisType {
+func (macd *MACDSeries) GetYAxis() YAxisType {
 	return macd.YAxis
 }
 
 // Len returns the number of elements in the series.
-func (macd MACDSeries) Len() int {
+func (macd *MACDSeries) Len() int {
 	if macd.InnerSeries == nil {
 		return 0
 	}
@@ -95,12 +103,10 @@ func (macd *MACDSeries) GetValues(index int) (x float64, y float64) {
 		return
 	}
 
-	if macd.signal == nil || macd.macdl == nil {
-		macd.ensureChildSeries()
-	}
+	signal, macdl := macd.getChildSeries()
 
-	_, lv := macd.macdl.GetValues(index)
-	_, sv := macd.signal.GetValues(index)
+	_, lv := macdl.GetValues(index)
+	_, sv := signal.GetValues(index)
 
 	x, _ = macd.InnerSeries.GetValues(index)
 	y = lv - sv
@@ -108,7 +114,14 @@ func (macd *MACDSeries) GetValues(index int) (x float64, y float64) {
 	return
 }
 
-func (macd *MACDSeries) ensureChildSeries() {
+// getChildSeries returns the signal and MACD line series, made on first use with the series locked,
+// as charts rendered concurrently can share it.
+func (macd *MACDSeries) getChildSeries() (*MACDSignalSeries, *MACDLineSeries) {
+	macd.lock.Lock()
+	defer macd.lock.Unlock()
+	if macd.signal != nil && macd.macdl != nil {
+		return macd.signal, macd.macdl
+	}
 	w1, w2, sig := macd.GetPeriods()
 
 	macd.signal = &MACDSignalSeries{
@@ -123,6 +136,7 @@ func (macd *MACDSeries) ensureChildSeries() {
 		PrimaryPeriod:   w1,
 		SecondaryPeriod: w2,
 	}
+	return macd.signal, macd.macdl
 }
 
 // MACDSignalSeries computes the EMA of the MACDLineSeries.
@@ -136,19 +150,24 @@ type MACDSignalSeries struct {
 	SecondaryPeriod int
 	SignalPeriod    int
 
+	lock   sync.Mutex
 	signal *EMASeries
 }
 
 // Validate validates the series.
-func (macds MACDSignalSeries) Validate() error {
-	if macds.signal != nil {
-		return macds.signal.Validate()
+func (macds *MACDSignalSeries) Validate() error {
+	macds.lock.Lock()
+	signal := macds.signal
+	macds.lock.Unlock()
+
+	if signal != nil {
+		return signal.Validate()
 	}
 	return nil
 }
 
 // GetPeriods returns the primary and secondary periods.
-func (macds MACDSignalSeries) GetPeriods() (w1, w2, sig int) {
+func (macds *MACDSignalSeries) GetPeriods() (w1, w2, sig int) {
 	if macds.PrimaryPeriod == 0 {
 		w1 = DefaultMACDPeriodPrimary
 	} else {
@@ -168,17 +187,17 @@ func (macds MACDSignalSeries) GetPeriods() (w1, w2, sig int) {
 }
 
 // GetName returns the name of the time series.
-func (macds MACDSignalSeries) GetName() string {
+func (macds *MACDSignalSeries) GetName() string {
 	return macds.Name
 }
 
 // GetStyle returns the line style.
-func (macds MACDSignalSeries) GetStyle() Style {
+func (macds *MACDSignalSeries) GetStyle() Style {
 	return macds.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (macds MACDSignalSeries) GetYAxis() YAxisType {
+func (macds *MACDSignalSeries) GetYAxis() YAxisType {
 	return macds.YAxis
 }
 
@@ -197,15 +216,18 @@ func (macds *MACDSignalSeries) GetValues(index int) (x float64, y float64) {
 		return
 	}
 
-	if macds.signal == nil {
-		macds.ensureSignal()
-	}
 	x, _ = macds.InnerSeries.GetValues(index)
-	_, y = macds.signal.GetValues(index)
+	_, y = macds.getSignal().GetValues(index)
 	return
 }
 
-func (macds *MACDSignalSeries) ensureSignal() {
+// getSignal returns the EMA of the MACD line, made on first use with the series locked.
+func (macds *MACDSignalSeries) getSignal() *EMASeries {
+	macds.lock.Lock()
+	defer macds.lock.Unlock()
+	if macds.signal != nil {
+		return macds.signal
+	}
 	w1, w2, sig := macds.GetPeriods()
 
 	macds.signal = &EMASeries{
@@ -216,6 +238,7 @@ func (macds *MACDSignalSeries) ensureSignal() {
 		},
 		Period: sig,
 	}
+	return macds.signal
 }
 
 // Render renders the series.
@@ -234,6 +257,7 @@ type MACDLineSeries struct {
 	PrimaryPeriod   int
 	SecondaryPeriod int
 
+	lock sync.Mutex
 	ema1 *EMASeries
 	ema2 *EMASeries
 
@@ -241,16 +265,20 @@ type MACDLineSeries struct {
 }
 
 // Validate validates the series.
-func (macdl MACDLineSeries) Validate() error {
+func (macdl *MACDLineSeries) Validate() error {
+	macdl.lock.Lock()
+	ema1, ema2 := macdl.ema1, macdl.ema2
+	macdl.lock.Unlock()
+
 	var err error
-	if macdl.ema1 != nil {
-		err = macdl.ema1.Validate()
+	if ema1 != nil {
+		err = ema1.Validate()
 	}
 	if err != nil {
 		return err
 	}
-	if macdl.ema2 != nil {
-		err = macdl.ema2.Validate()
+	if ema2 != nil {
+		err = ema2.Validate()
 	}
 	if err != nil {
 		return err
@@ -262,22 +290,22 @@ func (macdl MACDLineSeries) Validate() error {
 }
 
 // GetName returns the name of the time series.
-func (macdl MACDLineSeries) GetName() string {
+func (macdl *MACDLineSeries) GetName() string {
 	return macdl.Name
 }
 
 // GetStyle returns the line style.
-func (macdl MACDLineSeries) GetStyle() Style {
+func (macdl *MACDLineSeries) GetStyle() Style {
 	return macdl.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (macdl MACDLineSeries) GetYAxis() YAxisType {
+func (macdl *MACDLineSeries) GetYAxis() YAxisType {
 	return macdl.YAxis
 }
 
 // GetPeriods returns the primary and secondary periods.
-func (macdl MACDLineSeries) GetPeriods() (w1, w2 int) {
+func (macdl *MACDLineSeries) GetPeriods() (w1, w2 int) {
 	if macdl.PrimaryPeriod == 0 {
 		w1 = DefaultMACDPeriodPrimary
 	} else {
@@ -305,20 +333,24 @@ func (macdl *MACDLineSeries) GetValues(index int) (x float64, y float64) {
 	if macdl.InnerSeries == nil {
 		return
 	}
-	if macdl.ema1 == nil && macdl.ema2 == nil {
-		macdl.ensureEMASeries()
-	}
+	ema1, ema2 := macdl.getEMASeries()
 
 	x, _ = macdl.InnerSeries.GetValues(index)
 
-	_, emav1 := macdl.ema1.GetValues(index)
-	_, emav2 := macdl.ema2.GetValues(index)
+	_, emav1 := ema1.GetValues(index)
+	_, emav2 := ema2.GetValues(index)
 
 	y = emav2 - emav1
 	return
 }
 
-func (macdl *MACDLineSeries) ensureEMASeries() {
+// getEMASeries returns the EMAs of the primary and secondary periods, made on first use with the series locked.
+func (macdl *MACDLineSeries) getEMASeries() (*EMASeries, *EMASeries) {
+	macdl.lock.Lock()
+	defer macdl.lock.Unlock()
+	if macdl.ema1 != nil && macdl.ema2 != nil {
+		return macdl.ema1, macdl.ema2
+	}
 	w1, w2 := macdl.GetPeriods()
 
 	macdl.ema1 = &EMASeries{
@@ -329,6 +361,7 @@ func (macdl *MACDLineSeries) ensureEMASeries() {
 		InnerSeries: macdl.InnerSeries,
 		Period:      w2,
 	}
+	return macdl.ema1, macdl.ema2
 }
 
 // Render renders the series.
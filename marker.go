@@ -0,0 +1,101 @@
+package chart
+
+// XMarker is a vertical reference line drawn down the canvas at an x value,
+// for example a deploy or an incident.
+type XMarker struct {
+	Value float64
+	Label string
+	Style Style
+	// AboveSeries draws the marker over the series rather than under them.
+	AboveSeries bool
+}
+
+// Render renders the marker line and its label.
+// Markers whose value translates outside the canvas box are skipped.
+// The label sits at the top of the canvas to the right of the line,
+// and moves to the left of the line if it would leave the canvas.
+func (xm XMarker) Render(r Renderer, canvasBox Box, ra Range, defaults Style) {
+	style := xm.Style.InheritFrom(defaults)
+	if style.Hidden {
+		return
+	}
+
+	x := canvasBox.Left + ra.Translate(xm.Value)
+	if x < canvasBox.Left || x > canvasBox.Right {
+		return
+	}
+
+	style.GetStrokeOptions().WriteToRenderer(r)
+	fx := float64(canvasBox.Left) + translateFloat(ra, xm.Value)
+	Draw.path(r, []floatPoint{{X: fx, Y: float64(canvasBox.Top)}, {X: fx, Y: float64(canvasBox.Bottom)}})
+	r.Stroke()
+
+	if len(xm.Label) == 0 {
+		return
+	}
+
+	style.GetTextOptions().WriteToRenderer(r)
+	tb := r.MeasureText(xm.Label)
+	left := x + DefaultMarkerLabelPadding
+	if left+tb.Width() > canvasBox.Right-DefaultMarkerLabelPadding {
+		left = MaxInt(canvasBox.Left+DefaultMarkerLabelPadding, x-DefaultMarkerLabelPadding-tb.Width())
+	}
+	r.Text(xm.Label, left, canvasBox.Top+DefaultMarkerLabelPadding+tb.Height())
+}
+
+// YMarker is a horizontal reference line drawn across the canvas at a y value,
+// for example a threshold or the mean of a series.
+type YMarker struct {
+	Value float64
+	Label string
+	Style Style
+	YAxis YAxisType
+}
+
+// Render renders the marker line and its label.
+// Markers whose value translates outside the canvas box are skipped.
+// The label sits just above the line at the right edge of the canvas,
+// and moves below the line if it would overlap any of the given boxes or leave the canvas.
+func (ym YMarker) Render(r Renderer, canvasBox Box, ra Range, defaults Style, avoid []Box) {
+	style := ym.Style.InheritFrom(defaults)
+	if style.Hidden {
+		return
+	}
+
+	y := canvasBox.Bottom - ra.Translate(ym.Value)
+	if y < canvasBox.Top || y > canvasBox.Bottom {
+		return
+	}
+
+	style.GetStrokeOptions().WriteToRenderer(r)
+	fy := float64(canvasBox.Bottom) - translateFloat(ra, ym.Value)
+	Draw.path(r, []floatPoint{{X: float64(canvasBox.Left), Y: fy}, {X: float64(canvasBox.Right), Y: fy}})
+	r.Stroke()
+
+	if len(ym.Label) == 0 {
+		return
+	}
+
+	style.GetTextOptions().WriteToRenderer(r)
+	tb := r.MeasureText(ym.Label)
+	right := canvasBox.Right - DefaultMarkerLabelPadding
+	labelBox := Box{
+		Top:    y - DefaultMarkerLabelPadding - tb.Height(),
+		Left:   right - tb.Width(),
+		Right:  right,
+		Bottom: y - DefaultMarkerLabelPadding,
+	}
+	if labelBox.Top < canvasBox.Top || boxIntersectsAny(labelBox, avoid) {
+		labelBox = labelBox.Shift(0, tb.Height()+2*DefaultMarkerLabelPadding)
+	}
+	r.Text(ym.Label, labelBox.Left, labelBox.Bottom)
+}
+
+func boxIntersectsAny(b Box, others []Box) bool {
+	for _, other := range others {
+		if b.Intersects(other) {
+			return true
+		}
+	}
+	return false
+}
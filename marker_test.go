@@ -0,0 +1,166 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+var markerTextPattern = regexp.MustCompile(`<text x="(-?\d+)" y="(-?\d+)"[^>]*>([^<]*)</text>`)
+
+func renderYMarker(t *testing.T, m YMarker, avoid []Box) (svg string, x, y int) {
+	assert := assert.New(t)
+
+	r, err := SVG(200, 100)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	ra := &ContinuousRange{Min: 0, Max: 10, Domain: 80}
+	canvasBox := Box{Top: 10, Left: 10, Right: 190, Bottom: 90}
+	m.Render(r, canvasBox, ra, Style{Font: f, FontSize: DefaultMarkerFontSize, StrokeWidth: 1}, avoid)
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+	svg = buffer.String()
+	if match := markerTextPattern.FindStringSubmatch(svg); match != nil {
+		x, _ = strconv.Atoi(match[1])
+		y, _ = strconv.Atoi(match[2])
+	}
+	return
+}
+
+func TestYMarkerRender(t *testing.T) {
+	assert := assert.New(t)
+
+	// the line is at y = 90 - 40 = 50, with the label just above it at the right edge.
+	svg, x, y := renderYMarker(t, YMarker{Value: 5, Label: "mean"}, nil)
+	assert.True(strings.Contains(svg, "M 10 50\nL 190 50"))
+	assert.True(strings.Contains(svg, ">mean</text>"))
+	assert.True(x > 100)
+	assert.True(x < 190)
+	assert.Equal(50-DefaultMarkerLabelPadding, y)
+}
+
+func TestYMarkerRenderAvoidsBoxes(t *testing.T) {
+	assert := assert.New(t)
+
+	_, _, y := renderYMarker(t, YMarker{Value: 5, Label: "mean"}, []Box{{Top: 30, Left: 150, Right: 250, Bottom: 50}})
+	assert.True(y > 50)
+}
+
+func TestYMarkerRenderOutsideRange(t *testing.T) {
+	assert := assert.New(t)
+
+	svg, _, _ := renderYMarker(t, YMarker{Value: 20, Label: "max"}, nil)
+	assert.False(strings.Contains(svg, "max"))
+	assert.False(strings.Contains(svg, "<path"))
+}
+
+func TestChartYMarkers(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3, 4},
+				YValues: []float64{1, 4, 2, 3},
+			},
+		},
+		YMarkers: []YMarker{
+			{Value: 2.5, Label: "mean"},
+			{Value: 100, Label: "unreachable"},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	svg := buffer.String()
+	assert.True(strings.Contains(svg, ">mean</text>"))
+	assert.False(strings.Contains(svg, "unreachable"))
+	assert.True(strings.Contains(svg, fmt.Sprintf("stroke-dasharray=\"%.1f, %.1f\"", DashArrayDashesSmall[0], DashArrayDashesSmall[1])))
+}
+
+func renderXMarker(t *testing.T, m XMarker) (svg string, x, y int) {
+	assert := assert.New(t)
+
+	r, err := SVG(200, 100)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	ra := &ContinuousRange{Min: 0, Max: 10, Domain: 180}
+	canvasBox := Box{Top: 10, Left: 10, Right: 190, Bottom: 90}
+	m.Render(r, canvasBox, ra, Style{Font: f, FontSize: DefaultMarkerFontSize, StrokeWidth: 1})
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+	svg = buffer.String()
+	if match := markerTextPattern.FindStringSubmatch(svg); match != nil {
+		x, _ = strconv.Atoi(match[1])
+		y, _ = strconv.Atoi(match[2])
+	}
+	return
+}
+
+func TestXMarkerRender(t *testing.T) {
+	assert := assert.New(t)
+
+	// the line is at x = 10 + 18 = 28, with the label just right of it at the top.
+	svg, x, y := renderXMarker(t, XMarker{Value: 1, Label: "deploy"})
+	assert.True(strings.Contains(svg, "M 28 10\nL 28 90"))
+	assert.Equal(28+DefaultMarkerLabelPadding, x)
+	assert.True(y > 10)
+	assert.True(y < 30)
+}
+
+func TestXMarkerRenderRightEdge(t *testing.T) {
+	assert := assert.New(t)
+
+	// the line is at x = 10 + 171 = 181, so the label moves to its left.
+	_, x, _ := renderXMarker(t, XMarker{Value: 9.5, Label: "incident"})
+	assert.True(x < 181)
+}
+
+func TestXMarkerRenderOutsideRange(t *testing.T) {
+	assert := assert.New(t)
+
+	svg, _, _ := renderXMarker(t, XMarker{Value: -1, Label: "before"})
+	assert.False(strings.Contains(svg, "before"))
+	assert.False(strings.Contains(svg, "<path"))
+}
+
+func TestChartXMarkers(t *testing.T) {
+	assert := assert.New(t)
+
+	series := ContinuousSeries{
+		Style:   Style{StrokeColor: ColorRed},
+		XValues: []float64{1, 2, 3, 4},
+		YValues: []float64{1, 4, 2, 3},
+	}
+	render := func(aboveSeries bool) string {
+		c := Chart{
+			Series: []Series{series},
+			XMarkers: []XMarker{
+				{Value: 2.5, Label: "deploy", Style: Style{StrokeColor: ColorBlue}, AboveSeries: aboveSeries},
+				{Value: 10, Label: "unreachable"},
+			},
+		}
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(SVG, buffer))
+		return buffer.String()
+	}
+
+	below := render(false)
+	assert.True(strings.Contains(below, ">deploy</text>"))
+	assert.False(strings.Contains(below, "unreachable"))
+	assert.True(strings.Index(below, ColorBlue.Hex()) < strings.Index(below, ColorRed.Hex()))
+
+	above := render(true)
+	assert.True(strings.Index(above, ColorBlue.Hex()) > strings.Index(above, ColorRed.Hex()))
+}
@@ -157,6 +157,27 @@ func RoundDown(value, roundTo float64) float64 {
 	return d1 * roundTo
 }
 
+// NiceNumber returns the smallest "nice" number, i.e. 1, 2 or 5 times a power of ten,
+// that is greater than or equal to the given (positive) value.
+func NiceNumber(value float64) float64 {
+	if value <= 0 {
+		return value
+	}
+	exponent := math.Floor(math.Log10(value))
+	magnitude := math.Pow(10, exponent)
+	fraction := value / magnitude
+
+	switch {
+	case fraction <= 1:
+		return magnitude
+	case fraction <= 2:
+		return 2 * magnitude
+	case fraction <= 5:
+		return 5 * magnitude
+	}
+	return 10 * magnitude
+}
+
 // Normalize returns a set of numbers on the interval [0,1] for a given set of inputs.
 // An example: 4,3,2,1 => 0.4, 0.3, 0.2, 0.1
 // Caveat; the total may be < 1.0; there are going to be issues with irrational numbers etc.
@@ -250,3 +271,28 @@ func f64i(value float64) int {
 	r := RoundPlaces(value, 0)
 	return int(r)
 }
+
+// isFinite returns if a value is neither NaN nor infinite.
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+// bounds returns the bounds of the finite x,y pairs of a set of values, or NaNs if there are none.
+func bounds(xvalues, yvalues []float64) (minx, maxx, miny, maxy float64) {
+	minx, maxx, miny, maxy = math.NaN(), math.NaN(), math.NaN(), math.NaN()
+	for index := 0; index < len(xvalues) && index < len(yvalues); index++ {
+		vx, vy := xvalues[index], yvalues[index]
+		if !isFinite(vx) || !isFinite(vy) {
+			continue
+		}
+		if math.IsNaN(minx) {
+			minx, maxx, miny, maxy = vx, vx, vy, vy
+			continue
+		}
+		minx = math.Min(minx, vx)
+		maxx = math.Max(maxx, vx)
+		miny = math.Min(miny, vy)
+		maxy = math.Max(maxy, vy)
+	}
+	return
+}
@@ -3,6 +3,7 @@ package chart
 import (
 	"fmt"
 	"math"
+	"sync"
 )
 
 // MinSeries draws a horizontal line at the minimum value of the inner series.
@@ -12,34 +13,34 @@ type MinSeries struct {
 	YAxis       YAxisType
 	InnerSeries ValuesProvider
 
+	lock     sync.Mutex
 	minValue *float64
 }
 
 // GetName returns the name of the time series.
-func (ms MinSeries) GetName() string {
+func (ms *MinSeries) GetName() string {
 	return ms.Name
 }
 
 // GetStyle returns the line style.
-func (ms MinSeries) GetStyle() Style {
+func (ms *MinSeries) GetStyle() Style {
 	return ms.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (ms MinSeries) GetYAxis() YAxisType {
+func (ms *MinSeries) GetYAxis() YAxisType {
 	return ms.YAxis
 }
 
 // Len returns the number of elements in the series.
-func (ms MinSeries) Len() int {
+func (ms *MinSeries) Len() int {
 	return ms.InnerSeries.Len()
 }
 
 // GetValues gets a value at a given index.
 func (ms *MinSeries) GetValues(index int) (x, y float64) {
-	ms.ensureMinValue()
 	x, _ = ms.InnerSeries.GetValues(index)
-	y = *ms.minValue
+	y = ms.getMinValue()
 	return
 }
 
@@ -49,7 +50,11 @@ func (ms *MinSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, def
 	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ms)
 }
 
-func (ms *MinSeries) ensureMinValue() {
+// getMinValue returns the minimum value of the inner series, found on first use with the series locked,
+// as charts rendered concurrently can share it.
+func (ms *MinSeries) getMinValue() float64 {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
 	if ms.minValue == nil {
 		minValue := math.MaxFloat64
 		var y float64
@@ -61,6 +66,7 @@ func (ms *MinSeries) ensureMinValue() {
 		}
 		ms.minValue = &minValue
 	}
+	return *ms.minValue
 }
 
 // Validate validates the series.
@@ -78,34 +84,34 @@ type MaxSeries struct {
 	YAxis       YAxisType
 	InnerSeries ValuesProvider
 
+	lock     sync.Mutex
 	maxValue *float64
 }
 
 // GetName returns the name of the time series.
-func (ms MaxSeries) GetName() string {
+func (ms *MaxSeries) GetName() string {
 	return ms.Name
 }
 
 // GetStyle returns the line style.
-func (ms MaxSeries) GetStyle() Style {
+func (ms *MaxSeries) GetStyle() Style {
 	return ms.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (ms MaxSeries) GetYAxis() YAxisType {
+func (ms *MaxSeries) GetYAxis() YAxisType {
 	return ms.YAxis
 }
 
 // Len returns the number of elements in the series.
-func (ms MaxSeries) Len() int {
+func (ms *MaxSeries) Len() int {
 	return ms.InnerSeries.Len()
 }
 
 // GetValues gets a value at a given index.
 func (ms *MaxSeries) GetValues(index int) (x, y float64) {
-	ms.ensureMaxValue()
 	x, _ = ms.InnerSeries.GetValues(index)
-	y = *ms.maxValue
+	y = ms.getMaxValue()
 	return
 }
 
@@ -115,7 +121,10 @@ func (ms *MaxSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, def
 	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ms)
 }
 
-func (ms *MaxSeries) ensureMaxValue() {
+// getMaxValue returns the maximum value of the inner series, found on first use with the series locked.
+func (ms *MaxSeries) getMaxValue() float64 {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
 	if ms.maxValue == nil {
 		maxValue := -math.MaxFloat64
 		var y float64
@@ -127,6 +136,7 @@ func (ms *MaxSeries) ensureMaxValue() {
 		}
 		ms.maxValue = &maxValue
 	}
+	return *ms.maxValue
 }
 
 // Validate validates the series.
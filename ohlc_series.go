@@ -0,0 +1,138 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*OHLCSeries)(nil)
+	_ OHLCValuesProvider    = (*OHLCSeries)(nil)
+	_ BoundedValuesProvider = (*OHLCSeries)(nil)
+	_ FullValuesProvider    = (*OHLCSeries)(nil)
+)
+
+// OHLCSeries draws open, high, low and close values as candlesticks.
+// The y range spans the highs and lows; as a values provider (e.g. for annotations) it yields the close.
+type OHLCSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// UpStyle and DownStyle are applied on top of Style to candles that close at or above,
+	// or below, their open.
+	UpStyle   Style
+	DownStyle Style
+
+	XValueFormatter ValueFormatter
+	YValueFormatter ValueFormatter
+
+	XValues []float64
+	Open    []float64
+	High    []float64
+	Low     []float64
+	Close   []float64
+}
+
+// GetName returns the name of the series.
+func (ohlc OHLCSeries) GetName() string {
+	return ohlc.Name
+}
+
+// GetStyle returns the series style.
+func (ohlc OHLCSeries) GetStyle() Style {
+	return ohlc.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ohlc OHLCSeries) GetYAxis() YAxisType {
+	return ohlc.YAxis
+}
+
+// Len returns the number of elements in the series.
+// If the value slices differ in length, the shortest is used.
+func (ohlc OHLCSeries) Len() int {
+	return MinInt(len(ohlc.XValues), MinInt(MinInt(len(ohlc.Open), len(ohlc.High)), MinInt(len(ohlc.Low), len(ohlc.Close))))
+}
+
+// GetOHLCValues gets the x, open, high, low and close values at a given index.
+// It returns zero values if the index is out of bounds.
+func (ohlc OHLCSeries) GetOHLCValues(index int) (x, open, high, low, close float64) {
+	if index < 0 || index >= ohlc.Len() {
+		return
+	}
+	return ohlc.XValues[index], ohlc.Open[index], ohlc.High[index], ohlc.Low[index], ohlc.Close[index]
+}
+
+// GetBoundedValues gets the x, high and low values at a given index.
+func (ohlc OHLCSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x, _, y1, y2, _ = ohlc.GetOHLCValues(index)
+	return
+}
+
+// GetValues gets the x and close values at a given index.
+func (ohlc OHLCSeries) GetValues(index int) (x, y float64) {
+	x, _, _, _, y = ohlc.GetOHLCValues(index)
+	return
+}
+
+// GetLastValues gets the last x and close values.
+func (ohlc OHLCSeries) GetLastValues() (x, y float64) {
+	return ohlc.GetValues(ohlc.Len() - 1)
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (ohlc OHLCSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if ohlc.XValueFormatter != nil {
+		x = ohlc.XValueFormatter
+	} else {
+		x = FloatValueFormatter
+	}
+	if ohlc.YValueFormatter != nil {
+		y = ohlc.YValueFormatter
+	} else {
+		y = defaultValueFormatter
+	}
+	return
+}
+
+// Render renders the series.
+func (ohlc OHLCSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	// the body width is a fraction of the smallest x spacing so neighbouring candles do not overlap.
+	Draw.OHLCSeries(r, canvasBox, xrange, yrange, ohlc.getUpStyle(), ohlc.getDownStyle(), ohlc, Draw.bodyWidth(xrange, ohlc))
+}
+
+// Validate validates the series.
+func (ohlc OHLCSeries) Validate() error {
+	count := len(ohlc.XValues)
+	if count == 0 {
+		return fmt.Errorf("ohlc series; must have xvalues set")
+	}
+	if len(ohlc.Open) != count || len(ohlc.High) != count || len(ohlc.Low) != count || len(ohlc.Close) != count {
+		return fmt.Errorf("ohlc series; must have the same number of open, high, low and close values as xvalues")
+	}
+	for index := 0; index < count; index++ {
+		_, open, high, low, close := ohlc.GetOHLCValues(index)
+		if low > math.Min(open, close) || high < math.Max(open, close) {
+			return fmt.Errorf("ohlc series; value %d must have a low and high that contain its open and close", index)
+		}
+	}
+	return nil
+}
+
+func (ohlc OHLCSeries) getUpStyle() Style {
+	return ohlc.UpStyle.InheritFrom(ohlc.Style.InheritFrom(Style{
+		StrokeColor: ColorGreen,
+		StrokeWidth: DefaultAxisLineWidth,
+		FillColor:   ColorGreen,
+	}))
+}
+
+func (ohlc OHLCSeries) getDownStyle() Style {
+	return ohlc.DownStyle.InheritFrom(ohlc.Style.InheritFrom(Style{
+		StrokeColor: ColorRed,
+		StrokeWidth: DefaultAxisLineWidth,
+		FillColor:   ColorRed,
+	}))
+}
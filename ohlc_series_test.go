@@ -0,0 +1,104 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func testOHLCSeries() OHLCSeries {
+	return OHLCSeries{
+		Name:    "Prices",
+		XValues: []float64{1, 2, 3, 4},
+		Open:    []float64{10, 12, 11, 14},
+		High:    []float64{13, 13, 15, 16},
+		Low:     []float64{9, 10, 10, 8},
+		Close:   []float64{12, 11, 14, 9},
+	}
+}
+
+func TestOHLCSeriesValues(t *testing.T) {
+	assert := assert.New(t)
+
+	ohlc := testOHLCSeries()
+	assert.Equal(4, ohlc.Len())
+
+	x, open, high, low, close := ohlc.GetOHLCValues(1)
+	assert.Equal(2.0, x)
+	assert.Equal(12.0, open)
+	assert.Equal(13.0, high)
+	assert.Equal(10.0, low)
+	assert.Equal(11.0, close)
+
+	x, y1, y2 := ohlc.GetBoundedValues(3)
+	assert.Equal(4.0, x)
+	assert.Equal(16.0, y1)
+	assert.Equal(8.0, y2)
+
+	x, y := ohlc.GetLastValues()
+	assert.Equal(4.0, x)
+	assert.Equal(9.0, y)
+
+	x, open, _, _, _ = ohlc.GetOHLCValues(10)
+	assert.Zero(x)
+	assert.Zero(open)
+}
+
+func TestOHLCSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(testOHLCSeries().Validate())
+	assert.NotNil(OHLCSeries{}.Validate())
+
+	short := testOHLCSeries()
+	short.Close = short.Close[:2]
+	assert.NotNil(short.Validate())
+
+	inverted := testOHLCSeries()
+	inverted.High[0] = 11
+	assert.NotNil(inverted.Validate())
+}
+
+func TestOHLCSeriesRangeSpansHighLow(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{testOHLCSeries()},
+	}
+	xr, yr, _ := c.getRanges()
+	assert.Equal(1.0, xr.GetMin())
+	assert.Equal(4.0, xr.GetMax())
+	assert.Equal(8.0, yr.GetMin())
+	assert.Equal(16.0, yr.GetMax())
+}
+
+func TestOHLCSeriesCandleBodyWidth(t *testing.T) {
+	assert := assert.New(t)
+
+	xr := &ContinuousRange{Min: 1, Max: 4, Domain: 300}
+	// wide spacing is capped at the default bar width.
+	assert.Equal(35, Draw.bodyWidth(xr, testOHLCSeries()))
+
+	dense := OHLCSeries{XValues: []float64{1, 1.1, 4}, Open: []float64{1, 1, 1}, High: []float64{1, 1, 1}, Low: []float64{1, 1, 1}, Close: []float64{1, 1, 1}}
+	assert.Equal(7, Draw.bodyWidth(xr, dense))
+}
+
+func TestOHLCSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	ohlc := testOHLCSeries()
+	ohlc.UpStyle = Style{FillColor: ColorBlue, StrokeColor: ColorBlue}
+
+	c := Chart{
+		Series: []Series{ohlc},
+	}
+
+	buf := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buf))
+	svg := buf.String()
+	assert.True(strings.Contains(svg, ColorBlue.Hex()))
+	assert.True(strings.Contains(svg, ColorRed.Hex()))
+	assert.False(strings.Contains(svg, ColorGreen.Hex()))
+}
@@ -3,7 +3,9 @@ package chart
 import (
 	"errors"
 	"fmt"
+	"image"
 	"io"
+	"math"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -14,6 +16,10 @@ type PieChart struct {
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme sets the colors and padding of the chart; it defaults to `GetDefaultTheme()`.
+	// Slices keep the `AlternateColorPalette` colors unless the theme has series colors.
+	// A ColorPalette takes precedence over the theme colors.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -65,10 +71,16 @@ func (pc PieChart) GetHeight() int {
 	return pc.Height
 }
 
+// RenderImage renders the chart with a raster renderer provider, like PNG, and returns the image
+// without encoding it.
+func (pc PieChart) RenderImage(rp RendererProvider) (image.Image, error) {
+	return renderImage(rp, pc.Render)
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (pc PieChart) Render(rp RendererProvider, w io.Writer) error {
-	if len(pc.Values) == 0 {
-		return errors.New("please provide at least one value")
+	if err := pc.Validate(); err != nil {
+		return err
 	}
 
 	r, err := rp(pc.GetWidth(), pc.GetHeight())
@@ -124,17 +136,20 @@ func (pc PieChart) drawTitle(r Renderer) {
 func (pc PieChart) drawSlices(r Renderer, canvasBox Box, values []Value) {
 	cx, cy := canvasBox.Center()
 	diameter := MinInt(canvasBox.Width(), canvasBox.Height())
-	radius := float64(diameter >> 1)
+	if diameter < 2 {
+		// there is no room left for the circle once the padding is taken out.
+		return
+	}
+	radius := pc.getRadius(r, float64(diameter>>1), values)
 	labelRadius := (radius * 2.0) / 3.0
 
 	// draw the pie slices
-	var rads, delta, delta2, total float64
-	var lx, ly int
+	var rads, delta, total float64
 
 	if len(values) == 1 {
-		pc.stylePieChartValue(0).WriteToRenderer(r)
-		r.MoveTo(cx, cy)
+		values[0].Style.InheritFrom(pc.stylePieChartValue(0)).WriteToRenderer(r)
 		r.Circle(radius, cx, cy)
+		r.FillStroke()
 	} else {
 		for index, v := range values {
 			v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
@@ -155,29 +170,111 @@ func (pc PieChart) drawSlices(r Renderer, canvasBox Box, values []Value) {
 	// draw the labels
 	total = 0
 	for index, v := range values {
-		v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
+		style := v.Style.InheritFrom(pc.stylePieChartValue(index))
+		style.WriteToRenderer(r)
 		if len(v.Label) > 0 {
-			delta2 = PercentToRadians(total + (v.Value / 2.0))
-			delta2 = RadianAdd(delta2, _pi2)
-			lx, ly = CirclePoint(cx, cy, labelRadius, delta2)
-
+			theta := RadianAdd(PercentToRadians(total+(v.Value/2.0)), _pi2)
 			tb := r.MeasureText(v.Label)
-			lx = lx - (tb.Width() >> 1)
-			ly = ly + (tb.Height() >> 1)
 
-			if lx < 0 {
-				lx = 0
-			}
-			if ly < 0 {
-				lx = 0
+			if len(values) == 1 || pc.labelFitsInside(tb, labelRadius, v.Value) {
+				lx, ly := CirclePoint(cx, cy, labelRadius, theta)
+				if len(values) == 1 {
+					lx, ly = cx, cy
+				}
+				r.Text(v.Label, lx-(tb.Width()>>1), ly+(tb.Height()>>1))
+			} else {
+				pc.drawLeaderLabel(r, cx, cy, radius, theta, v.Label, style)
 			}
-
-			r.Text(v.Label, lx, ly)
 		}
 		total = total + v.Value
 	}
 }
 
+// drawLeaderLabel draws a label outside the pie, joined to the edge of its slice by a leader line.
+func (pc PieChart) drawLeaderLabel(r Renderer, cx, cy int, radius, theta float64, label string, style Style) {
+	sx, sy := CirclePoint(cx, cy, radius, theta)
+	ex, ey := CirclePoint(cx, cy, radius+DefaultPieLeaderLineLength, theta)
+
+	elbow := DefaultPieLeaderLineLength >> 1
+	if math.Sin(theta) < 0 {
+		elbow = -elbow
+	}
+
+	r.SetStrokeColor(style.GetFontColor())
+	r.SetStrokeWidth(DefaultAxisLineWidth)
+	r.SetStrokeDashArray(nil)
+	r.MoveTo(sx, sy)
+	r.LineTo(ex, ey)
+	r.LineTo(ex+elbow, ey)
+	r.Stroke()
+
+	tb := r.MeasureText(label)
+	lx := ex + elbow + DefaultPieLeaderLabelPadding
+	if elbow < 0 {
+		lx = ex + elbow - DefaultPieLeaderLabelPadding - tb.Width()
+	}
+	r.Text(label, lx, ey+(tb.Height()>>1))
+}
+
+// labelFitsInside returns if a label fits within the chord of its slice at the label radius.
+func (pc PieChart) labelFitsInside(tb Box, labelRadius, pct float64) bool {
+	if pct >= 0.5 {
+		return float64(tb.Width()) <= labelRadius
+	}
+	chord := 2.0 * labelRadius * math.Sin(PercentToRadians(pct)/2.0)
+	return float64(tb.Width()) <= chord && float64(tb.Height()) <= chord
+}
+
+// getRadius returns the pie radius, shrunk to leave room for any labels drawn outside the pie.
+func (pc PieChart) getRadius(r Renderer, maxRadius float64, values []Value) float64 {
+	if len(values) < 2 {
+		return maxRadius
+	}
+
+	var outside bool
+	var labelWidth int
+	for index, v := range values {
+		if len(v.Label) == 0 {
+			continue
+		}
+		v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteTextOptionsToRenderer(r)
+		tb := r.MeasureText(v.Label)
+		labelWidth = MaxInt(labelWidth, tb.Width())
+		if !pc.labelFitsInside(tb, (maxRadius*2.0)/3.0, v.Value) {
+			outside = true
+		}
+	}
+	if !outside {
+		return maxRadius
+	}
+	reserved := float64(labelWidth + DefaultPieLeaderLineLength + (DefaultPieLeaderLineLength >> 1) + DefaultPieLeaderLabelPadding)
+	return math.Max(maxRadius-reserved, maxRadius/2.0)
+}
+
+// Validate validates the chart values.
+// Values must be finite and not negative, and at least one must be positive; zero values are not drawn.
+func (pc PieChart) Validate() error {
+	if len(pc.Values) == 0 {
+		return errors.New("please provide at least one value")
+	}
+	var hasPositive bool
+	for index, v := range pc.Values {
+		if math.IsNaN(v.Value) || math.IsInf(v.Value, 0) {
+			return fmt.Errorf("pie chart value %d (%q) is not finite", index, v.Label)
+		}
+		if v.Value < 0 {
+			return fmt.Errorf("pie chart value %d (%q) is negative: %v", index, v.Label, v.Value)
+		}
+		if v.Value > 0 {
+			hasPositive = true
+		}
+	}
+	if !hasPositive {
+		return fmt.Errorf("pie chart must contain at least (1) non-zero value")
+	}
+	return nil
+}
+
 func (pc PieChart) finalizeValues(values []Value) ([]Value, error) {
 	finalValues := Values(values).Normalize()
 	if len(finalValues) == 0 {
@@ -192,6 +289,9 @@ func (pc PieChart) getDefaultCanvasBox() Box {
 
 func (pc PieChart) getCircleAdjustedCanvasBox(canvasBox Box) Box {
 	circleDiameter := MinInt(canvasBox.Width(), canvasBox.Height())
+	if circleDiameter == 0 {
+		return canvasBox
+	}
 
 	square := Box{
 		Right:  circleDiameter,
@@ -289,23 +389,18 @@ func (pc PieChart) getTitleFontSize() float64 {
 	return 10
 }
 
+// GetTheme returns the chart theme or the default theme; nil means the package defaults.
+func (pc PieChart) GetTheme() *Theme {
+	return getTheme(pc.Theme)
+}
+
 // GetColorPalette returns the color palette for the chart.
 func (pc PieChart) GetColorPalette() ColorPalette {
-	if pc.ColorPalette != nil {
-		return pc.ColorPalette
-	}
-	return AlternateColorPalette
+	return getColorPalette(pc.ColorPalette, pc.GetTheme(), AlternateColorPalette)
 }
 
 // Box returns the chart bounds as a box.
 func (pc PieChart) Box() Box {
-	dpr := pc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
-	dpb := pc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
-
-	return Box{
-		Top:    pc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
-		Left:   pc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
-		Right:  pc.GetWidth() - dpr,
-		Bottom: pc.GetHeight() - dpb,
-	}
+	padding := pc.Background.InheritFrom(Style{Padding: pc.GetTheme().GetBackgroundPadding()}).Padding
+	return Box{Right: pc.GetWidth(), Bottom: pc.GetHeight()}.Inset(padding)
 }
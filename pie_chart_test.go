@@ -2,6 +2,8 @@ package chart
 
 import (
 	"bytes"
+	"math"
+	"strings"
 	"testing"
 
 	assert "github.com/blend/go-sdk/assert"
@@ -67,3 +69,63 @@ func TestPieChartAllZeroValues(t *testing.T) {
 	err := pie.Render(PNG, b)
 	assert.NotNil(err)
 }
+
+func TestPieChartValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(PieChart{}.Validate())
+	assert.NotNil(PieChart{Values: []Value{{Value: 5}, {Value: -1}}}.Validate())
+	assert.NotNil(PieChart{Values: []Value{{Value: 5}, {Value: math.NaN()}}}.Validate())
+	assert.NotNil(PieChart{Values: []Value{{Value: 0}, {Value: 0}}}.Validate())
+	assert.Nil(PieChart{Values: []Value{{Value: 5}, {Value: 0}}}.Validate())
+
+	b := bytes.NewBuffer([]byte{})
+	err := PieChart{Values: []Value{{Value: 5}, {Value: -1}}}.Render(PNG, b)
+	assert.NotNil(err)
+}
+
+func TestPieChartSingleValue(t *testing.T) {
+	assert := assert.New(t)
+
+	pie := PieChart{
+		Values: []Value{
+			{Value: 5, Label: "Everything"},
+			{Value: 0, Label: "Nothing"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	err := pie.Render(SVG, b)
+	assert.Nil(err)
+	assert.True(strings.Contains(b.String(), "<circle"))
+	assert.True(strings.Contains(b.String(), "Everything"))
+	assert.False(strings.Contains(b.String(), "Nothing"))
+}
+
+func TestPieChartLabelFitsInside(t *testing.T) {
+	assert := assert.New(t)
+
+	pc := PieChart{}
+	label := Box{Right: 40, Bottom: 10}
+	assert.True(pc.labelFitsInside(label, 100, 0.25))
+	assert.True(pc.labelFitsInside(label, 100, 0.75))
+	assert.False(pc.labelFitsInside(label, 100, 0.01))
+}
+
+func TestPieChartGetRadius(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(512, 512)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	pc := PieChart{Font: f}
+	wide := []Value{{Value: 0.5, Label: "A"}, {Value: 0.5, Label: "B"}}
+	assert.Equal(200.0, pc.getRadius(r, 200, wide))
+
+	thin := []Value{{Value: 0.99, Label: "A"}, {Value: 0.01, Label: "A thin slice"}}
+	radius := pc.getRadius(r, 200, thin)
+	assert.True(radius < 200)
+	assert.True(radius >= 100)
+}
@@ -0,0 +1,66 @@
+package chart
+
+import (
+	"image"
+	"image/png"
+	"sync"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// PNGPool is a renderer provider for high throughput rendering that reuses the image buffers of
+// png renderers of the same size, and the buffers used to encode them.
+//
+// Use its `PNG` method in place of the `PNG` renderer provider. A renderer goes back to the pool once it
+// is saved and must not be used after that, so each renderer belongs to one goroutine at a time;
+// the pool itself is safe for concurrent use. Images handed to an `RGBACollector`, e.g. by `RenderImage`,
+// are not reused.
+type PNGPool struct {
+	images   sync.Map // map[image.Point]*sync.Pool
+	encoders sync.Pool
+}
+
+// PNG returns a png renderer drawing to a cleared image from the pool.
+func (pp *PNGPool) PNG(width, height int) (Renderer, error) {
+	i := pp.getImage(width, height)
+	gc, err := drawing.NewRasterGraphicContext(i)
+	if err != nil {
+		pp.putImage(i)
+		return nil, err
+	}
+	return &rasterRenderer{
+		i:    i,
+		gc:   gc,
+		pool: pp,
+	}, nil
+}
+
+// Get implements png.EncoderBufferPool.
+func (pp *PNGPool) Get() *png.EncoderBuffer {
+	if buffer, ok := pp.encoders.Get().(*png.EncoderBuffer); ok {
+		return buffer
+	}
+	return nil
+}
+
+// Put implements png.EncoderBufferPool.
+func (pp *PNGPool) Put(buffer *png.EncoderBuffer) {
+	pp.encoders.Put(buffer)
+}
+
+func (pp *PNGPool) getImage(width, height int) *image.RGBA {
+	if pool, ok := pp.images.Load(image.Pt(width, height)); ok {
+		if i, ok := pool.(*sync.Pool).Get().(*image.RGBA); ok {
+			for index := range i.Pix {
+				i.Pix[index] = 0
+			}
+			return i
+		}
+	}
+	return image.NewRGBA(image.Rect(0, 0, width, height))
+}
+
+func (pp *PNGPool) putImage(i *image.RGBA) {
+	pool, _ := pp.images.LoadOrStore(i.Rect.Size(), &sync.Pool{})
+	pool.(*sync.Pool).Put(i)
+}
@@ -0,0 +1,113 @@
+package chart
+
+import (
+	"bytes"
+	"image"
+	"sync"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func pngPoolTestChart(values ...float64) Chart {
+	return Chart{
+		Width:  300,
+		Height: 200,
+		Series: []Series{
+			ContinuousSeries{
+				XValues: LinearRange(0, float64(len(values)-1)),
+				YValues: values,
+			},
+		},
+	}
+}
+
+func TestPNGPoolRender(t *testing.T) {
+	assert := assert.New(t)
+
+	c := pngPoolTestChart(1, 3, 2, 4)
+	expected := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, expected))
+
+	pool := &PNGPool{}
+	for _, other := range []Chart{pngPoolTestChart(4, 1, 5), c} {
+		// rendering something else first leaves a used image in the pool.
+		assert.Nil(other.Render(pool.PNG, bytes.NewBuffer(nil)))
+
+		actual := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(pool.PNG, actual))
+		assert.Equal(expected.Bytes(), actual.Bytes())
+	}
+}
+
+func TestPNGPoolRenderImageNotReused(t *testing.T) {
+	assert := assert.New(t)
+
+	pool := &PNGPool{}
+	first, err := pngPoolTestChart(1, 3, 2, 4).RenderImage(pool.PNG)
+	assert.Nil(err)
+	snapshot := image.NewRGBA(first.Bounds())
+	copy(snapshot.Pix, first.(*image.RGBA).Pix)
+
+	for index := 0; index < 3; index++ {
+		assert.Nil(pngPoolTestChart(4, 1, 5).Render(pool.PNG, bytes.NewBuffer(nil)))
+	}
+	assert.Equal(snapshot.Pix, first.(*image.RGBA).Pix)
+}
+
+func TestPNGPoolSaveTwice(t *testing.T) {
+	assert := assert.New(t)
+
+	pool := &PNGPool{}
+	r, err := pool.PNG(10, 10)
+	assert.Nil(err)
+	assert.Nil(r.Save(bytes.NewBuffer(nil)))
+	assert.NotNil(r.Save(bytes.NewBuffer(nil)))
+}
+
+func TestPNGPoolConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	c := pngPoolTestChart(1, 3, 2, 4)
+	expected := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, expected))
+
+	pool := &PNGPool{}
+	results := make([][]byte, 8)
+	var wg sync.WaitGroup
+	for index := range results {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			buffer := bytes.NewBuffer(nil)
+			c.Render(pool.PNG, buffer)
+			results[index] = buffer.Bytes()
+		}(index)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		assert.Equal(expected.Bytes(), result)
+	}
+}
+
+func benchmarkPNGRender(b *testing.B, rp RendererProvider) {
+	c := pngPoolTestChart(1, 3, 2, 4, 3, 5, 4, 6)
+	c.Width, c.Height = 1024, 512
+	buffer := bytes.NewBuffer(nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		buffer.Reset()
+		c.Render(rp, buffer)
+	}
+}
+
+func BenchmarkPNGRender(b *testing.B) {
+	benchmarkPNGRender(b, PNG)
+}
+
+func BenchmarkPNGPoolRender(b *testing.B) {
+	benchmarkPNGRender(b, (&PNGPool{}).PNG)
+}
@@ -3,6 +3,7 @@ package chart
 import (
 	"fmt"
 	"math"
+	"sync"
 
 	"github.com/wcharczuk/go-chart/matrix"
 )
@@ -26,31 +27,32 @@ type PolynomialRegressionSeries struct {
 	Degree      int
 	InnerSeries ValuesProvider
 
+	lock   sync.Mutex
 	coeffs []float64
 }
 
 // GetName returns the name of the time series.
-func (prs PolynomialRegressionSeries) GetName() string {
+func (prs *PolynomialRegressionSeries) GetName() string {
 	return prs.Name
 }
 
 // GetStyle returns the line style.
-func (prs PolynomialRegressionSeries) GetStyle() Style {
+func (prs *PolynomialRegressionSeries) GetStyle() Style {
 	return prs.Style
 }
 
 // GetYAxis returns which YAxis the series draws on.
-func (prs PolynomialRegressionSeries) GetYAxis() YAxisType {
+func (prs *PolynomialRegressionSeries) GetYAxis() YAxisType {
 	return prs.YAxis
 }
 
 // Len returns the number of elements in the series.
-func (prs PolynomialRegressionSeries) Len() int {
+func (prs *PolynomialRegressionSeries) Len() int {
 	return MinInt(prs.GetLimit(), prs.InnerSeries.Len()-prs.GetOffset())
 }
 
 // GetLimit returns the window size.
-func (prs PolynomialRegressionSeries) GetLimit() int {
+func (prs *PolynomialRegressionSeries) GetLimit() int {
 	if prs.Limit == 0 {
 		return prs.InnerSeries.Len()
 	}
@@ -58,14 +60,14 @@ func (prs PolynomialRegressionSeries) GetLimit() int {
 }
 
 // GetEndIndex returns the effective limit end.
-func (prs PolynomialRegressionSeries) GetEndIndex() int {
+func (prs *PolynomialRegressionSeries) GetEndIndex() int {
 	windowEnd := prs.GetOffset() + prs.GetLimit()
 	innerSeriesLastIndex := prs.InnerSeries.Len() - 1
 	return MinInt(windowEnd, innerSeriesLastIndex)
 }
 
 // GetOffset returns the data offset.
-func (prs PolynomialRegressionSeries) GetOffset() int {
+func (prs *PolynomialRegressionSeries) GetOffset() int {
 	if prs.Offset == 0 {
 		return 0
 	}
@@ -92,18 +94,12 @@ func (prs *PolynomialRegressionSeries) GetValues(index int) (x, y float64) {
 		return
 	}
 
-	if prs.coeffs == nil {
-		coeffs, err := prs.computeCoefficients()
-		if err != nil {
-			panic(err)
-		}
-		prs.coeffs = coeffs
-	}
+	coeffs := prs.getCoefficients()
 
 	offset := prs.GetOffset()
 	effectiveIndex := MinInt(index+offset, prs.InnerSeries.Len())
 	x, y = prs.InnerSeries.GetValues(effectiveIndex)
-	y = prs.apply(x)
+	y = prs.apply(coeffs, x)
 	return
 }
 
@@ -112,15 +108,9 @@ func (prs *PolynomialRegressionSeries) GetFirstValues() (x, y float64) {
 	if prs.InnerSeries == nil || prs.InnerSeries.Len() == 0 {
 		return
 	}
-	if prs.coeffs == nil {
-		coeffs, err := prs.computeCoefficients()
-		if err != nil {
-			panic(err)
-		}
-		prs.coeffs = coeffs
-	}
+	coeffs := prs.getCoefficients()
 	x, y = prs.InnerSeries.GetValues(0)
-	y = prs.apply(x)
+	y = prs.apply(coeffs, x)
 	return
 }
 
@@ -129,26 +119,35 @@ func (prs *PolynomialRegressionSeries) GetLastValues() (x, y float64) {
 	if prs.InnerSeries == nil || prs.InnerSeries.Len() == 0 {
 		return
 	}
-	if prs.coeffs == nil {
-		coeffs, err := prs.computeCoefficients()
-		if err != nil {
-			panic(err)
-		}
-		prs.coeffs = coeffs
-	}
+	coeffs := prs.getCoefficients()
 	endIndex := prs.GetEndIndex()
 	x, y = prs.InnerSeries.GetValues(endIndex)
-	y = prs.apply(x)
+	y = prs.apply(coeffs, x)
 	return
 }
 
-func (prs *PolynomialRegressionSeries) apply(v float64) (out float64) {
-	for index, coeff := range prs.coeffs {
+func (prs *PolynomialRegressionSeries) apply(coeffs []float64, v float64) (out float64) {
+	for index, coeff := range coeffs {
 		out = out + (coeff * math.Pow(v, float64(index)))
 	}
 	return
 }
 
+// getCoefficients returns the coefficients of the polynomial, computed on first use.
+// The series is locked while they're computed, as charts rendered concurrently can share it.
+func (prs *PolynomialRegressionSeries) getCoefficients() []float64 {
+	prs.lock.Lock()
+	defer prs.lock.Unlock()
+	if prs.coeffs == nil {
+		coeffs, err := prs.computeCoefficients()
+		if err != nil {
+			panic(err)
+		}
+		prs.coeffs = coeffs
+	}
+	return prs.coeffs
+}
+
 func (prs *PolynomialRegressionSeries) computeCoefficients() ([]float64, error) {
 	xvalues, yvalues := prs.values()
 	return matrix.Poly(xvalues, yvalues, prs.Degree)
@@ -41,3 +41,42 @@ type Range interface {
 	// Translate the range to the domain.
 	Translate(value float64) int
 }
+
+// FloatTranslator is a range that can translate values to fractional positions within its domain.
+// Lines drawn through fractional positions don't snap to whole pixels, which keeps shallow slopes
+// on small charts from looking like stairs. Ranges that don't implement it fall back to `Translate`.
+// `TranslateFloat` doesn't round, so its positions can be up to a pixel short of the ones `Translate` rounds up to;
+// the lines, dots, tick marks and grid lines of a chart are all drawn at the fractional positions so they meet,
+// and only text is placed at the whole pixel ones.
+type FloatTranslator interface {
+	TranslateFloat(value float64) float64
+}
+
+// translateFloat translates a value with the range, to a fractional position if the range supports it.
+func translateFloat(ra Range, value float64) float64 {
+	if typed, isTyped := ra.(FloatTranslator); isTyped {
+		return typed.TranslateFloat(value)
+	}
+	return float64(ra.Translate(value))
+}
+
+// copyRange returns a copy of a user supplied range to lay out a chart with, or a new continuous range if there isn't one.
+// Laying out a chart sets the min, max and domain of its ranges, and the ranges of the chart itself are left as they are,
+// so it can be rendered again, or concurrently. Ranges of types other than the ones in this package are used as they are.
+func copyRange(r Range) Range {
+	switch typed := r.(type) {
+	case nil:
+		return &ContinuousRange{}
+	case *ContinuousRange:
+		copied := *typed
+		return &copied
+	case *LogarithmicRange:
+		copied := *typed
+		return &copied
+	case *TimeRange:
+		copied := *typed
+		return &copied
+	default:
+		return r
+	}
+}
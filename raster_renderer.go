@@ -1,15 +1,37 @@
 package chart
 
 import (
+	"errors"
+	"fmt"
 	"image"
+	"image/jpeg"
 	"image/png"
 	"io"
 	"math"
 
 	"github.com/golang/freetype/truetype"
 	"github.com/wcharczuk/go-chart/drawing"
+	xdraw "golang.org/x/image/draw"
 )
 
+// Interface Assertions.
+var (
+	_ RasterRenderer      = (*rasterRenderer)(nil)
+	_ FloatPathRenderer   = (*rasterRenderer)(nil)
+	_ FloatCircleRenderer = (*rasterRenderer)(nil)
+	_ ImageRenderer       = (*rasterRenderer)(nil)
+	_ ScaledRenderer      = (*rasterRenderer)(nil)
+	_ ClipRenderer        = (*rasterRenderer)(nil)
+)
+
+// RasterRenderer is a renderer that draws to an in-memory image.
+type RasterRenderer interface {
+	Renderer
+
+	// Image returns the image the renderer draws to.
+	Image() *image.RGBA
+}
+
 // PNG returns a new png/raster renderer.
 func PNG(width, height int) (Renderer, error) {
 	i := image.NewRGBA(image.Rect(0, 0, width, height))
@@ -23,16 +45,89 @@ func PNG(width, height int) (Renderer, error) {
 	return nil, err
 }
 
+// PNGWithCompression returns a png renderer provider that encodes with a given compression level,
+// e.g. `png.BestCompression` for images that are stored.
+func PNGWithCompression(level png.CompressionLevel) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		r, err := PNG(width, height)
+		if err != nil {
+			return nil, err
+		}
+		r.(*rasterRenderer).compression = level
+		return r, nil
+	}
+}
+
+// JPEG returns a jpeg renderer provider with a given quality, from 1 to 100.
+// JPEGs aren't transparent, so transparent parts of the chart are white; see `JPEGWithMatte`.
+func JPEG(quality int) RendererProvider {
+	return JPEGWithMatte(quality, drawing.ColorWhite)
+}
+
+// JPEGWithMatte returns a jpeg renderer provider with a given quality, from 1 to 100, that flattens
+// transparent parts of the chart onto a matte color.
+func JPEGWithMatte(quality int, matte drawing.Color) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		if quality < 1 || quality > 100 {
+			return nil, fmt.Errorf("jpeg; quality must be from 1 to 100, got %d", quality)
+		}
+		r, err := PNG(width, height)
+		if err != nil {
+			return nil, err
+		}
+		r.(*rasterRenderer).jpeg = &jpegEncoding{quality: quality, matte: matte}
+		return r, nil
+	}
+}
+
+// jpegEncoding is how a raster renderer saves a jpeg.
+type jpegEncoding struct {
+	quality int
+	matte   drawing.Color
+}
+
+// encode flattens the image onto the matte color and encodes it.
+func (je jpegEncoding) encode(w io.Writer, i *image.RGBA) error {
+	flattened := image.NewRGBA(i.Bounds())
+	xdraw.Draw(flattened, flattened.Bounds(), image.NewUniform(je.matte), image.Point{}, xdraw.Src)
+	xdraw.Draw(flattened, flattened.Bounds(), i, i.Bounds().Min, xdraw.Over)
+	return jpeg.Encode(w, flattened, &jpeg.Options{Quality: je.quality})
+}
+
 // rasterRenderer renders chart commands to a bitmap.
 type rasterRenderer struct {
 	i  *image.RGBA
 	gc *drawing.RasterGraphicContext
 
+	// pool is where the image goes back to once it's saved, if the renderer came from a pool.
+	pool *PNGPool
+	// compression is the png compression level.
+	compression png.CompressionLevel
+	// jpeg is set if the renderer saves a jpeg rather than a png.
+	jpeg *jpegEncoding
+
+	// scale is the number of pixels per unit; zero is one.
+	scale         float64
 	rotateRadians *float64
 
+	// clips are the bounds of the clips begun and not yet ended, in pixels, innermost last.
+	clips []image.Rectangle
+
 	s Style
 }
 
+// Image returns the image the renderer draws to.
+func (rr *rasterRenderer) Image() *image.RGBA {
+	return rr.i
+}
+
+func (rr *rasterRenderer) contentType() string {
+	if rr.jpeg != nil {
+		return ContentTypeJPEG
+	}
+	return ContentTypePNG
+}
+
 func (rr *rasterRenderer) ResetStyle() {
 	rr.s = Style{Font: rr.s.Font}
 	rr.ClearTextRotation()
@@ -48,6 +143,34 @@ func (rr *rasterRenderer) SetDPI(dpi float64) {
 	rr.gc.SetDPI(dpi)
 }
 
+// SetScale implements the interface method.
+// The image is replaced with a cleared one of the scaled size, and everything is drawn through a scale transform.
+func (rr *rasterRenderer) SetScale(scale float64) {
+	size := rr.i.Bounds().Size()
+	width := int(math.Round(float64(size.X) / rr.getScale() * scale))
+	height := int(math.Round(float64(size.Y) / rr.getScale() * scale))
+
+	dpi := rr.gc.GetDPI()
+	if rr.pool != nil {
+		rr.pool.putImage(rr.i)
+		rr.i = rr.pool.getImage(width, height)
+	} else {
+		rr.i = image.NewRGBA(image.Rect(0, 0, width, height))
+	}
+	rr.gc, _ = drawing.NewRasterGraphicContext(rr.i)
+	rr.gc.SetDPI(dpi)
+	rr.scale = scale
+	rr.ClearTextRotation()
+}
+
+// getScale returns the number of pixels per unit.
+func (rr *rasterRenderer) getScale() float64 {
+	if rr.scale == 0 {
+		return 1
+	}
+	return rr.scale
+}
+
 // SetClassName implements the interface method. However, PNGs have no classes.
 func (rr *rasterRenderer) SetClassName(_ string) {}
 
@@ -73,12 +196,23 @@ func (rr *rasterRenderer) SetFillColor(c drawing.Color) {
 
 // MoveTo implements the interface method.
 func (rr *rasterRenderer) MoveTo(x, y int) {
-	rr.gc.MoveTo(float64(x), float64(y))
+	rr.MoveToFloat(float64(x), float64(y))
 }
 
 // LineTo implements the interface method.
 func (rr *rasterRenderer) LineTo(x, y int) {
-	rr.gc.LineTo(float64(x), float64(y))
+	rr.LineToFloat(float64(x), float64(y))
+}
+
+// MoveToFloat implements the interface method.
+func (rr *rasterRenderer) MoveToFloat(x, y float64) {
+	rr.gc.MoveTo(x, y)
+}
+
+// LineToFloat implements the interface method.
+// The rasterizer works in sub pixels, so the line is anti-aliased across the pixels it passes through.
+func (rr *rasterRenderer) LineToFloat(x, y float64) {
+	rr.gc.LineTo(x, y)
 }
 
 // QuadCurveTo implements the interface method.
@@ -97,7 +231,12 @@ func (rr *rasterRenderer) Close() {
 }
 
 // Stroke implements the interface method.
+// A fully transparent stroke isn't drawn.
 func (rr *rasterRenderer) Stroke() {
+	if rr.s.StrokeColor.IsTransparent() {
+		rr.gc.BeginPath()
+		return
+	}
 	rr.gc.SetStrokeColor(rr.s.StrokeColor)
 	rr.gc.SetLineWidth(rr.s.StrokeWidth)
 	rr.gc.SetLineDash(rr.s.StrokeDashArray, 0)
@@ -105,13 +244,27 @@ func (rr *rasterRenderer) Stroke() {
 }
 
 // Fill implements the interface method.
+// A fully transparent fill isn't drawn.
 func (rr *rasterRenderer) Fill() {
+	if rr.s.FillColor.IsTransparent() {
+		rr.gc.BeginPath()
+		return
+	}
 	rr.gc.SetFillColor(rr.s.FillColor)
 	rr.gc.Fill()
 }
 
 // FillStroke implements the interface method.
+// Only the visible parts are drawn, so a fully transparent fill or stroke costs nothing.
 func (rr *rasterRenderer) FillStroke() {
+	if rr.s.FillColor.IsTransparent() {
+		rr.Stroke()
+		return
+	}
+	if rr.s.StrokeColor.IsTransparent() {
+		rr.Fill()
+		return
+	}
 	rr.gc.SetFillColor(rr.s.FillColor)
 	rr.gc.SetStrokeColor(rr.s.StrokeColor)
 	rr.gc.SetLineWidth(rr.s.StrokeWidth)
@@ -121,14 +274,14 @@ func (rr *rasterRenderer) FillStroke() {
 
 // Circle fully draws a circle at a given point but does not apply the fill or stroke.
 func (rr *rasterRenderer) Circle(radius float64, x, y int) {
-	xf := float64(x)
-	yf := float64(y)
+	rr.CircleFloat(radius, float64(x), float64(y))
+}
 
-	rr.gc.MoveTo(xf-radius, yf)                            //9
-	rr.gc.QuadCurveTo(xf-radius, yf-radius, xf, yf-radius) //12
-	rr.gc.QuadCurveTo(xf+radius, yf-radius, xf+radius, yf) //3
-	rr.gc.QuadCurveTo(xf+radius, yf+radius, xf, yf+radius) //6
-	rr.gc.QuadCurveTo(xf-radius, yf+radius, xf-radius, yf) //9
+// CircleFloat implements the interface method.
+func (rr *rasterRenderer) CircleFloat(radius, x, y float64) {
+	rr.gc.MoveTo(x+radius, y)
+	rr.gc.ArcTo(x, y, radius, radius, 0, _2pi)
+	rr.gc.Close()
 }
 
 // SetFont implements the interface method.
@@ -150,6 +303,7 @@ func (rr *rasterRenderer) SetFontColor(c drawing.Color) {
 func (rr *rasterRenderer) Text(body string, x, y int) {
 	xf, yf := rr.getCoords(x, y)
 	rr.gc.SetFont(rr.s.Font)
+	rr.gc.SetFallbackFonts(getFallbackFonts())
 	rr.gc.SetFontSize(rr.s.FontSize)
 	rr.gc.SetFillColor(rr.s.FontColor)
 	rr.gc.CreateStringPath(body, float64(xf), float64(yf))
@@ -159,8 +313,32 @@ func (rr *rasterRenderer) Text(body string, x, y int) {
 // MeasureText returns the height and width in pixels of a string.
 func (rr *rasterRenderer) MeasureText(body string) Box {
 	rr.gc.SetFont(rr.s.Font)
+	rr.gc.SetFallbackFonts(getFallbackFonts())
 	rr.gc.SetFontSize(rr.s.FontSize)
 	rr.gc.SetFillColor(rr.s.FontColor)
+
+	var textBox Box
+	if rr.s.Font == nil {
+		textBox = rr.measureText(body)
+	} else {
+		textBox = _textMeasureCache.measure(textMeasureKey{
+			font: rr.s.Font,
+			size: rr.s.FontSize,
+			dpi:  rr.gc.GetDPI(),
+			text: body,
+		}, func() Box {
+			return rr.measureText(body)
+		})
+	}
+	if rr.rotateRadians == nil {
+		return textBox
+	}
+
+	return textBox.Corners().Rotate(RadiansToDegrees(*rr.rotateRadians)).Box()
+}
+
+// measureText measures the unrotated bounds of a string with the font set on the graphic context.
+func (rr *rasterRenderer) measureText(body string) Box {
 	l, t, r, b, err := rr.gc.GetStringBounds(body)
 	if err != nil {
 		return Box{}
@@ -184,17 +362,12 @@ func (rr *rasterRenderer) MeasureText(body string) Box {
 		t = 0
 	}
 
-	textBox := Box{
+	return Box{
 		Top:    int(math.Ceil(t)),
 		Left:   int(math.Ceil(l)),
 		Right:  int(math.Ceil(r)),
 		Bottom: int(math.Ceil(b)),
 	}
-	if rr.rotateRadians == nil {
-		return textBox
-	}
-
-	return textBox.Corners().Rotate(RadiansToDegrees(*rr.rotateRadians)).Box()
 }
 
 // SetTextRotation sets a text rotation.
@@ -214,17 +387,78 @@ func (rr *rasterRenderer) getCoords(x, y int) (xf, yf int) {
 	return
 }
 
+// DrawImage implements the interface method.
+// The image is composited over what's drawn already, and resampled if it isn't the size of the box.
+func (rr *rasterRenderer) DrawImage(img image.Image, box Box) {
+	scale := rr.getScale()
+	target := image.Rect(
+		int(math.Round(float64(box.Left)*scale)), int(math.Round(float64(box.Top)*scale)),
+		int(math.Round(float64(box.Right)*scale)), int(math.Round(float64(box.Bottom)*scale)),
+	)
+	dst := rr.i.SubImage(rr.getClipBounds()).(*image.RGBA)
+	if target.Size() == img.Bounds().Size() {
+		xdraw.Draw(dst, target, img, img.Bounds().Min, xdraw.Over)
+		return
+	}
+	xdraw.CatmullRom.Scale(dst, target, img, img.Bounds(), xdraw.Over, nil)
+}
+
+// BeginClip implements the interface method.
+// Everything drawn until the clip ends is painted onto the part of the image within it, so nothing is restored after.
+func (rr *rasterRenderer) BeginClip(box Box) {
+	scale := rr.getScale()
+	bounds := image.Rect(
+		int(math.Floor(float64(box.Left)*scale)), int(math.Floor(float64(box.Top)*scale)),
+		int(math.Ceil(float64(box.Right)*scale)), int(math.Ceil(float64(box.Bottom)*scale)),
+	).Intersect(rr.getClipBounds())
+	rr.clips = append(rr.clips, bounds)
+	rr.gc.ClipTo(bounds)
+}
+
+// EndClip implements the interface method.
+func (rr *rasterRenderer) EndClip() {
+	if len(rr.clips) == 0 {
+		return
+	}
+	rr.clips = rr.clips[:len(rr.clips)-1]
+	rr.gc.ClipTo(rr.getClipBounds())
+}
+
+// getClipBounds returns the part of the image that's drawn onto, in pixels; all of it unless there's a clip.
+func (rr *rasterRenderer) getClipBounds() image.Rectangle {
+	if len(rr.clips) == 0 {
+		return rr.i.Bounds()
+	}
+	return rr.clips[len(rr.clips)-1]
+}
+
 // ClearTextRotation clears text rotation.
 func (rr *rasterRenderer) ClearTextRotation() {
-	rr.gc.SetMatrixTransform(drawing.NewIdentityMatrix())
+	rr.gc.SetMatrixTransform(drawing.NewScaleMatrix(rr.getScale(), rr.getScale()))
 	rr.rotateRadians = nil
 }
 
 // Save implements the interface method.
+// The image is encoded as a png, or a jpeg if the renderer came from `JPEG`; an `RGBACollector` gets it as is.
 func (rr *rasterRenderer) Save(w io.Writer) error {
+	if rr.i == nil {
+		return errors.New("raster renderer; already saved to its pool")
+	}
 	if typed, isTyped := w.(RGBACollector); isTyped {
 		typed.SetRGBA(rr.i)
 		return nil
 	}
-	return png.Encode(w, rr.i)
+	if rr.jpeg != nil {
+		return rr.jpeg.encode(w, rr.i)
+	}
+	encoder := png.Encoder{CompressionLevel: rr.compression}
+	if rr.pool == nil {
+		return encoder.Encode(w, rr.i)
+	}
+
+	encoder.BufferPool = rr.pool
+	err := encoder.Encode(w, rr.i)
+	rr.pool.putImage(rr.i)
+	rr.i = nil
+	return err
 }
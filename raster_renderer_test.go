@@ -0,0 +1,319 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestRasterRendererSave(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(320, 240)
+	assert.Nil(err)
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+
+	img, err := png.Decode(buffer)
+	assert.Nil(err)
+	assert.Equal(320, img.Bounds().Dx())
+	assert.Equal(240, img.Bounds().Dy())
+}
+
+func TestRasterRendererMeasureTextFontSize(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	r, err := PNG(320, 240)
+	assert.Nil(err)
+	r.SetFont(f)
+
+	r.SetFontSize(10)
+	small := r.MeasureText("test string")
+	r.SetFontSize(20)
+	large := r.MeasureText("test string")
+
+	assert.NotZero(small.Width())
+	assert.True(large.Width() > small.Width())
+	assert.True(large.Height() > small.Height())
+}
+
+func TestRasterRendererChartFills(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Width:      320,
+		Height:     240,
+		Background: Style{FillColor: drawing.ColorRed, Padding: NewBox(20, 20, 20, 20)},
+		Canvas:     Style{FillColor: drawing.ColorBlue},
+		XAxis:      HideXAxis(),
+		YAxis:      HideYAxis(),
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(PNG, buffer))
+
+	img, err := png.Decode(buffer)
+	assert.Nil(err)
+	assert.Equal(320, img.Bounds().Dx())
+	assert.Equal(240, img.Bounds().Dy())
+
+	assert.Equal(drawing.ColorRed, drawing.ColorFromAlphaMixedRGBA(img.At(5, 5).RGBA()))
+	assert.Equal(drawing.ColorBlue, drawing.ColorFromAlphaMixedRGBA(img.At(160, 60).RGBA()))
+}
+
+func TestRasterRendererImage(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(10, 10)
+	assert.Nil(err)
+	rr, isRaster := r.(RasterRenderer)
+	assert.True(isRaster)
+
+	Draw.Box(rr, Box{Top: 0, Left: 0, Right: 10, Bottom: 10}, Style{FillColor: drawing.ColorRed})
+	assert.Equal(10, rr.Image().Bounds().Dx())
+	red, _, _, _ := rr.Image().At(5, 5).RGBA()
+	assert.Equal(uint32(0xffff), red)
+}
+
+func TestRasterRendererAlpha(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(20, 20)
+	assert.Nil(err)
+	square := func(c drawing.Color) {
+		r.SetFillColor(c)
+		r.MoveTo(0, 0)
+		r.LineTo(20, 0)
+		r.LineTo(20, 20)
+		r.LineTo(0, 20)
+		r.Close()
+		r.Fill()
+	}
+	img := r.(RasterRenderer).Image()
+
+	square(drawing.ColorWhite)
+	// half transparent red is blended over the white.
+	square(drawing.Color{R: 255, A: 128})
+	assert.Equal(drawing.Color{R: 255, G: 127, B: 127, A: 255}, drawing.ColorFromAlphaMixedRGBA(img.At(10, 10).RGBA()))
+
+	// transparent fills and strokes leave the image, and the path, as they were.
+	r.SetStrokeColor(drawing.ColorTransparent)
+	r.SetStrokeWidth(5)
+	square(drawing.ColorBlue.WithAlpha(0))
+	r.MoveTo(0, 0)
+	r.LineTo(20, 20)
+	r.FillStroke()
+	r.Stroke()
+	assert.Equal(drawing.Color{R: 255, G: 127, B: 127, A: 255}, drawing.ColorFromAlphaMixedRGBA(img.At(10, 10).RGBA()))
+
+	r.SetStrokeColor(drawing.ColorBlack)
+	r.SetStrokeWidth(2)
+	r.MoveTo(0, 5)
+	r.LineTo(20, 5)
+	r.Stroke()
+	assert.Equal(drawing.Color{R: 255, G: 127, B: 127, A: 255}, drawing.ColorFromAlphaMixedRGBA(img.At(10, 15).RGBA()))
+	assert.Equal(drawing.ColorBlack, drawing.ColorFromAlphaMixedRGBA(img.At(10, 5).RGBA()))
+}
+
+// sparklineDeviation draws a shallow line through many points on a 100x40 sparkline,
+// and returns how far the center of the stroke in each pixel column is from the line on average.
+func sparklineDeviation(t *testing.T, xrange, yrange Range) float64 {
+	r, err := PNG(100, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slope := func(x float64) float64 { return 0.12 * x }
+	series := ContinuousSeries{
+		XValues: LinearRange(0, 100),
+		YValues: make([]float64, 101),
+	}
+	for index, x := range series.XValues {
+		series.YValues[index] = slope(x)
+	}
+	canvasBox := Box{Top: 0, Left: 0, Right: 100, Bottom: 40}
+	Draw.LineSeries(r, canvasBox, xrange, yrange, Style{StrokeColor: drawing.ColorBlack, StrokeWidth: 1}, series)
+
+	img := r.(RasterRenderer).Image()
+	var total float64
+	var columns int
+	for x := 10; x < 90; x++ {
+		var weight, center float64
+		for y := 0; y < 40; y++ {
+			alpha := float64(img.RGBAAt(x, y).A)
+			weight += alpha
+			center += alpha * (float64(y) + 0.5)
+		}
+		if weight == 0 {
+			continue
+		}
+		total += math.Abs(center/weight - (40 - slope(float64(x)+0.5)))
+		columns++
+	}
+	return total / float64(columns)
+}
+
+func TestRasterRendererSubpixelLines(t *testing.T) {
+	assert := assert.New(t)
+
+	before := sparklineDeviation(t,
+		wholePixelRange{&ContinuousRange{Min: 0, Max: 100, Domain: 100}},
+		wholePixelRange{&ContinuousRange{Min: 0, Max: 40, Domain: 40}})
+	after := sparklineDeviation(t,
+		&ContinuousRange{Min: 0, Max: 100, Domain: 100},
+		&ContinuousRange{Min: 0, Max: 40, Domain: 40})
+
+	// snapped to whole pixels the line is a staircase; between pixels it follows the values.
+	assert.True(after < before/2, fmt.Sprintf("before: %0.3f after: %0.3f", before, after))
+}
+
+func TestRasterRendererScaleFactor(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Title:  "Scaled",
+		Width:  320,
+		Height: 240,
+		Series: []Series{
+			ContinuousSeries{XValues: LinearRange(0, 10), YValues: LinearRange(0, 10)},
+		},
+	}
+	// dark returns the bounds of the text and axes.
+	dark := func(c Chart) (bounds Box) {
+		img, err := c.RenderImage(PNG)
+		assert.Nil(err)
+		bounds = Box{Top: math.MaxInt32, Left: math.MaxInt32}
+		for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+			for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+				if r, _, _, _ := img.At(x, y).RGBA(); r < 0x8000 {
+					bounds = Box{Top: MinInt(bounds.Top, y), Left: MinInt(bounds.Left, x), Right: MaxInt(bounds.Right, x), Bottom: MaxInt(bounds.Bottom, y)}
+				}
+			}
+		}
+		return
+	}
+	single := dark(graph)
+
+	graph.ScaleFactor = 2
+	img, err := graph.RenderImage(PNG)
+	assert.Nil(err)
+	assert.Equal(640, img.Bounds().Dx())
+	assert.Equal(480, img.Bounds().Dy())
+
+	// everything is drawn in proportion, text included.
+	double := dark(graph)
+	for _, edges := range [][2]int{{single.Top, double.Top}, {single.Left, double.Left}, {single.Right, double.Right}, {single.Bottom, double.Bottom}} {
+		assert.True(math.Abs(float64(2*edges[0]-edges[1])) <= 2, fmt.Sprintf("%v scaled to %v", single, double))
+	}
+
+	// renderers from a pool are scaled too, and vector images are the logical size.
+	pool := &PNGPool{}
+	img, err = graph.RenderImage(pool.PNG)
+	assert.Nil(err)
+	assert.Equal(640, img.Bounds().Dx())
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(SVG, buffer))
+	assert.Contains(buffer.String(), `width="320" height="240"`)
+
+	graph.ScaleFactor = -1
+	assert.NotNil(graph.Validate())
+}
+
+func TestRasterRendererEncodings(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Width:      320,
+		Height:     240,
+		Background: Style{FillColor: ColorTransparent},
+		Canvas:     Style{FillColor: ColorTransparent},
+		Series: []Series{
+			ContinuousSeries{XValues: LinearRange(0, 10), YValues: LinearRange(0, 10)},
+		},
+	}
+
+	none, best := bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(PNGWithCompression(png.NoCompression), none))
+	assert.Nil(graph.Render(PNGWithCompression(png.BestCompression), best))
+	assert.True(best.Len() < none.Len())
+	_, err := png.Decode(best)
+	assert.Nil(err)
+
+	// the transparent background is flattened onto the matte color.
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(JPEGWithMatte(90, drawing.ColorRed), buffer))
+	img, err := jpeg.Decode(buffer)
+	assert.Nil(err)
+	assert.Equal(320, img.Bounds().Dx())
+	r, g, b, _ := img.At(1, 1).RGBA()
+	assert.True(r > 0xf000 && g < 0x1000 && b < 0x1000)
+
+	renderer, err := JPEG(80)(320, 240)
+	assert.Nil(err)
+	assert.Equal(ContentTypeJPEG, renderer.(*rasterRenderer).contentType())
+	_, err = JPEG(0)(320, 240)
+	assert.NotNil(err)
+	_, err = JPEG(101)(320, 240)
+	assert.NotNil(err)
+}
+
+func TestRasterRendererClip(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(40, 40)
+	assert.Nil(err)
+	rr := r.(*rasterRenderer)
+	fill := func() {
+		Draw.Box(r, NewBox(0, 0, 40, 40), Style{FillColor: drawing.ColorRed})
+	}
+
+	// clips nest, and what's outside of them is left as it was.
+	rr.BeginClip(NewBox(0, 0, 20, 40))
+	rr.BeginClip(NewBox(10, 10, 30, 30))
+	fill()
+	rr.EndClip()
+	rr.EndClip()
+
+	_, _, _, alpha := rr.Image().At(15, 15).RGBA()
+	assert.NotZero(alpha)
+	for _, p := range [][2]int{{5, 5}, {15, 5}, {25, 15}, {35, 35}} {
+		_, _, _, alpha = rr.Image().At(p[0], p[1]).RGBA()
+		assert.Zero(alpha, fmt.Sprintf("%v", p))
+	}
+
+	// images are clipped too.
+	img := image.NewRGBA(image.Rect(0, 0, 40, 40))
+	for index := range img.Pix {
+		img.Pix[index] = 0xff
+	}
+	rr.BeginClip(NewBox(30, 30, 40, 40))
+	rr.DrawImage(img, NewBox(0, 0, 40, 40))
+	rr.EndClip()
+	_, _, blue, _ := rr.Image().At(35, 35).RGBA()
+	assert.NotZero(blue)
+	_, _, blue, _ = rr.Image().At(15, 15).RGBA()
+	assert.Zero(blue)
+
+	// ending a clip that wasn't begun does nothing.
+	rr.EndClip()
+	fill()
+	_, _, _, alpha = rr.Image().At(35, 35).RGBA()
+	assert.NotZero(alpha)
+}
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"image"
 	"io"
 
 	"github.com/golang/freetype/truetype"
@@ -8,6 +9,9 @@ import (
 )
 
 // Renderer represents the basic methods required to draw a chart.
+// Renderers can implement optional interfaces to draw more precisely or more; see `FloatPathRenderer`,
+// `FloatCircleRenderer`, `ImageRenderer`, `ScaledRenderer`, `GroupRenderer` and `TooltipRenderer`. Charts check for them, and fall back to the methods here
+// (e.g. rounding to whole pixels) for renderers that don't implement them.
 type Renderer interface {
 	// ResetStyle should reset any style related settings on the renderer.
 	ResetStyle()
@@ -87,3 +91,67 @@ type Renderer interface {
 	// Save writes the image to the given writer.
 	Save(w io.Writer) error
 }
+
+// FloatPathRenderer is a renderer that can draw paths through fractional coordinates.
+// The raster renderer anti-aliases them across neighboring pixels; the vector renderer writes them as is.
+type FloatPathRenderer interface {
+	// MoveToFloat moves the cursor to a given point.
+	MoveToFloat(x, y float64)
+
+	// LineToFloat draws a line from the previous point to a given point.
+	LineToFloat(x, y float64)
+}
+
+// FloatCircleRenderer is a renderer that can draw circles centered between pixels, e.g. dots on a line
+// drawn through fractional coordinates.
+type FloatCircleRenderer interface {
+	// CircleFloat draws a circle at the given coords with a given radius.
+	CircleFloat(radius, x, y float64)
+}
+
+// ImageRenderer is a renderer that can draw raster images, e.g. a logo.
+// The raster renderer composites them over what's drawn already; the vector renderer embeds them as data URIs.
+type ImageRenderer interface {
+	// DrawImage draws an image scaled to a given box; anything outside of the renderer is clipped.
+	DrawImage(img image.Image, box Box)
+}
+
+// ScaledRenderer is a renderer that can draw at a multiple of its size, e.g. a raster image for high resolution
+// displays. Everything is still drawn and measured in the units of its size, so the layout doesn't change.
+type ScaledRenderer interface {
+	// SetScale sets the number of pixels per unit; it's set before anything is drawn.
+	SetScale(scale float64)
+}
+
+// GroupRenderer is a renderer that can group what's drawn, e.g. svg groups for stylesheets and scripts.
+// Charts draw each of their parts, like the canvas, an axis or a series, in a group.
+type GroupRenderer interface {
+	// BeginGroup starts a group of what's drawn next. The name is unique to the part of the chart, e.g. "series-0",
+	// and the classes are shared by parts alike, e.g. "series".
+	BeginGroup(name string, classes ...string)
+
+	// EndGroup ends the group begun last.
+	EndGroup()
+}
+
+// scopedGroupRenderer is a group renderer that can scope the ids of groups, so the parts of charts drawn
+// onto one renderer, like the cells of a `Grid`, have ids of their own; their classes are the same in any scope.
+type scopedGroupRenderer interface {
+	// beginScopedGroup starts a group like `BeginGroup`, with the scope, e.g. "chart-0-", at the start of its id.
+	beginScopedGroup(scope, name string, classes ...string)
+}
+
+// ClipRenderer is a renderer that can clip what's drawn to a box, e.g. a chart to its cell in a `Grid`.
+type ClipRenderer interface {
+	// BeginClip clips what's drawn next to a box, within any clip begun before it.
+	BeginClip(box Box)
+
+	// EndClip ends the clip begun last.
+	EndClip()
+}
+
+// TooltipRenderer is a renderer that can show text when hovering over what's drawn, e.g. svg `<title>`s.
+type TooltipRenderer interface {
+	// SetTooltip sets the tooltip of the shapes drawn next; an empty tooltip clears it.
+	SetTooltip(text string)
+}
@@ -0,0 +1,277 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*RollingSeries)(nil)
+	_ FirstValuesProvider = (*RollingSeries)(nil)
+	_ LastValuesProvider  = (*RollingSeries)(nil)
+	_ BoundsProvider      = (*RollingSeries)(nil)
+)
+
+// NewRollingSeries returns a new rolling series with the given name, that keeps the last `capacity` values pushed to it.
+func NewRollingSeries(name string, capacity int) *RollingSeries {
+	capacity = MaxInt(capacity, 0)
+	rs := &RollingSeries{
+		Name:    name,
+		xvalues: make([]float64, capacity),
+		yvalues: make([]float64, capacity),
+	}
+	rs.minx = newRollingExtremes(capacity, rs.xvalues, false)
+	rs.maxx = newRollingExtremes(capacity, rs.xvalues, true)
+	rs.miny = newRollingExtremes(capacity, rs.yvalues, false)
+	rs.maxy = newRollingExtremes(capacity, rs.yvalues, true)
+	return rs
+}
+
+// RollingSeries is a line through the latest values of a stream, e.g. the last minute of samples taken ten times a second,
+// for charts that are rendered again as values arrive. Pushing a value once the series is full drops the oldest one.
+// The values are kept in a ring buffer, and their bounds are kept up to date as they're pushed, so neither pushing
+// nor finding the ranges of the chart scans the values or allocates; rendering draws each of them once.
+// Use `Chart.SetRollingXRange` to show a fixed span of x values, like the last minute, as the series fills up.
+//
+// Values can be pushed from any goroutine, including while the chart renders; the series is drawn with the values
+// it has when it starts drawing, and pushes wait until it's done. The ranges of the chart are found before the series
+// is drawn though, so values pushed in between can fall outside of them and are clipped to the canvas.
+// Set the name, style and formatters before the series is pushed to or rendered concurrently.
+type RollingSeries struct {
+	Name  string
+	Style Style
+
+	YAxis YAxisType
+
+	XValueFormatter ValueFormatter
+	YValueFormatter ValueFormatter
+
+	lock    sync.RWMutex
+	xvalues []float64
+	yvalues []float64
+	// pushed is the number of values ever pushed; the nth value pushed is at n modulo the capacity while it's in the series.
+	pushed int
+
+	minx, maxx, miny, maxy rollingExtremes
+}
+
+// Push adds a value to the end of the series, dropping the oldest value if the series is full.
+// Non-finite values are missing data, and leave a gap in the line.
+func (rs *RollingSeries) Push(x, y float64) {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+
+	capacity := len(rs.xvalues)
+	if capacity == 0 {
+		return
+	}
+	index := rs.pushed
+	rs.pushed++
+
+	oldest := MaxInt(0, rs.pushed-capacity)
+	for _, re := range []*rollingExtremes{&rs.minx, &rs.maxx, &rs.miny, &rs.maxy} {
+		re.evict(oldest)
+	}
+	rs.xvalues[index%capacity], rs.yvalues[index%capacity] = x, y
+	if isFinite(x) && isFinite(y) {
+		for _, re := range []*rollingExtremes{&rs.minx, &rs.maxx, &rs.miny, &rs.maxy} {
+			re.push(index)
+		}
+	}
+}
+
+// Clear removes all the values from the series.
+func (rs *RollingSeries) Clear() {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+
+	rs.pushed = 0
+	for _, re := range []*rollingExtremes{&rs.minx, &rs.maxx, &rs.miny, &rs.maxy} {
+		re.head, re.size = 0, 0
+	}
+}
+
+// Capacity returns the number of values the series keeps.
+func (rs *RollingSeries) Capacity() int {
+	return len(rs.xvalues)
+}
+
+// GetName returns the name of the series.
+func (rs *RollingSeries) GetName() string {
+	return rs.Name
+}
+
+// GetStyle returns the line style.
+func (rs *RollingSeries) GetStyle() Style {
+	return rs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (rs *RollingSeries) GetYAxis() YAxisType {
+	return rs.YAxis
+}
+
+// Len returns the number of values in the series.
+func (rs *RollingSeries) Len() int {
+	rs.lock.RLock()
+	defer rs.lock.RUnlock()
+	return rs.len()
+}
+
+// GetValues gets the x,y values at a given index, oldest first.
+// It returns zero values if the index is out of bounds.
+func (rs *RollingSeries) GetValues(index int) (x, y float64) {
+	rs.lock.RLock()
+	defer rs.lock.RUnlock()
+	return rs.getValues(index)
+}
+
+// GetFirstValues gets the oldest x,y values.
+func (rs *RollingSeries) GetFirstValues() (x, y float64) {
+	rs.lock.RLock()
+	defer rs.lock.RUnlock()
+	return rs.getValues(0)
+}
+
+// GetLastValues gets the latest x,y values.
+func (rs *RollingSeries) GetLastValues() (x, y float64) {
+	rs.lock.RLock()
+	defer rs.lock.RUnlock()
+	return rs.getValues(rs.len() - 1)
+}
+
+// GetBounds returns the bounds of the finite values of the series.
+func (rs *RollingSeries) GetBounds() (minx, maxx, miny, maxy float64) {
+	rs.lock.RLock()
+	defer rs.lock.RUnlock()
+	return rs.minx.value(), rs.maxx.value(), rs.miny.value(), rs.maxy.value()
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (rs *RollingSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if rs.XValueFormatter != nil {
+		x = rs.XValueFormatter
+	} else {
+		x = FloatValueFormatter
+	}
+	if rs.YValueFormatter != nil {
+		y = rs.YValueFormatter
+	} else {
+		y = defaultValueFormatter
+	}
+	return
+}
+
+// Render renders the series with the values it has when it starts; pushes wait until it's done.
+func (rs *RollingSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	rs.lock.RLock()
+	defer rs.lock.RUnlock()
+
+	style := rs.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, rollingSeriesValues{rs})
+}
+
+// Validate validates the series.
+func (rs *RollingSeries) Validate() error {
+	if len(rs.xvalues) == 0 {
+		return fmt.Errorf("rolling series; must have a capacity, see `NewRollingSeries`")
+	}
+	return nil
+}
+
+// len returns the number of values in the series; the series must be locked.
+func (rs *RollingSeries) len() int {
+	return MinInt(rs.pushed, len(rs.xvalues))
+}
+
+// getValues gets the x,y values at a given index; the series must be locked.
+func (rs *RollingSeries) getValues(index int) (x, y float64) {
+	if index < 0 || index >= rs.len() {
+		return
+	}
+	slot := (rs.pushed - rs.len() + index) % len(rs.xvalues)
+	return rs.xvalues[slot], rs.yvalues[slot]
+}
+
+// rollingSeriesValues are the values of a rolling series that's locked already, e.g. while it renders.
+type rollingSeriesValues struct {
+	*RollingSeries
+}
+
+func (rsv rollingSeriesValues) Len() int {
+	return rsv.len()
+}
+
+func (rsv rollingSeriesValues) GetValues(index int) (x, y float64) {
+	return rsv.getValues(index)
+}
+
+// SetRollingXRange sets the x range to the last `window` of x values up to the greatest one of a rolling series,
+// e.g. the last 60 seconds of a series of timestamps, so the values aren't scanned for it and it doesn't grow
+// while the series fills up. Call it before each render; a range the chart has already is updated rather than replaced.
+// The y ranges come from the bounds of every value in the series, so size its capacity to the window.
+// The range is left as it is while the series has no finite values.
+// It changes the chart, so call it between renders rather than while the chart renders.
+func (c *Chart) SetRollingXRange(rs *RollingSeries, window float64) {
+	_, maxx, _, _ := rs.GetBounds()
+	if !isFinite(maxx) {
+		return
+	}
+	if c.XAxis.Range == nil {
+		c.XAxis.Range = &ContinuousRange{}
+	}
+	c.XAxis.Range.SetMin(maxx - window)
+	c.XAxis.Range.SetMax(maxx)
+}
+
+// rollingExtremes keeps the least, or greatest, of the values in a sliding window in constant amortized time per value.
+// It holds the indexes of the values that can still become the extreme as older values leave the window, i.e. that
+// no later value beats, in the order they were pushed; the first of them is the extreme.
+type rollingExtremes struct {
+	values   []float64
+	greatest bool
+
+	indexes []int
+	head    int
+	size    int
+}
+
+func newRollingExtremes(capacity int, values []float64, greatest bool) rollingExtremes {
+	return rollingExtremes{
+		values:   values,
+		greatest: greatest,
+		indexes:  make([]int, capacity),
+	}
+}
+
+// evict drops the indexes from before the oldest value in the window.
+func (re *rollingExtremes) evict(oldest int) {
+	for re.size > 0 && re.indexes[re.head] < oldest {
+		re.head = (re.head + 1) % len(re.indexes)
+		re.size--
+	}
+}
+
+// push adds the index of the latest value, dropping the indexes of the values it beats or equals.
+func (re *rollingExtremes) push(index int) {
+	value := re.values[index%len(re.values)]
+	for re.size > 0 {
+		last := re.values[re.indexes[(re.head+re.size-1)%len(re.indexes)]%len(re.values)]
+		if (re.greatest && last > value) || (!re.greatest && last < value) {
+			break
+		}
+		re.size--
+	}
+	re.indexes[(re.head+re.size)%len(re.indexes)] = index
+	re.size++
+}
+
+// value returns the extreme value, or NaN if there isn't one.
+func (re *rollingExtremes) value() float64 {
+	if re.size == 0 {
+		return math.NaN()
+	}
+	return re.values[re.indexes[re.head]%len(re.values)]
+}
@@ -0,0 +1,143 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestRollingSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	rs := NewRollingSeries("samples", 3)
+	assert.Equal(3, rs.Capacity())
+	assert.Equal(0, rs.Len())
+	minx, _, _, _ := rs.GetBounds()
+	assert.True(math.IsNaN(minx))
+
+	for index := 0; index < 5; index++ {
+		rs.Push(float64(index), float64(index*10))
+	}
+	assert.Equal(3, rs.Len())
+	for index := 0; index < rs.Len(); index++ {
+		x, y := rs.GetValues(index)
+		assert.Equal(float64(index+2), x)
+		assert.Equal(float64((index+2)*10), y)
+	}
+	x, y := rs.GetFirstValues()
+	assert.Equal(2.0, x)
+	assert.Equal(20.0, y)
+	x, y = rs.GetLastValues()
+	assert.Equal(4.0, x)
+	assert.Equal(40.0, y)
+	x, y = rs.GetValues(3)
+	assert.Zero(x)
+	assert.Zero(y)
+
+	rs.Clear()
+	assert.Equal(0, rs.Len())
+	rs.Push(7, 70)
+	minx, maxx, miny, maxy := rs.GetBounds()
+	assert.Equal([]float64{7, 7, 70, 70}, []float64{minx, maxx, miny, maxy})
+
+	assert.NotNil((&RollingSeries{}).Validate())
+	assert.Nil(rs.Validate())
+}
+
+func TestRollingSeriesBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	// the bounds kept as values are pushed are the bounds of the values in the series.
+	random := rand.New(rand.NewSource(1))
+	rs := NewRollingSeries("", 16)
+	for index := 0; index < 500; index++ {
+		x, y := random.Float64()*100, random.Float64()*100
+		if index%7 == 3 {
+			y = math.NaN()
+		}
+		rs.Push(x, y)
+
+		xvalues, yvalues := make([]float64, rs.Len()), make([]float64, rs.Len())
+		for valueIndex := range xvalues {
+			xvalues[valueIndex], yvalues[valueIndex] = rs.GetValues(valueIndex)
+		}
+		eminx, emaxx, eminy, emaxy := bounds(xvalues, yvalues)
+		minx, maxx, miny, maxy := rs.GetBounds()
+		assert.Equal([]float64{eminx, emaxx, eminy, emaxy}, []float64{minx, maxx, miny, maxy})
+	}
+}
+
+func TestRollingSeriesAllocations(t *testing.T) {
+	assert := assert.New(t)
+
+	rs := NewRollingSeries("", 600)
+	var x float64
+	assert.Zero(testing.AllocsPerRun(1000, func() {
+		x += 0.1
+		rs.Push(x, math.Sin(x))
+		rs.GetBounds()
+	}))
+}
+
+func TestRollingSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	rs := NewRollingSeries("samples", 600)
+	c := Chart{Series: []Series{rs}}
+	c.SetRollingXRange(rs, 60)
+	assert.Nil(c.XAxis.Range)
+
+	for index := 0; index < 1000; index++ {
+		rs.Push(float64(index)/10, float64(index%50))
+	}
+	c.SetRollingXRange(rs, 60)
+	assert.InDelta(39.9, c.XAxis.Range.GetMin(), 1e-9)
+	assert.Equal(99.9, c.XAxis.Range.GetMax())
+	xrange, yrange, _ := c.getRanges()
+	assert.InDelta(39.9, xrange.GetMin(), 1e-9)
+	assert.Equal(99.9, xrange.GetMax())
+	assert.Equal(0.0, yrange.GetMin())
+
+	// the range is updated in place.
+	xr := c.XAxis.Range
+	rs.Push(100, 1)
+	c.SetRollingXRange(rs, 60)
+	assert.True(xr == c.XAxis.Range)
+	assert.Equal(40.0, c.XAxis.Range.GetMin())
+
+	// pushing while rendering is safe.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for index := 0; index < 1000; index++ {
+			rs.Push(100+float64(index)/10, float64(index%50))
+		}
+	}()
+	for frame := 0; frame < 5; frame++ {
+		c.SetRollingXRange(rs, 60)
+		assert.Nil(c.Render(SVG, bytes.NewBuffer(nil)))
+	}
+	wg.Wait()
+}
+
+func BenchmarkRollingSeriesRender(b *testing.B) {
+	rs := NewRollingSeries("samples", 600)
+	c := Chart{Series: []Series{rs}}
+	for index := 0; index < 600; index++ {
+		rs.Push(float64(index)/10, math.Sin(float64(index)/10))
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		rs.Push(float64(600+n)/10, math.Sin(float64(600+n)/10))
+		c.SetRollingXRange(rs, 60)
+		if err := c.Render(PNG, bytes.NewBuffer(nil)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -8,3 +8,9 @@ type Series interface {
 	Validate() error
 	Render(r Renderer, canvasBox Box, xrange, yrange Range, s Style)
 }
+
+// bandSeries is a series drawn as a band between two lines, which charts fill like their bands by default.
+type bandSeries interface {
+	Series
+	band()
+}
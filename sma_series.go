@@ -11,12 +11,14 @@ const (
 
 // Interface Assertions.
 var (
-	_ Series              = (*SMASeries)(nil)
-	_ FirstValuesProvider = (*SMASeries)(nil)
-	_ LastValuesProvider  = (*SMASeries)(nil)
+	_ Series                 = (*SMASeries)(nil)
+	_ FirstValuesProvider    = (*SMASeries)(nil)
+	_ LastValuesProvider     = (*SMASeries)(nil)
+	_ ValueFormatterProvider = (*SMASeries)(nil)
 )
 
-// SMASeries is a computed series.
+// SMASeries is a computed series that plots the trailing simple moving average of an inner series.
+// The first Period-1 values average over the partial window of values available so far.
 type SMASeries struct {
 	Name  string
 	Style Style
@@ -41,6 +43,14 @@ func (sma SMASeries) GetYAxis() YAxisType {
 	return sma.YAxis
 }
 
+// GetValueFormatters returns the value formatters of the inner series if it provides them.
+func (sma SMASeries) GetValueFormatters() (x, y ValueFormatter) {
+	if typed, isTyped := sma.InnerSeries.(ValueFormatterProvider); isTyped {
+		return typed.GetValueFormatters()
+	}
+	return FloatValueFormatter, defaultValueFormatter
+}
+
 // Len returns the number of elements in the series.
 func (sma SMASeries) Len() int {
 	return sma.InnerSeries.Len()
@@ -94,7 +104,7 @@ func (sma SMASeries) GetLastValues() (x, y float64) {
 
 func (sma SMASeries) getAverage(index int) float64 {
 	period := sma.GetPeriod()
-	floor := MaxInt(0, index-period)
+	floor := MaxInt(0, index-period+1)
 	var accum float64
 	var count float64
 	for x := index; x >= floor; x-- {
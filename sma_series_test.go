@@ -1,7 +1,10 @@
 package chart
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/blend/go-sdk/assert"
 )
@@ -106,6 +109,61 @@ func TestSMASeriesGetLastValue(t *testing.T) {
 
 	lx, ly := mas.GetLastValues()
 	assert.Equal(100.0, lx)
-	assert.Equal(6, ly)
+	assert.Equal(5.5, ly)
 	assert.Equal(yvalues[len(yvalues)-1], ly)
 }
+
+func TestSMASeriesWindow(t *testing.T) {
+	assert := assert.New(t)
+
+	sma := SMASeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{1, 2, 3, 4, 5, 6},
+			YValues: []float64{1, 2, 3, 4, 5, 6},
+		},
+		Period: 3,
+	}
+	assert.Equal(6, sma.Len())
+
+	var yvalues []float64
+	for index := 0; index < sma.Len(); index++ {
+		_, y := sma.GetValues(index)
+		yvalues = append(yvalues, y)
+	}
+	assert.Equal([]float64{1, 1.5, 2, 3, 4, 5}, yvalues)
+}
+
+func TestSMASeriesGetValueFormatters(t *testing.T) {
+	assert := assert.New(t)
+
+	sma := SMASeries{
+		InnerSeries: TimeSeries{},
+	}
+	x, y := sma.GetValueFormatters()
+	assert.NotNil(x)
+	assert.NotNil(y)
+	assert.Equal("2019-01-02", x(time.Date(2019, 01, 02, 15, 04, 05, 0, time.UTC)))
+
+	sma.InnerSeries = mockValuesProvider{}
+	x, _ = sma.GetValueFormatters()
+	assert.Equal("1.50", x(1.5))
+}
+
+func TestSMASeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	inner := ContinuousSeries{
+		XValues: LinearRange(1, 100),
+		YValues: LinearRange(100, 1),
+	}
+	c := Chart{
+		Series: []Series{
+			inner,
+			SMASeries{InnerSeries: inner, Period: 10},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	assert.True(strings.Contains(buffer.String(), c.GetColorPalette().GetSeriesColor(1).Hex()))
+}
@@ -0,0 +1,134 @@
+package chart
+
+import "fmt"
+
+// Interface Assertions.
+var (
+	_ Series = (*SparklineDotsSeries)(nil)
+)
+
+// NewSparkline returns a chart that draws a series as a sparkline, i.e. a small inline chart like
+// the ones in table cells. It has no axes, title, background stroke or padding, so the canvas is
+// the full width and height of the chart; set them, they default to `DefaultSparklineWidth` by
+// `DefaultSparklineHeight`. Add a `SparklineDotsSeries` to mark the lowest, highest or last values.
+func NewSparkline(series Series) *Chart {
+	return &Chart{
+		Width:  DefaultSparklineWidth,
+		Height: DefaultSparklineHeight,
+		Background: Style{
+			Padding:     BoxZero,
+			StrokeColor: ColorTransparent,
+		},
+		Canvas: Style{
+			StrokeColor: ColorTransparent,
+		},
+		TitleStyle:     Hidden(),
+		XAxis:          HideXAxis(),
+		YAxis:          HideYAxis(),
+		YAxisSecondary: HideYAxis(),
+		Series:         []Series{series},
+	}
+}
+
+// SparklineDotsSeries draws dots on the lowest, highest and last values of an inner series.
+// The dots use the style's dot color and width if they're set. Otherwise they're `DefaultSparklineDotWidth`
+// wide and the stroke color of the inner series, or, if that isn't set either, the series color of the dots.
+type SparklineDotsSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Inner ValuesProvider
+
+	// ShowMinMax draws dots on the lowest and highest values; the first of them if there are ties.
+	ShowMinMax bool
+	// ShowLast draws a dot on the last value.
+	ShowLast bool
+}
+
+// GetName returns the name of the series.
+func (sds SparklineDotsSeries) GetName() string {
+	return sds.Name
+}
+
+// GetStyle returns the style of the series.
+func (sds SparklineDotsSeries) GetStyle() Style {
+	return sds.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (sds SparklineDotsSeries) GetYAxis() YAxisType {
+	return sds.YAxis
+}
+
+// GetIndexes returns the indexes of the values the series draws dots on, in order and without repeats.
+func (sds SparklineDotsSeries) GetIndexes() []int {
+	min, max, last := -1, -1, -1
+	for index := 0; index < sds.Inner.Len(); index++ {
+		vx, vy := sds.Inner.GetValues(index)
+		if !isFinite(vx) || !isFinite(vy) {
+			continue
+		}
+		if min < 0 || vy < sds.valueAt(min) {
+			min = index
+		}
+		if max < 0 || vy > sds.valueAt(max) {
+			max = index
+		}
+		last = index
+	}
+
+	var candidates []int
+	if sds.ShowMinMax {
+		candidates = append(candidates, MinInt(min, max), MaxInt(min, max))
+	}
+	if sds.ShowLast {
+		candidates = append(candidates, last)
+	}
+
+	var indexes []int
+	for _, index := range candidates {
+		if index < 0 || (len(indexes) > 0 && indexes[len(indexes)-1] >= index) {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+// Render renders the series.
+func (sds SparklineDotsSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if typed, isTyped := sds.Inner.(Series); isTyped && !typed.GetStyle().StrokeColor.IsZero() {
+		defaults.StrokeColor = typed.GetStyle().StrokeColor
+	}
+	style := sds.Style.InheritFrom(Style{
+		DotColor: defaults.StrokeColor,
+		DotWidth: DefaultSparklineDotWidth,
+	})
+	if !style.ShouldDrawDot() {
+		return
+	}
+	style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
+	for _, index := range sds.GetIndexes() {
+		vx, vy := sds.Inner.GetValues(index)
+		Draw.circle(r, style.DotWidth, floatPoint{
+			X: float64(canvasBox.Left) + translateFloat(xrange, vx),
+			Y: float64(canvasBox.Bottom) - translateFloat(yrange, vy),
+		})
+		r.FillStroke()
+	}
+}
+
+// Validate validates the series.
+func (sds SparklineDotsSeries) Validate() error {
+	if sds.Inner == nil {
+		return fmt.Errorf("sparkline dots series; must have an inner series")
+	}
+	return nil
+}
+
+// valueAt returns the y value of the inner series at a given index.
+func (sds SparklineDotsSeries) valueAt(index int) float64 {
+	_, vy := sds.Inner.GetValues(index)
+	return vy
+}
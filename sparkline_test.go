@@ -0,0 +1,70 @@
+package chart
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func sparklineTestSeries() ContinuousSeries {
+	return ContinuousSeries{
+		XValues: []float64{0, 1, 2, 3, 4, 5, 6, 7},
+		YValues: []float64{3, 1, 4, 1, 5, 9, 2, 6},
+	}
+}
+
+func TestSparklineCanvasIsFullChart(t *testing.T) {
+	assert := assert.New(t)
+
+	c := NewSparkline(sparklineTestSeries())
+	assert.Equal(Box{Right: DefaultSparklineWidth, Bottom: DefaultSparklineHeight}, c.Box())
+
+	svg := renderFrameTestChart(t, *c)
+	assert.NotContains(svg, "<text")
+
+	// the line spans the whole chart.
+	series := regexp.MustCompile(`<path  d="([^"]*)" style="stroke-width:1;stroke:` + GetDefaultColor(0).Hex()).FindStringSubmatch(svg)
+	assert.Len(series, 2)
+	minX, maxX, minY, maxY := math.Inf(1), math.Inf(-1), math.Inf(1), math.Inf(-1)
+	for _, p := range regexp.MustCompile(`[ML] ([\d.]+) ([\d.]+)`).FindAllStringSubmatch(series[1], -1) {
+		x, _ := strconv.ParseFloat(p[1], 64)
+		y, _ := strconv.ParseFloat(p[2], 64)
+		minX, maxX, minY, maxY = math.Min(minX, x), math.Max(maxX, x), math.Min(minY, y), math.Max(maxY, y)
+	}
+	assert.Equal(0.0, minX)
+	assert.Equal(float64(DefaultSparklineWidth), maxX)
+	assert.Equal(0.0, minY)
+	assert.Equal(float64(DefaultSparklineHeight), maxY)
+}
+
+func TestSparklineDotsSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := sparklineTestSeries()
+	assert.Equal([]int{1, 5, 7}, SparklineDotsSeries{Inner: cs, ShowMinMax: true, ShowLast: true}.GetIndexes())
+	assert.Equal([]int{1, 5}, SparklineDotsSeries{Inner: cs, ShowMinMax: true}.GetIndexes())
+	assert.Equal([]int{7}, SparklineDotsSeries{Inner: cs, ShowLast: true}.GetIndexes())
+	assert.Empty(SparklineDotsSeries{Inner: cs}.GetIndexes())
+
+	// the last value being the highest gets one dot.
+	cs.YValues[7] = 10
+	assert.Equal([]int{1, 7}, SparklineDotsSeries{Inner: cs, ShowMinMax: true, ShowLast: true}.GetIndexes())
+
+	// the dots take the color of the line.
+	cs.Style = Style{StrokeColor: ColorRed, StrokeWidth: 1}
+	c := NewSparkline(cs)
+	c.Series = append(c.Series, SparklineDotsSeries{Inner: cs, ShowMinMax: true, ShowLast: true})
+	svg := renderFrameTestChart(t, *c)
+	assert.Equal(2, strings.Count(svg, "<circle"))
+	assert.Equal(2, strings.Count(svg, `r="2.00" style="stroke-width:1;stroke:`+ColorRed.Hex()))
+	// they sit on the line, between pixels.
+	assert.Contains(svg, "L 17.14 28")
+	assert.Contains(svg, `<circle cx="17.14" cy="28"`)
+
+	c.Series[1] = SparklineDotsSeries{Inner: cs, ShowLast: true, Style: Style{DotWidth: Disabled}}
+	assert.NotContains(renderFrameTestChart(t, *c), "<circle")
+}
@@ -3,6 +3,7 @@ package chart
 import (
 	"errors"
 	"fmt"
+	"image"
 	"io"
 	"math"
 
@@ -24,12 +25,18 @@ func (sb StackedBar) GetWidth() int {
 	return sb.Width
 }
 
-// StackedBarChart is a chart that draws sections of a bar based on percentages.
+// StackedBarChart is a chart that draws bars made of stacked segments.
+// By default the value axis spans the largest bar total; if IsPercentage is set, every bar is scaled to 100%.
+// Segments with a zero value are skipped, and negative values are rejected by `Validate`.
 type StackedBarChart struct {
 	Title      string
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme sets the colors and font sizes of the chart; it defaults to `GetDefaultTheme()`.
+	// Bar segments keep the `AlternateColorPalette` colors unless the theme has series colors.
+	// A ColorPalette takes precedence over the theme colors.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -47,6 +54,7 @@ type StackedBarChart struct {
 	defaultFont *truetype.Font
 
 	IsHorizontal bool
+	IsPercentage bool
 
 	Bars     []StackedBar
 	Elements []Renderable
@@ -82,7 +90,7 @@ func (sbc StackedBarChart) GetWidth() int {
 // GetHeight returns the chart height or the default value.
 func (sbc StackedBarChart) GetHeight() int {
 	if sbc.Height == 0 {
-		return DefaultChartWidth
+		return DefaultChartHeight
 	}
 	return sbc.Height
 }
@@ -95,11 +103,20 @@ func (sbc StackedBarChart) GetBarSpacing() int {
 	return sbc.BarSpacing
 }
 
+// RenderImage renders the chart with a raster renderer provider, like PNG, and returns the image
+// without encoding it.
+func (sbc StackedBarChart) RenderImage(rp RendererProvider) (image.Image, error) {
+	return renderImage(rp, sbc.Render)
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	if len(sbc.Bars) == 0 {
 		return errors.New("please provide at least one bar")
 	}
+	if err := sbc.Validate(); err != nil {
+		return err
+	}
 
 	r, err := rp(sbc.GetWidth(), sbc.GetHeight())
 	if err != nil {
@@ -116,18 +133,21 @@ func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	r.SetDPI(sbc.GetDPI(DefaultDPI))
 
 	var canvasBox Box
+	ra := sbc.getRange()
 	if sbc.IsHorizontal {
-		canvasBox = sbc.getHorizontalAdjustedCanvasBox(r, sbc.getDefaultCanvasBox())
+		canvasBox = sbc.getHorizontalAdjustedCanvasBox(r, sbc.getTitleAdjustedCanvasBox(r, sbc.getDefaultCanvasBox()))
+		ra.SetDomain(canvasBox.Width())
 		sbc.drawCanvas(r, canvasBox)
-		sbc.drawHorizontalBars(r, canvasBox)
-		sbc.drawHorizontalXAxis(r, canvasBox)
+		sbc.drawHorizontalBars(r, canvasBox, ra)
+		sbc.drawHorizontalXAxis(r, canvasBox, ra)
 		sbc.drawHorizontalYAxis(r, canvasBox)
 	} else {
-		canvasBox = sbc.getAdjustedCanvasBox(r, sbc.getDefaultCanvasBox())
+		canvasBox = sbc.getAdjustedCanvasBox(r, sbc.getTitleAdjustedCanvasBox(r, sbc.getDefaultCanvasBox()))
+		ra.SetDomain(canvasBox.Height())
 		sbc.drawCanvas(r, canvasBox)
-		sbc.drawBars(r, canvasBox)
+		sbc.drawBars(r, canvasBox, ra)
 		sbc.drawXAxis(r, canvasBox)
-		sbc.drawYAxis(r, canvasBox)
+		sbc.drawYAxis(r, canvasBox, ra)
 	}
 
 	sbc.drawTitle(r)
@@ -138,121 +158,153 @@ func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	return r.Save(w)
 }
 
+// Validate validates the chart bars.
+func (sbc StackedBarChart) Validate() error {
+	for _, bar := range sbc.Bars {
+		for _, v := range bar.Values {
+			if v.Value < 0 || !isFinite(v.Value) {
+				return fmt.Errorf("stacked bar chart; bar %q has an invalid segment value: %v", bar.Name, v.Value)
+			}
+		}
+	}
+	return nil
+}
+
+// getRange returns the range of the value axis, from zero to either one for percentages
+// or the largest bar total rounded up to a tick step.
+func (sbc StackedBarChart) getRange() Range {
+	if sbc.IsPercentage {
+		return &ContinuousRange{Min: 0, Max: 1}
+	}
+	var maxTotal float64
+	for _, bar := range sbc.Bars {
+		maxTotal = math.Max(maxTotal, Sum(Values(bar.Values).Values()...))
+	}
+	if maxTotal == 0 {
+		return &ContinuousRange{Min: 0, Max: 1}
+	}
+	step := sbc.getTickStep(maxTotal)
+	return &ContinuousRange{Min: 0, Max: math.Ceil(maxTotal/step) * step}
+}
+
+func (sbc StackedBarChart) getTickStep(max float64) float64 {
+	return NiceNumber(max / DefaultStackedBarTickCount)
+}
+
+// getTicks returns the ticks of the value axis.
+func (sbc StackedBarChart) getTicks(ra Range) []Tick {
+	var ticks []Tick
+	if sbc.IsPercentage {
+		for _, t := range LinearRangeWithStep(0.0, 1.0, 0.2) {
+			ticks = append(ticks, Tick{Value: t, Label: fmt.Sprintf("%0.0f%%", t*100)})
+		}
+		return ticks
+	}
+	for _, t := range LinearRangeWithStep(0.0, ra.GetMax(), sbc.getTickStep(ra.GetMax())) {
+		ticks = append(ticks, Tick{Value: t, Label: FloatValueFormatter(t)})
+	}
+	return ticks
+}
+
+// getSegments returns the non-zero segments of a bar, normalized if the chart is a percentage chart.
+func (sbc StackedBarChart) getSegments(bar StackedBar) []Value {
+	total := Sum(Values(bar.Values).Values()...)
+	var segments []Value
+	for index, v := range bar.Values {
+		if v.Value == 0 {
+			continue
+		}
+		if sbc.IsPercentage {
+			v.Value = v.Value / total
+		}
+		v.Style = v.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index))
+		segments = append(segments, v)
+	}
+	return segments
+}
+
 func (sbc StackedBarChart) drawCanvas(r Renderer, canvasBox Box) {
 	Draw.Box(r, canvasBox, sbc.getCanvasStyle())
 }
 
-func (sbc StackedBarChart) drawBars(r Renderer, canvasBox Box) {
+func (sbc StackedBarChart) drawBars(r Renderer, canvasBox Box, ra Range) {
 	xoffset := canvasBox.Left
 	for _, bar := range sbc.Bars {
-		sbc.drawBar(r, canvasBox, xoffset, bar)
+		sbc.drawBar(r, canvasBox, ra, xoffset, bar)
 		xoffset += (sbc.GetBarSpacing() + bar.GetWidth())
 	}
 }
 
-func (sbc StackedBarChart) drawHorizontalBars(r Renderer, canvasBox Box) {
+func (sbc StackedBarChart) drawHorizontalBars(r Renderer, canvasBox Box, ra Range) {
 	yOffset := canvasBox.Top
 	for _, bar := range sbc.Bars {
-		sbc.drawHorizontalBar(r, canvasBox, yOffset, bar)
+		sbc.drawHorizontalBar(r, canvasBox, ra, yOffset, bar)
 		yOffset += sbc.GetBarSpacing() + bar.GetWidth()
 	}
 }
 
-func (sbc StackedBarChart) drawBar(r Renderer, canvasBox Box, xoffset int, bar StackedBar) int {
+// drawBar draws the segments of a bar upward from the bottom of the canvas, in order.
+func (sbc StackedBarChart) drawBar(r Renderer, canvasBox Box, ra Range, xoffset int, bar StackedBar) int {
 	barSpacing2 := sbc.GetBarSpacing() >> 1
 	bxl := xoffset + barSpacing2
 	bxr := bxl + bar.GetWidth()
 
-	normalizedBarComponents := Values(bar.Values).Normalize()
-	yoffset := canvasBox.Top
-	for index, bv := range normalizedBarComponents {
-		barHeight := int(math.Ceil(bv.Value * float64(canvasBox.Height())))
-		barBox := Box{
-			Top:    yoffset,
+	segments := sbc.getSegments(bar)
+	boxes := make([]Box, len(segments))
+	var total float64
+	for index, bv := range segments {
+		boxes[index] = Box{
+			Top:    canvasBox.Bottom - ra.Translate(total+bv.Value),
 			Left:   bxl,
 			Right:  bxr,
-			Bottom: MinInt(yoffset+barHeight, canvasBox.Bottom-DefaultStrokeWidth),
+			Bottom: canvasBox.Bottom - ra.Translate(total),
 		}
-		Draw.Box(r, barBox, bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index)))
-		yoffset += barHeight
+		total += bv.Value
+		Draw.Box(r, boxes[index], bv.Style)
 	}
 
 	// draw the labels
-	yoffset = canvasBox.Top
-	var lx, ly int
-	for index, bv := range normalizedBarComponents {
-		barHeight := int(math.Ceil(bv.Value * float64(canvasBox.Height())))
-
+	for index, bv := range segments {
 		if len(bv.Label) > 0 {
-			lx = bxl + ((bxr - bxl) / 2)
-			ly = yoffset + (barHeight / 2)
-
-			bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index)).WriteToRenderer(r)
+			bv.Style.WriteToRenderer(r)
 			tb := r.MeasureText(bv.Label)
-			lx = lx - (tb.Width() >> 1)
-			ly = ly + (tb.Height() >> 1)
-
-			if lx < 0 {
-				lx = 0
-			}
-			if ly < 0 {
-				lx = 0
-			}
-
-			r.Text(bv.Label, lx, ly)
+			cx, cy := boxes[index].Center()
+			r.Text(bv.Label, MaxInt(0, cx-(tb.Width()>>1)), MaxInt(0, cy+(tb.Height()>>1)))
 		}
-		yoffset += barHeight
 	}
 
 	return bxr
 }
 
-func (sbc StackedBarChart) drawHorizontalBar(r Renderer, canvasBox Box, yoffset int, bar StackedBar) {
+// drawHorizontalBar draws the segments of a bar rightward from the left of the canvas, in order.
+func (sbc StackedBarChart) drawHorizontalBar(r Renderer, canvasBox Box, ra Range, yoffset int, bar StackedBar) {
 	halfBarSpacing := sbc.GetBarSpacing() >> 1
 
 	boxTop := yoffset + halfBarSpacing
 	boxBottom := boxTop + bar.GetWidth()
 
-	normalizedBarComponents := Values(bar.Values).Normalize()
-
-	xOffset := canvasBox.Right
-	for index, bv := range normalizedBarComponents {
-		barHeight := int(math.Ceil(bv.Value * float64(canvasBox.Width())))
-		barBox := Box{
+	segments := sbc.getSegments(bar)
+	boxes := make([]Box, len(segments))
+	var total float64
+	for index, bv := range segments {
+		boxes[index] = Box{
 			Top:    boxTop,
-			Left:   MinInt(xOffset-barHeight, canvasBox.Left+DefaultStrokeWidth),
-			Right:  xOffset,
+			Left:   canvasBox.Left + ra.Translate(total),
+			Right:  canvasBox.Left + ra.Translate(total+bv.Value),
 			Bottom: boxBottom,
 		}
-		Draw.Box(r, barBox, bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index)))
-		xOffset -= barHeight
+		total += bv.Value
+		Draw.Box(r, boxes[index], bv.Style)
 	}
 
 	// draw the labels
-	xOffset = canvasBox.Right
-	var lx, ly int
-	for index, bv := range normalizedBarComponents {
-		barHeight := int(math.Ceil(bv.Value * float64(canvasBox.Width())))
-
+	for index, bv := range segments {
 		if len(bv.Label) > 0 {
-			lx = xOffset - (barHeight / 2)
-			ly = boxTop + ((boxBottom - boxTop) / 2)
-
-			bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index)).WriteToRenderer(r)
+			bv.Style.WriteToRenderer(r)
 			tb := r.MeasureText(bv.Label)
-			lx = lx - (tb.Width() >> 1)
-			ly = ly + (tb.Height() >> 1)
-
-			if lx < 0 {
-				lx = 0
-			}
-			if ly < 0 {
-				lx = 0
-			}
-
-			r.Text(bv.Label, lx, ly)
+			cx, cy := boxes[index].Center()
+			r.Text(bv.Label, MaxInt(0, cx-(tb.Width()>>1)), MaxInt(0, cy+(tb.Height()>>1)))
 		}
-		xOffset -= barHeight
 	}
 }
 
@@ -290,7 +342,7 @@ func (sbc StackedBarChart) drawXAxis(r Renderer, canvasBox Box) {
 	}
 }
 
-func (sbc StackedBarChart) drawHorizontalXAxis(r Renderer, canvasBox Box) {
+func (sbc StackedBarChart) drawHorizontalXAxis(r Renderer, canvasBox Box, ra Range) {
 	if !sbc.XAxis.Hidden {
 		axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
@@ -302,31 +354,29 @@ func (sbc StackedBarChart) drawHorizontalXAxis(r Renderer, canvasBox Box) {
 		r.LineTo(canvasBox.Left, canvasBox.Bottom+DefaultVerticalTickHeight)
 		r.Stroke()
 
-		ticks := LinearRangeWithStep(0.0, 1.0, 0.2)
-		for _, t := range ticks {
+		ticks := sbc.getTicks(ra)
+		for index, t := range ticks {
 			axisStyle.GetStrokeOptions().WriteToRenderer(r)
-			tx := canvasBox.Left + int(t*float64(canvasBox.Width()))
+			tx := canvasBox.Left + ra.Translate(t.Value)
 			r.MoveTo(tx, canvasBox.Bottom)
 			r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
 			r.Stroke()
 
 			axisStyle.GetTextOptions().WriteToRenderer(r)
-			text := fmt.Sprintf("%0.0f%%", t*100)
-
-			textBox := r.MeasureText(text)
+			textBox := r.MeasureText(t.Label)
 			textX := tx - (textBox.Width() >> 1)
 			textY := canvasBox.Bottom + DefaultXAxisMargin + 10
 
-			if t == 1 {
+			if index == len(ticks)-1 {
 				textX = canvasBox.Right - textBox.Width()
 			}
 
-			Draw.Text(r, text, textX, textY, axisStyle)
+			Draw.Text(r, t.Label, textX, textY, axisStyle)
 		}
 	}
 }
 
-func (sbc StackedBarChart) drawYAxis(r Renderer, canvasBox Box) {
+func (sbc StackedBarChart) drawYAxis(r Renderer, canvasBox Box, ra Range) {
 	if !sbc.YAxis.Hidden {
 		axisStyle := sbc.YAxis.InheritFrom(sbc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
@@ -338,19 +388,16 @@ func (sbc StackedBarChart) drawYAxis(r Renderer, canvasBox Box) {
 		r.LineTo(canvasBox.Right+DefaultHorizontalTickWidth, canvasBox.Bottom)
 		r.Stroke()
 
-		ticks := LinearRangeWithStep(0.0, 1.0, 0.2)
-		for _, t := range ticks {
+		for _, t := range sbc.getTicks(ra) {
 			axisStyle.GetStrokeOptions().WriteToRenderer(r)
-			ty := canvasBox.Bottom - int(t*float64(canvasBox.Height()))
+			ty := canvasBox.Bottom - ra.Translate(t.Value)
 			r.MoveTo(canvasBox.Right, ty)
 			r.LineTo(canvasBox.Right+DefaultHorizontalTickWidth, ty)
 			r.Stroke()
 
 			axisStyle.GetTextOptions().WriteToRenderer(r)
-			text := fmt.Sprintf("%0.0f%%", t*100)
-
-			tb := r.MeasureText(text)
-			Draw.Text(r, text, canvasBox.Right+DefaultYAxisMargin+5, ty+(tb.Height()>>1), axisStyle)
+			tb := r.MeasureText(t.Label)
+			Draw.Text(r, t.Label, canvasBox.Right+DefaultYAxisMargin+5, ty+(tb.Height()>>1), axisStyle)
 		}
 	}
 }
@@ -392,7 +439,7 @@ func (sbc StackedBarChart) drawTitle(r Renderer) {
 	if len(sbc.Title) > 0 && !sbc.TitleStyle.Hidden {
 		r.SetFont(sbc.TitleStyle.GetFont(sbc.GetFont()))
 		r.SetFontColor(sbc.TitleStyle.GetFontColor(sbc.GetColorPalette().TextColor()))
-		titleFontSize := sbc.TitleStyle.GetFontSize(DefaultTitleFontSize)
+		titleFontSize := sbc.TitleStyle.GetFontSize(sbc.GetTheme().GetTitleFontSize())
 		r.SetFontSize(titleFontSize)
 
 		textBox := r.MeasureText(sbc.Title)
@@ -419,18 +466,35 @@ func (sbc StackedBarChart) styleDefaultsCanvas() Style {
 	}
 }
 
+// GetTheme returns the chart theme or the default theme; nil means the package defaults.
+func (sbc StackedBarChart) GetTheme() *Theme {
+	return getTheme(sbc.Theme)
+}
+
 // GetColorPalette returns the color palette for the chart.
 func (sbc StackedBarChart) GetColorPalette() ColorPalette {
-	if sbc.ColorPalette != nil {
-		return sbc.ColorPalette
-	}
-	return AlternateColorPalette
+	return getColorPalette(sbc.ColorPalette, sbc.GetTheme(), AlternateColorPalette)
 }
 
 func (sbc StackedBarChart) getDefaultCanvasBox() Box {
 	return sbc.Box()
 }
 
+// getTitleAdjustedCanvasBox moves the top of the canvas below the title so a large title font
+// doesn't overlap the bars.
+func (sbc StackedBarChart) getTitleAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	if len(sbc.Title) == 0 || sbc.TitleStyle.Hidden {
+		return canvasBox
+	}
+	textBox := Draw.MeasureText(r, sbc.Title, sbc.TitleStyle.InheritFrom(Style{
+		Font:     sbc.GetFont(),
+		FontSize: sbc.GetTheme().GetTitleFontSize(),
+	}))
+	titleBottom := sbc.TitleStyle.Padding.GetTop(DefaultTitleTop) + textBox.Height()
+	canvasBox.Top = MaxInt(canvasBox.Top, titleBottom+sbc.TitleStyle.Padding.GetBottom(DefaultTitleBottom))
+	return canvasBox
+}
+
 func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
 	var totalWidth int
 	for _, bar := range sbc.Bars {
@@ -518,15 +582,8 @@ func (sbc StackedBarChart) getHorizontalAdjustedCanvasBox(r Renderer, canvasBox
 
 // Box returns the chart bounds as a box.
 func (sbc StackedBarChart) Box() Box {
-	dpr := sbc.Background.Padding.GetRight(10)
-	dpb := sbc.Background.Padding.GetBottom(50)
-
-	return Box{
-		Top:    sbc.Background.Padding.GetTop(20),
-		Left:   sbc.Background.Padding.GetLeft(20),
-		Right:  sbc.GetWidth() - dpr,
-		Bottom: sbc.GetHeight() - dpb,
-	}
+	padding := sbc.Background.InheritFrom(Style{Padding: Box{Top: 20, Left: 20, Right: 10, Bottom: 50}}).Padding
+	return Box{Right: sbc.GetWidth(), Bottom: sbc.GetHeight()}.Inset(padding)
 }
 
 func (sbc StackedBarChart) styleDefaultsStackedBarValue(index int) Style {
@@ -542,7 +599,7 @@ func (sbc StackedBarChart) styleDefaultsStackedBarValue(index int) Style {
 
 func (sbc StackedBarChart) styleDefaultsTitle() Style {
 	return sbc.TitleStyle.InheritFrom(Style{
-		FontColor:           DefaultTextColor,
+		FontColor:           sbc.GetColorPalette().TextColor(),
 		Font:                sbc.GetFont(),
 		FontSize:            sbc.getTitleFontSize(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
@@ -581,10 +638,10 @@ func (sbc StackedBarChart) getTitleFontSize() float64 {
 
 func (sbc StackedBarChart) styleDefaultsAxes() Style {
 	return Style{
-		StrokeColor:         DefaultAxisColor,
+		StrokeColor:         sbc.GetColorPalette().AxisStrokeColor(),
 		Font:                sbc.GetFont(),
-		FontSize:            DefaultAxisFontSize,
-		FontColor:           DefaultAxisColor,
+		FontSize:            sbc.GetTheme().GetAxisFontSize(),
+		FontColor:           sbc.GetColorPalette().AxisStrokeColor(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
@@ -593,10 +650,10 @@ func (sbc StackedBarChart) styleDefaultsAxes() Style {
 
 func (sbc StackedBarChart) styleDefaultsHorizontalAxes() Style {
 	return Style{
-		StrokeColor:         DefaultAxisColor,
+		StrokeColor:         sbc.GetColorPalette().AxisStrokeColor(),
 		Font:                sbc.GetFont(),
-		FontSize:            DefaultAxisFontSize,
-		FontColor:           DefaultAxisColor,
+		FontSize:            sbc.GetTheme().GetAxisFontSize(),
+		FontColor:           sbc.GetColorPalette().AxisStrokeColor(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignMiddle,
 		TextWrap:            TextWrapWord,
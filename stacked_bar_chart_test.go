@@ -0,0 +1,114 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestStackedBarChartGetRange(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		Bars: []StackedBar{
+			{Values: []Value{{Value: 5}, {Value: 4}}},
+			{Values: []Value{{Value: 10}, {Value: 12}}},
+		},
+	}
+
+	// the largest total is 22, rounded up to a tick step of 5.
+	ra := sbc.getRange()
+	assert.Equal(0.0, ra.GetMin())
+	assert.Equal(25.0, ra.GetMax())
+
+	ticks := sbc.getTicks(ra)
+	assert.Len(ticks, 6)
+	assert.Equal(25.0, ticks[5].Value)
+
+	sbc.IsPercentage = true
+	ra = sbc.getRange()
+	assert.Equal(1.0, ra.GetMax())
+	ticks = sbc.getTicks(ra)
+	assert.Equal("100%", ticks[len(ticks)-1].Label)
+}
+
+func TestStackedBarChartGetSegments(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{}
+	bar := StackedBar{
+		Values: []Value{{Value: 1, Label: "a"}, {Value: 0, Label: "b"}, {Value: 3, Label: "c"}},
+	}
+
+	segments := sbc.getSegments(bar)
+	assert.Len(segments, 2)
+	assert.Equal("a", segments[0].Label)
+	assert.Equal("c", segments[1].Label)
+	assert.Equal(3.0, segments[1].Value)
+	// segments keep the palette color of their position in the bar.
+	assert.Equal(sbc.GetColorPalette().GetSeriesColor(2), segments[1].Style.FillColor)
+
+	sbc.IsPercentage = true
+	segments = sbc.getSegments(bar)
+	assert.Equal(0.25, segments[0].Value)
+	assert.Equal(0.75, segments[1].Value)
+}
+
+func TestStackedBarChartValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		Bars: []StackedBar{
+			{Name: "ok", Values: []Value{{Value: 1}, {Value: 0}}},
+		},
+	}
+	assert.Nil(sbc.Validate())
+
+	sbc.Bars = append(sbc.Bars, StackedBar{Name: "negative", Values: []Value{{Value: -1}}})
+	assert.NotNil(sbc.Validate())
+	assert.NotNil(sbc.Render(PNG, bytes.NewBuffer(nil)))
+
+	sbc.Bars[1].Values[0].Value = math.NaN()
+	assert.NotNil(sbc.Validate())
+}
+
+func TestStackedBarChartRender(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, isHorizontal := range []bool{false, true} {
+		for _, isPercentage := range []bool{false, true} {
+			sbc := StackedBarChart{
+				IsHorizontal: isHorizontal,
+				IsPercentage: isPercentage,
+				Bars: []StackedBar{
+					{Name: "a", Values: []Value{{Value: 5, Label: "x"}, {Value: 0}, {Value: 4, Label: "y"}}},
+					{Name: "b", Values: []Value{{Value: 10}, {Value: 12}}},
+				},
+			}
+			buffer := bytes.NewBuffer(nil)
+			assert.Nil(sbc.Render(PNG, buffer))
+			assert.NotZero(buffer.Len())
+		}
+	}
+}
+
+func TestStackedBarChartGetTitleAdjustedCanvasBox(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	sbc := StackedBarChart{Font: f}
+	cb := sbc.getDefaultCanvasBox()
+	assert.Equal(cb, sbc.getTitleAdjustedCanvasBox(r, cb))
+
+	sbc.Title = "Title"
+	sbc.TitleStyle = Style{FontSize: 48}
+	adjusted := sbc.getTitleAdjustedCanvasBox(r, cb)
+	assert.True(adjusted.Top > cb.Top)
+	assert.Equal(cb.Bottom, adjusted.Bottom)
+}
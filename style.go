@@ -36,8 +36,8 @@ func StyleTextDefaults() Style {
 	return Style{
 		Hidden:    false,
 		Font:      font,
-		FontColor: DefaultTextColor,
-		FontSize:  DefaultTitleFontSize,
+		FontColor: GetDefaultTheme().GetTextColor(),
+		FontSize:  GetDefaultTheme().GetTitleFontSize(),
 	}
 }
 
@@ -51,6 +51,16 @@ type Style struct {
 	StrokeWidth     float64
 	StrokeColor     drawing.Color
 	StrokeDashArray []float64
+	// Smooth draws lines as a monotone cubic curve through the points rather than straight segments.
+	Smooth bool
+
+	// ShowValues draws the formatted y value above each point of a series.
+	ShowValues bool
+	// ValueFormatter formats the values drawn by ShowValues; it defaults to the series y value formatter.
+	ValueFormatter ValueFormatter
+	// ShowTooltips shows the formatted x and y values of each dot of a series when hovering over it, with renderers
+	// that can, like svgs; see `TooltipRenderer`. Only dots have tooltips, so series without dots stay small.
+	ShowTooltips bool
 
 	DotColor drawing.Color
 	DotWidth float64
@@ -62,13 +72,20 @@ type Style struct {
 
 	FontSize  float64
 	FontColor drawing.Color
-	Font      *truetype.Font
+	// Font is the font of the text drawn with the style; it falls back to the chart font, and then the default font.
+	Font *truetype.Font
+	// FontName is the name of a font registered with `RegisterFont`, used if Font isn't set.
+	FontName string
 
 	TextHorizontalAlign TextHorizontalAlign
 	TextVerticalAlign   TextVerticalAlign
 	TextWrap            TextWrap
 	TextLineSpacing     int
 	TextRotationDegrees float64 //0 is unset or normal
+
+	// TickLabelSpacing is the least space in pixels between the tick labels of an axis with the style;
+	// it defaults to `DefaultMinimumTickHorizontalSpacing` or `DefaultMinimumTickVerticalSpacing`.
+	TickLabelSpacing int
 }
 
 // IsZero returns if the object is set or not.
@@ -82,6 +99,7 @@ func (s Style) IsZero() bool {
 		s.FontColor.IsZero() &&
 		s.FontSize == 0 &&
 		s.Font == nil &&
+		s.FontName == "" &&
 		s.ClassName == ""
 }
 
@@ -238,6 +256,17 @@ func (s Style) GetDotWidth(defaults ...float64) float64 {
 	return s.DotWidth
 }
 
+// GetValueFormatter returns the formatter for point value labels.
+func (s Style) GetValueFormatter(defaults ...ValueFormatter) ValueFormatter {
+	if s.ValueFormatter == nil {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return nil
+	}
+	return s.ValueFormatter
+}
+
 // GetStrokeDashArray returns the stroke dash array.
 func (s Style) GetStrokeDashArray(defaults ...[]float64) []float64 {
 	if len(s.StrokeDashArray) == 0 {
@@ -255,7 +284,7 @@ func (s Style) GetFontSize(defaults ...float64) float64 {
 		if len(defaults) > 0 {
 			return defaults[0]
 		}
-		return DefaultFontSize
+		return GetDefaultTheme().GetFontSize()
 	}
 	return s.FontSize
 }
@@ -271,9 +300,13 @@ func (s Style) GetFontColor(defaults ...drawing.Color) drawing.Color {
 	return s.FontColor
 }
 
-// GetFont returns the font face.
+// GetFont returns the font face; the font registered as the font name if there's no font,
+// or the default value if neither is set.
 func (s Style) GetFont(defaults ...*truetype.Font) *truetype.Font {
 	if s.Font == nil {
+		if font := GetRegisteredFont(s.FontName); font != nil {
+			return font
+		}
 		if len(defaults) > 0 {
 			return defaults[0]
 		}
@@ -282,6 +315,14 @@ func (s Style) GetFont(defaults ...*truetype.Font) *truetype.Font {
 	return s.Font
 }
 
+// GetFontName returns the registered font name or a default.
+func (s Style) GetFontName(defaults ...string) string {
+	if s.FontName == "" && len(defaults) > 0 {
+		return defaults[0]
+	}
+	return s.FontName
+}
+
 // GetPadding returns the padding.
 func (s Style) GetPadding(defaults ...Box) Box {
 	if s.Padding.IsZero() {
@@ -337,6 +378,17 @@ func (s Style) GetTextLineSpacing(defaults ...int) int {
 	return s.TextLineSpacing
 }
 
+// GetTickLabelSpacing returns the least spacing in pixels between tick labels.
+func (s Style) GetTickLabelSpacing(defaults ...int) int {
+	if s.TickLabelSpacing == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultMinimumTickHorizontalSpacing
+	}
+	return s.TickLabelSpacing
+}
+
 // GetTextRotationDegrees returns the text rotation in degrees.
 func (s Style) GetTextRotationDegrees(defaults ...float64) float64 {
 	if s.TextRotationDegrees == 0 {
@@ -381,13 +433,25 @@ func (s Style) WriteTextOptionsToRenderer(r Renderer) {
 	r.SetFontSize(s.GetFontSize())
 }
 
-// InheritFrom coalesces two styles into a new style.
+// InheritFrom coalesces two styles into a new style; every field of the style that is unset takes the value of the defaults.
+// Chains of calls layer styles, e.g. `chartStyle.InheritFrom(dashboardStyle.InheritFrom(themeStyle))`.
+//
+// A field is unset if it has its zero value, so setting a field explicitly to zero needs a value that isn't zero:
+//   - colors use `ColorTransparent`, which draws nothing.
+//   - widths and font sizes use `Disabled`, which draws no stroke or dot.
+//   - padding uses `BoxZero`, or any box with `IsSet`; boxes without `IsSet` inherit side by side.
+//   - hiding is sticky: the result is hidden if either style is hidden, as `Hidden: false` can't be told from unset.
 func (s Style) InheritFrom(defaults Style) (final Style) {
+	final.Hidden = s.Hidden || defaults.Hidden
 	final.ClassName = s.GetClassName(defaults.ClassName)
 
 	final.StrokeColor = s.GetStrokeColor(defaults.StrokeColor)
 	final.StrokeWidth = s.GetStrokeWidth(defaults.StrokeWidth)
 	final.StrokeDashArray = s.GetStrokeDashArray(defaults.StrokeDashArray)
+	final.Smooth = s.Smooth || defaults.Smooth
+	final.ShowValues = s.ShowValues || defaults.ShowValues
+	final.ShowTooltips = s.ShowTooltips || defaults.ShowTooltips
+	final.ValueFormatter = s.GetValueFormatter(defaults.ValueFormatter)
 
 	final.DotColor = s.GetDotColor(defaults.DotColor)
 	final.DotWidth = s.GetDotWidth(defaults.DotWidth)
@@ -398,17 +462,36 @@ func (s Style) InheritFrom(defaults Style) (final Style) {
 	final.FillColor = s.GetFillColor(defaults.FillColor)
 	final.FontColor = s.GetFontColor(defaults.FontColor)
 	final.FontSize = s.GetFontSize(defaults.FontSize)
-	final.Font = s.GetFont(defaults.Font)
-	final.Padding = s.GetPadding(defaults.Padding)
+	final.Font = s.GetFont(defaults.GetFont())
+	final.FontName = s.GetFontName(defaults.FontName)
+	final.Padding = s.getInheritedPadding(defaults.Padding)
 	final.TextHorizontalAlign = s.GetTextHorizontalAlign(defaults.TextHorizontalAlign)
 	final.TextVerticalAlign = s.GetTextVerticalAlign(defaults.TextVerticalAlign)
 	final.TextWrap = s.GetTextWrap(defaults.TextWrap)
 	final.TextLineSpacing = s.GetTextLineSpacing(defaults.TextLineSpacing)
 	final.TextRotationDegrees = s.GetTextRotationDegrees(defaults.TextRotationDegrees)
+	final.TickLabelSpacing = s.GetTickLabelSpacing(defaults.TickLabelSpacing)
 
 	return
 }
 
+// getInheritedPadding returns the padding with each unset side taken from the defaults.
+func (s Style) getInheritedPadding(defaults Box) Box {
+	if s.Padding.IsSet {
+		return s.Padding
+	}
+	if s.Padding.IsZero() {
+		return defaults
+	}
+	return Box{
+		Top:    s.Padding.GetTop(defaults.Top),
+		Left:   s.Padding.GetLeft(defaults.Left),
+		Right:  s.Padding.GetRight(defaults.Right),
+		Bottom: s.Padding.GetBottom(defaults.Bottom),
+		IsSet:  defaults.IsSet,
+	}
+}
+
 // GetStrokeOptions returns the stroke components.
 func (s Style) GetStrokeOptions() Style {
 	return Style{
@@ -456,6 +539,7 @@ func (s Style) GetTextOptions() Style {
 		FontColor:           s.FontColor,
 		FontSize:            s.FontSize,
 		Font:                s.Font,
+		FontName:            s.FontName,
 		TextHorizontalAlign: s.TextHorizontalAlign,
 		TextVerticalAlign:   s.TextVerticalAlign,
 		TextWrap:            s.TextWrap,
@@ -145,6 +145,64 @@ func TestStyleWithDefaultsFrom(t *testing.T) {
 	assert.Equal(set, coalesced)
 }
 
+func TestStyleInheritFromLayers(t *testing.T) {
+	assert := assert.New(t)
+
+	theme := Style{
+		StrokeColor: drawing.ColorBlack,
+		StrokeWidth: 2,
+		FontSize:    10,
+		Padding:     Box{Top: 5, Left: 5, Right: 5, Bottom: 5},
+	}
+	dashboard := Style{StrokeColor: drawing.ColorBlue, FontSize: 12}
+	chart := Style{FontColor: drawing.ColorRed, Padding: Box{Top: 20}}
+
+	final := chart.InheritFrom(dashboard.InheritFrom(theme))
+	assert.Equal(drawing.ColorBlue, final.StrokeColor)
+	assert.Equal(2.0, final.StrokeWidth)
+	assert.Equal(12.0, final.FontSize)
+	assert.Equal(drawing.ColorRed, final.FontColor)
+	assert.Equal(Box{Top: 20, Left: 5, Right: 5, Bottom: 5}, final.Padding)
+}
+
+func TestStyleInheritFromExplicitZero(t *testing.T) {
+	assert := assert.New(t)
+
+	defaults := Style{
+		StrokeColor: drawing.ColorBlack,
+		StrokeWidth: 2,
+		FillColor:   drawing.ColorBlue,
+		DotWidth:    3,
+		Padding:     Box{Top: 5, Left: 5, Right: 5, Bottom: 5},
+	}
+
+	// a zero stroke width is unset; `Disabled` turns the stroke off.
+	unset := Style{StrokeWidth: 0}.InheritFrom(defaults)
+	assert.Equal(2.0, unset.StrokeWidth)
+	assert.True(unset.ShouldDrawStroke())
+	disabled := Style{StrokeWidth: Disabled, DotWidth: Disabled}.InheritFrom(defaults)
+	assert.Equal(float64(Disabled), disabled.StrokeWidth)
+	assert.False(disabled.ShouldDrawStroke())
+	assert.False(disabled.ShouldDrawDot())
+
+	transparent := Style{FillColor: ColorTransparent}.InheritFrom(defaults)
+	assert.Equal(ColorTransparent, transparent.FillColor)
+
+	assert.Equal(BoxZero, Style{Padding: BoxZero}.InheritFrom(defaults).Padding)
+	explicit := NewBox(0, 1, 0, 0)
+	assert.Equal(explicit, Style{Padding: explicit}.InheritFrom(defaults).Padding)
+}
+
+func TestStyleInheritFromHidden(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.False(Style{}.InheritFrom(Style{}).Hidden)
+	assert.True(Hidden().InheritFrom(Style{}).Hidden)
+	assert.True(Style{}.InheritFrom(Hidden()).Hidden)
+	// an explicit `Hidden: false` is unset, so it doesn't show what the defaults hide.
+	assert.True(Shown().InheritFrom(Hidden()).Hidden)
+}
+
 func TestStyleGetStrokeOptions(t *testing.T) {
 	assert := assert.New(t)
 
@@ -0,0 +1,239 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// SubPlots stacks charts, i.e. panels, on top of each other in a single image, sharing one x axis;
+// e.g. prices with the traded volume beneath them. Each panel has its own series and y axes.
+// The x range spans the values of every panel, the canvases of the panels line up on the left and right,
+// and the x axis is drawn under the bottom panel only. Each panel is clipped to its band, if the renderer can clip.
+type SubPlots struct {
+	Title      string
+	TitleStyle Style
+
+	Width  int
+	Height int
+	DPI    float64
+	Font   *truetype.Font
+
+	// Theme sets the colors of the background and title, and is the theme of the panel charts without one of their own.
+	// It defaults to the default theme.
+	Theme *Theme
+
+	Background Style
+
+	// XAxis is the shared x axis; the x axes of the panel charts are ignored.
+	XAxis XAxis
+
+	Panels []SubPlot
+}
+
+// SubPlot is a panel of `SubPlots`.
+type SubPlot struct {
+	// Chart has the series and y axes of the panel. Its size is set to fit the panel, and its x axis
+	// is the shared one.
+	Chart *Chart
+	// Weight is the height of the panel relative to the other panels; it defaults to 1.
+	Weight float64
+}
+
+// GetWeight returns the weight of the panel or the default value.
+func (sp SubPlot) GetWeight() float64 {
+	if sp.Weight == 0 {
+		return 1
+	}
+	return sp.Weight
+}
+
+// GetWidth returns the width or the default value.
+func (sp SubPlots) GetWidth() int {
+	if sp.Width == 0 {
+		return DefaultChartWidth
+	}
+	return sp.Width
+}
+
+// GetHeight returns the height or the default value.
+func (sp SubPlots) GetHeight() int {
+	if sp.Height == 0 {
+		return DefaultChartHeight
+	}
+	return sp.Height
+}
+
+// GetDPI returns the dpi for the sub plots.
+func (sp SubPlots) GetDPI(defaults ...float64) float64 {
+	if sp.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return sp.DPI
+}
+
+// GetTheme returns the theme for the sub plots.
+func (sp SubPlots) GetTheme() *Theme {
+	return getTheme(sp.Theme)
+}
+
+// Validate checks the sub plots can be rendered, and returns an error describing every problem it finds.
+func (sp SubPlots) Validate() error {
+	var errs errorList
+	if sp.Width < 0 || sp.Height < 0 {
+		errs = append(errs, fmt.Errorf("sub plots; width and height must not be negative, got %dx%d", sp.Width, sp.Height))
+	}
+	if len(sp.Panels) == 0 {
+		errs = append(errs, errors.New("sub plots; please provide at least one panel"))
+	}
+	for index, panel := range sp.Panels {
+		if panel.Weight < 0 {
+			errs = append(errs, fmt.Errorf("sub plots; panel %d weight must not be negative, got %v", index, panel.Weight))
+		}
+		if panel.Chart == nil {
+			errs = append(errs, fmt.Errorf("sub plots; panel %d has no chart", index))
+			continue
+		}
+		if err := panel.Chart.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("sub plots; panel %d: %v", index, err))
+		}
+	}
+	errs = append(errs, validateAxisRange("x axis", sp.XAxis.Range)...)
+	return errs.errorOrNil()
+}
+
+// RenderImage renders the sub plots with a raster renderer provider, like PNG, and returns the image
+// without encoding it.
+func (sp SubPlots) RenderImage(rp RendererProvider) (image.Image, error) {
+	return renderImage(rp, sp.Render)
+}
+
+// Render renders the sub plots with the given renderer to the given io.Writer.
+// Every panel is drawn onto its band of the one renderer.
+func (sp SubPlots) Render(rp RendererProvider, w io.Writer) error {
+	if err := sp.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(sp.GetWidth(), sp.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if sp.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		sp.Font = defaultFont
+	}
+	r.SetDPI(sp.GetDPI(DefaultDPI))
+
+	Draw.Box(r, Box{Right: sp.GetWidth(), Bottom: sp.GetHeight()}, sp.Background.InheritFrom(styleDefaultsCombinedBackground(sp.GetTheme())))
+	top := sp.drawTitle(r)
+
+	// the canvases line up on the narrowest of them.
+	charts := sp.getCharts()
+	panels := sp.GetPanels(top)
+	edges := Box{Left: 0, Right: sp.GetWidth()}
+	for index := range charts {
+		charts[index].Width, charts[index].Height = panels[index].Width(), panels[index].Height()
+		canvasBox, _, _, _, _, _, _, err := charts[index].layout(viewportRenderer{Renderer: r, viewport: panels[index]})
+		if err != nil {
+			return fmt.Errorf("sub plots; panel %d: %v", index, err)
+		}
+		edges.Left, edges.Right = MaxInt(edges.Left, canvasBox.Left), MinInt(edges.Right, canvasBox.Right)
+	}
+
+	for index, c := range charts {
+		c.canvasEdges = &edges
+		vr := viewportRenderer{Renderer: r, viewport: panels[index], scope: fmt.Sprintf("panel-%d-", index)}
+		vr.clipToViewport()
+		err := c.renderTo(vr, w)
+		vr.EndClip()
+		if err != nil {
+			return fmt.Errorf("sub plots; panel %d: %v", index, err)
+		}
+	}
+
+	return r.Save(w)
+}
+
+// GetPanels returns the boxes the panels are drawn in, top to bottom, below a given top; the title is above it.
+func (sp SubPlots) GetPanels(top int) []Box {
+	var total float64
+	for _, panel := range sp.Panels {
+		total += panel.GetWeight()
+	}
+
+	height := float64(sp.GetHeight() - top)
+	panels := make([]Box, len(sp.Panels))
+	var weight float64
+	for index, panel := range sp.Panels {
+		panels[index] = Box{
+			Top:   top + int(math.Round(height*weight/total)),
+			Right: sp.GetWidth(),
+		}
+		weight += panel.GetWeight()
+		panels[index].Bottom = top + int(math.Round(height*weight/total))
+	}
+	return panels
+}
+
+// getCharts returns copies of the panel charts to draw, with the shared x axis.
+// All of them use the same x range; it's set to span the values of every panel unless the x axis
+// has a range or ticks of its own.
+func (sp SubPlots) getCharts() []Chart {
+	charts := make([]Chart, len(sp.Panels))
+	for index, panel := range sp.Panels {
+		charts[index] = *panel.Chart
+		if charts[index].Font == nil {
+			charts[index].Font = sp.Font
+		}
+		if charts[index].DPI == 0 {
+			charts[index].DPI = sp.GetDPI()
+		}
+		if charts[index].Theme == nil {
+			charts[index].Theme = sp.Theme
+		}
+		charts[index].YAxisSecondary.AxisType = YAxisSecondary
+		charts[index].XAxis = sp.XAxis
+		charts[index].XAxis.Range = nil
+	}
+
+	// the span is set on a copy, so the x axis of the sub plots is left as it was for the next render.
+	xrange := copyRange(sp.XAxis.Range)
+	if len(sp.XAxis.Ticks) == 0 && xrange.IsZero() {
+		min, max := math.MaxFloat64, -math.MaxFloat64
+		for _, c := range charts {
+			panelRange, _, _ := c.getRanges()
+			min, max = math.Min(min, panelRange.GetMin()), math.Max(max, panelRange.GetMax())
+		}
+		xrange.SetMin(min)
+		xrange.SetMax(max)
+	}
+
+	// every panel lays out a copy of the range, so they can share it.
+	for index := range charts {
+		charts[index].XAxis.Range = xrange
+		if index < len(charts)-1 {
+			charts[index].XAxis.Style.Hidden = true
+		}
+	}
+	return charts
+}
+
+// drawTitle draws the title, and returns the bottom of the space it takes up.
+func (sp SubPlots) drawTitle(r Renderer) int {
+	if sp.TitleStyle.Hidden {
+		return 0
+	}
+	return drawCenteredTitle(r, sp.Title, sp.TitleStyle.InheritFrom(styleDefaultsCombinedTitle(sp.Font, sp.GetTheme())), sp.GetWidth())
+}
@@ -0,0 +1,158 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func subPlotsTestPanels() []SubPlot {
+	labeled := func(prefix string) ValueFormatter {
+		return func(v interface{}) string {
+			return fmt.Sprintf("%s%v", prefix, v)
+		}
+	}
+	return []SubPlot{
+		{
+			Chart: &Chart{
+				Canvas: Style{FillColor: drawing.ColorFromHex("fafafa")},
+				YAxis:  YAxis{ValueFormatter: labeled("price=")},
+				Series: []Series{
+					ContinuousSeries{XValues: LinearRange(0, 10), YValues: LinearRange(100000, 200000)},
+				},
+			},
+			Weight: 3,
+		},
+		{
+			Chart: &Chart{
+				Canvas: Style{FillColor: drawing.ColorFromHex("f0f0f0")},
+				YAxis:  YAxis{ValueFormatter: labeled("vol=")},
+				Series: []Series{
+					ContinuousSeries{XValues: LinearRange(5, 20), YValues: LinearRange(1, 16)},
+				},
+			},
+		},
+	}
+}
+
+func TestSubPlotsGetPanels(t *testing.T) {
+	assert := assert.New(t)
+
+	sp := SubPlots{Width: 300, Height: 420, Panels: subPlotsTestPanels()}
+	assert.Equal([]Box{
+		{Top: 20, Right: 300, Bottom: 320},
+		{Top: 320, Right: 300, Bottom: 420},
+	}, sp.GetPanels(20))
+}
+
+func TestSubPlotsSharedXRange(t *testing.T) {
+	assert := assert.New(t)
+
+	sp := SubPlots{Panels: subPlotsTestPanels()}
+	charts := sp.getCharts()
+	assert.True(charts[0].XAxis.Range == charts[1].XAxis.Range)
+	assert.Equal(0.0, charts[0].XAxis.Range.GetMin())
+	assert.Equal(20.0, charts[0].XAxis.Range.GetMax())
+	assert.True(charts[0].XAxis.Style.Hidden)
+	assert.False(charts[1].XAxis.Style.Hidden)
+
+	// an x range of its own is kept.
+	sp.XAxis.Range = &ContinuousRange{Min: 2, Max: 8}
+	charts = sp.getCharts()
+	assert.Equal(2.0, charts[1].XAxis.Range.GetMin())
+	assert.Nil(sp.Panels[0].Chart.XAxis.Range)
+
+	// an x range without bounds gets the span of the panels, and is left without them.
+	descending := &ContinuousRange{Descending: true}
+	sp.XAxis.Range = descending
+	charts = sp.getCharts()
+	assert.True(charts[0].XAxis.Range.(*ContinuousRange).Descending)
+	assert.Equal(20.0, charts[0].XAxis.Range.GetMax())
+	assert.True(descending.IsZero())
+
+	sp.Panels[1].Chart = &Chart{Series: []Series{ContinuousSeries{XValues: LinearRange(5, 30), YValues: LinearRange(1, 26)}}}
+	charts = sp.getCharts()
+	assert.Equal(30.0, charts[0].XAxis.Range.GetMax())
+}
+
+func TestSubPlotsRender(t *testing.T) {
+	assert := assert.New(t)
+
+	sp := SubPlots{
+		Width:  600,
+		Height: 400,
+		XAxis:  XAxis{ValueFormatter: func(v interface{}) string { return fmt.Sprintf("x=%v", v) }},
+		Panels: subPlotsTestPanels(),
+	}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(sp.Render(SVG, buffer))
+	svg := buffer.String()
+	panels := sp.GetPanels(0)
+
+	// both canvases span the same columns.
+	canvas := func(fill string) (left, right int) {
+		match := regexp.MustCompile(`M (\d+) \d+\nL (\d+) \d+\nL \d+ \d+\nL \d+ \d+\nL \d+ \d+" style="[^"]*fill:#` + fill).FindStringSubmatch(svg)
+		assert.Len(match, 3)
+		left, _ = strconv.Atoi(match[1])
+		right, _ = strconv.Atoi(match[2])
+		return
+	}
+	priceLeft, priceRight := canvas("fafafa")
+	volumeLeft, volumeRight := canvas("f0f0f0")
+	assert.Equal(priceLeft, volumeLeft)
+	assert.Equal(priceRight, volumeRight)
+
+	// labels stay within the band of their panel, and the x axis is only under the bottom one.
+	labels := func(prefix string) (ys []int) {
+		for _, match := range regexp.MustCompile(`<text x="\d+" y="(\d+)"[^>]*>`+prefix).FindAllStringSubmatch(svg, -1) {
+			y, _ := strconv.Atoi(match[1])
+			ys = append(ys, y)
+		}
+		return
+	}
+	assert.NotEmpty(labels("price="))
+	for _, y := range labels("price=") {
+		assert.True(y > panels[0].Top && y <= panels[0].Bottom)
+	}
+	assert.NotEmpty(labels("vol="))
+	for _, y := range labels("vol=") {
+		assert.True(y > panels[1].Top && y <= panels[1].Bottom)
+	}
+	assert.NotEmpty(labels("x="))
+	for _, y := range labels("x=") {
+		assert.True(y > panels[1].Top && y <= panels[1].Bottom)
+	}
+}
+
+func TestSubPlotsValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(SubPlots{}.Validate())
+	assert.NotNil(SubPlots{Panels: []SubPlot{{}}}.Validate())
+	assert.NotNil(SubPlots{Panels: []SubPlot{{Chart: &Chart{}}}}.Validate())
+	assert.Nil(SubPlots{Panels: subPlotsTestPanels()}.Validate())
+}
+
+func TestSubPlotsThemeAndClip(t *testing.T) {
+	assert := assert.New(t)
+
+	sp := SubPlots{Title: "Prices", Theme: ThemeDark, Panels: subPlotsTestPanels()}
+	sp.Panels[1].Chart.Theme = &Theme{CanvasColor: ColorRed}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(sp.Render(SVG, buffer))
+	svg := buffer.String()
+	assert.Contains(svg, "fill:#1e1e1e")
+	assert.Contains(svg, `style="stroke-width:0;stroke:none;fill:#e0e0e0;font-size:`)
+	assert.Equal(2, strings.Count(svg, `clip-path="url(#clip-`))
+
+	// panel charts without a theme of their own use the sub plots'.
+	charts := sp.getCharts()
+	assert.Equal(ThemeDark, charts[0].Theme)
+	assert.Equal(ColorRed, charts[1].Theme.CanvasColor)
+}
@@ -76,41 +76,34 @@ func (t text) WrapFit(r Renderer, value string, width int, style Style) []string
 	return []string{value}
 }
 
+// WrapFitWord splits a value into lines that fit within the given width, breaking between words.
+// A word too long for a line by itself, e.g. in text without spaces like Chinese or Japanese,
+// is broken between runes. Each `\n` starts a new line.
 func (t text) WrapFitWord(r Renderer, value string, width int, style Style) []string {
 	style.WriteToRenderer(r)
 
 	var output []string
-	var line string
-	var word string
-
-	var textBox Box
-
-	for _, c := range value {
-		if c == rune('\n') { // commit the line to output
-			output = append(output, t.Trim(line+word))
-			line = ""
-			word = ""
-			continue
+	for _, paragraph := range strings.Split(value, "\n") {
+		var line string
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if len(line) > 0 {
+				candidate = line + " " + word
+			}
+			if r.MeasureText(candidate).Width() < width {
+				line = candidate
+				continue
+			}
+			if len(line) > 0 {
+				output = append(output, line)
+			}
+			parts := t.WrapFitRune(r, word, width, style)
+			output = append(output, parts[:len(parts)-1]...)
+			line = parts[len(parts)-1]
 		}
-
-		textBox = r.MeasureText(line + word + string(c))
-
-		if textBox.Width() >= width {
-			output = append(output, t.Trim(line))
-			line = word
-			word = string(c)
-			continue
-		}
-
-		if c == rune(' ') || c == rune('\t') {
-			line = line + word + string(c)
-			word = ""
-			continue
-		}
-		word = word + string(c)
+		output = append(output, line)
 	}
-
-	return append(output, t.Trim(line+word))
+	return output
 }
 
 func (t text) WrapFitRune(r Renderer, value string, width int, style Style) []string {
@@ -128,14 +121,31 @@ func (t text) WrapFitRune(r Renderer, value string, width int, style Style) []st
 
 		textBox = r.MeasureText(line + string(c))
 
-		if textBox.Width() >= width {
+		if textBox.Width() >= width && len(line) > 0 {
 			output = append(output, line)
 			line = string(c)
 			continue
 		}
 		line = line + string(c)
 	}
-	return t.appendLast(output, line)
+	return append(output, line)
+}
+
+// Truncate shortens a value with a trailing ellipsis so it fits within the given width.
+func (t text) Truncate(r Renderer, value string, width int, style Style) string {
+	style.WriteTextOptionsToRenderer(r)
+	if r.MeasureText(value).Width() <= width {
+		return value
+	}
+	runes := []rune(value)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		truncated := t.Trim(string(runes)) + DefaultTextEllipsis
+		if r.MeasureText(truncated).Width() <= width {
+			return truncated
+		}
+	}
+	return ""
 }
 
 func (t text) Trim(value string) string {
@@ -155,12 +165,3 @@ func (t text) MeasureLines(r Renderer, lines []string, style Style) Box {
 	}
 	return output
 }
-
-func (t text) appendLast(lines []string, text string) []string {
-	if len(lines) == 0 {
-		return []string{text}
-	}
-	lastLine := lines[len(lines)-1]
-	lines[len(lines)-1] = lastLine + text
-	return lines
-}
@@ -0,0 +1,64 @@
+package chart
+
+import (
+	"sync"
+
+	"github.com/golang/freetype/truetype"
+)
+
+var (
+	// _textMeasureCache is shared by the renderers, as charts are typically rendered with a new
+	// renderer each time but measure the same labels.
+	_textMeasureCache = &textMeasureCache{}
+)
+
+// textMeasureKey identifies the measurement of a string in a font at a size and dpi.
+// The raster and vector renderers measure text differently, so their measurements are kept apart.
+type textMeasureKey struct {
+	vector bool
+	font   *truetype.Font
+	size   float64
+	dpi    float64
+	text   string
+}
+
+// textMeasureCache caches unrotated text measurements, which are expensive to compute with freetype.
+// It is cleared once it holds `DefaultTextMeasureCacheSize` entries.
+type textMeasureCache struct {
+	lock  sync.RWMutex
+	boxes map[textMeasureKey]Box
+}
+
+// measure returns the cached measurement for a key, or measures and caches it.
+func (tmc *textMeasureCache) measure(key textMeasureKey, measure func() Box) Box {
+	tmc.lock.RLock()
+	box, ok := tmc.boxes[key]
+	tmc.lock.RUnlock()
+	if ok {
+		return box
+	}
+
+	box = measure()
+
+	tmc.lock.Lock()
+	defer tmc.lock.Unlock()
+	if tmc.boxes == nil || len(tmc.boxes) >= DefaultTextMeasureCacheSize {
+		tmc.boxes = make(map[textMeasureKey]Box)
+	}
+	tmc.boxes[key] = box
+	return box
+}
+
+// len returns the number of cached measurements.
+func (tmc *textMeasureCache) len() int {
+	tmc.lock.RLock()
+	defer tmc.lock.RUnlock()
+	return len(tmc.boxes)
+}
+
+// clear removes every cached measurement.
+func (tmc *textMeasureCache) clear() {
+	tmc.lock.Lock()
+	defer tmc.lock.Unlock()
+	tmc.boxes = nil
+}
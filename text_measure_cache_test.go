@@ -0,0 +1,147 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestTextMeasureCacheMeasure(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	var measured int
+	measure := func() Box {
+		measured++
+		return Box{Right: 10, Bottom: 5}
+	}
+
+	tmc := &textMeasureCache{}
+	key := textMeasureKey{font: f, size: 10, dpi: DefaultDPI, text: "label"}
+	assert.Equal(Box{Right: 10, Bottom: 5}, tmc.measure(key, measure))
+	assert.Equal(Box{Right: 10, Bottom: 5}, tmc.measure(key, measure))
+	assert.Equal(1, measured)
+
+	key.size = 12
+	tmc.measure(key, measure)
+	assert.Equal(2, measured)
+	assert.Equal(2, tmc.len())
+}
+
+func TestTextMeasureCacheSizeCap(t *testing.T) {
+	assert := assert.New(t)
+
+	tmc := &textMeasureCache{}
+	for index := 0; index < DefaultTextMeasureCacheSize; index++ {
+		tmc.measure(textMeasureKey{text: fmt.Sprint(index)}, func() Box { return Box{} })
+	}
+	assert.Equal(DefaultTextMeasureCacheSize, tmc.len())
+
+	tmc.measure(textMeasureKey{text: "one more"}, func() Box { return Box{} })
+	assert.Equal(1, tmc.len())
+}
+
+func TestRendererMeasureTextCached(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	for _, rp := range []RendererProvider{PNG, SVG} {
+		r, err := rp(100, 100)
+		assert.Nil(err)
+		r.SetFont(f)
+		r.SetFontSize(13)
+
+		cached := r.MeasureText("cached label")
+
+		// the raster renderer measures with the font MeasureText set on its graphic context.
+		var uncached Box
+		switch typed := r.(type) {
+		case *rasterRenderer:
+			uncached = typed.measureText("cached label")
+		case *vectorRenderer:
+			uncached = typed.measureText("cached label")
+		}
+		assert.False(uncached.IsZero())
+		assert.Equal(uncached, cached)
+		assert.Equal(uncached, r.MeasureText("cached label"))
+
+		// rotation is applied to the cached measurement.
+		r.SetTextRotation(DegreesToRadians(90))
+		rotated := r.MeasureText("cached label")
+		assert.Equal(uncached.Corners().Rotate(90).Box(), rotated)
+		r.ClearTextRotation()
+	}
+}
+
+func TestTextMeasureCacheByRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  400,
+		Height: 200,
+		XAxis:  XAxis{Name: "Wide Axis Name"},
+		YAxis:  YAxis{Name: "Tall Axis Name"},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 1234.5},
+				YValues: []float64{0, 98765.4},
+			},
+		},
+	}
+	render := func(rp RendererProvider) []byte {
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(rp, buffer))
+		return buffer.Bytes()
+	}
+
+	_textMeasureCache.clear()
+	alone := render(PNG)
+
+	// measurements made rendering an svg aren't used to lay out a png.
+	_textMeasureCache.clear()
+	render(SVG)
+	afterSVG := render(PNG)
+	assert.True(bytes.Equal(alone, afterSVG))
+}
+
+func benchmarkChartTickLabels(b *testing.B, warm bool) {
+	ticks := make([]Tick, 50)
+	for index := range ticks {
+		ticks[index] = Tick{Value: float64(index), Label: fmt.Sprintf("tick %d", index)}
+	}
+	c := Chart{
+		Width:  1200,
+		Height: 400,
+		XAxis:  XAxis{Ticks: ticks},
+		YAxis:  YAxis{Ticks: ticks},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 49},
+				YValues: []float64{0, 49},
+			},
+		},
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if !warm {
+			_textMeasureCache = &textMeasureCache{}
+		}
+		c.Render(PNG, bytes.NewBuffer(nil))
+	}
+}
+
+// BenchmarkChartTickLabelsColdCache only reuses measurements within a render.
+func BenchmarkChartTickLabelsColdCache(b *testing.B) {
+	benchmarkChartTickLabels(b, false)
+}
+
+func BenchmarkChartTickLabelsWarmCache(b *testing.B) {
+	benchmarkChartTickLabels(b, true)
+}
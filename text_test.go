@@ -1,6 +1,9 @@
 package chart
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
 	"testing"
 
 	assert "github.com/blend/go-sdk/assert"
@@ -40,6 +43,25 @@ func TestTextWrapWord(t *testing.T) {
 	// test that it handles newlines and long lines.
 	output = Text.WrapFitWord(r, "this\nis\na\ntest\nstring that is very long", 100, basicTextStyle)
 	assert.Len(output, 8)
+
+	// words too long for a line are broken between runes.
+	output = Text.WrapFitWord(r, "a supercalifragilistic word", 100, basicTextStyle)
+	assert.Equal("a", output[0])
+	assert.Equal("word", output[len(output)-1])
+	assert.Equal("supercalifragilistic", strings.Join(output[1:len(output)-1], ""))
+	for _, line := range output {
+		assert.NotEmpty(line)
+		assert.True(r.MeasureText(line).Width() < 100, line)
+	}
+
+	// as is text without spaces.
+	cjk := "图表库支持多种图表类型和输出格式"
+	output = Text.WrapFitWord(r, cjk, 100, basicTextStyle)
+	assert.True(len(output) > 1)
+	assert.Equal(cjk, strings.Join(output, ""))
+	for _, line := range output {
+		assert.True(r.MeasureText(line).Width() < 100, line)
+	}
 }
 
 func TestTextWrapRune(t *testing.T) {
@@ -54,7 +76,69 @@ func TestTextWrapRune(t *testing.T) {
 
 	output := Text.WrapFitRune(r, "this is a test string", 150, basicTextStyle)
 	assert.NotEmpty(output)
-	assert.Len(output, 2)
+	assert.Len(output, 3)
 	assert.Equal("this is a t", output[0])
-	assert.Equal("est string", output[1])
+	assert.Equal("est strin", output[1])
+	assert.Equal("g", output[2])
+	// every line fits, the last one included.
+	for _, line := range output {
+		assert.True(r.MeasureText(line).Width() < 150, line)
+	}
+}
+
+func TestTextTruncate(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	basicTextStyle := Style{Font: f, FontSize: 24}
+
+	assert.Equal("short", Text.Truncate(r, "short", 200, basicTextStyle))
+
+	truncated := Text.Truncate(r, "this is a test string", 100, basicTextStyle)
+	assert.True(strings.HasSuffix(truncated, DefaultTextEllipsis))
+	assert.True(strings.HasPrefix("this is a test string", strings.TrimSuffix(truncated, DefaultTextEllipsis)))
+	assert.True(r.MeasureText(truncated).Width() <= 100)
+
+	assert.Empty(Text.Truncate(r, "this is a test string", 1, basicTextStyle))
+}
+
+func TestDrawTextAligned(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: 12, TextLineSpacing: 4}
+
+	draw := func(text string, style Style) string {
+		r, err := SVG(200, 200)
+		assert.Nil(err)
+		Draw.TextAligned(r, text, 100, 50, style)
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(r.Save(buffer))
+		return buffer.String()
+	}
+	r, err := SVG(200, 200)
+	assert.Nil(err)
+	style.WriteTextOptionsToRenderer(r)
+	short, long := r.MeasureText("ab"), r.MeasureText("abcdef")
+
+	// by default the point is the left of the baseline.
+	assert.Contains(draw("ab", style), `<text x="100" y="50"`)
+
+	centered := style.InheritFrom(Style{TextHorizontalAlign: TextHorizontalAlignCenter, TextVerticalAlign: TextVerticalAlignTop})
+	assert.Contains(draw("ab", centered), fmt.Sprintf(`<text x="%d" y="%d"`, 100-short.Width()>>1, 50+short.Height()))
+
+	right := style.InheritFrom(Style{TextHorizontalAlign: TextHorizontalAlignRight, TextVerticalAlign: TextVerticalAlignMiddle})
+	assert.Contains(draw("ab", right), fmt.Sprintf(`<text x="%d" y="%d"`, 100-short.Width(), 50+short.Height()>>1))
+
+	// each line is aligned on its own, and the lines are aligned as a block.
+	bottom := style.InheritFrom(Style{TextHorizontalAlign: TextHorizontalAlignCenter, TextVerticalAlign: TextVerticalAlignBottom})
+	svg := draw("ab\nabcdef", bottom)
+	assert.Contains(svg, fmt.Sprintf(`<text x="%d" y="%d" `, 100-short.Width()>>1, 50-long.Height()-4))
+	assert.Contains(svg, fmt.Sprintf(`<text x="%d" y="%d" `, 100-long.Width()>>1, 50))
+	assert.Equal(2, strings.Count(draw("ab\nabcdef", style), "<text"))
 }
@@ -0,0 +1,234 @@
+package chart
+
+import (
+	"sync"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+var (
+	_defaultThemeLock sync.RWMutex
+	_defaultTheme     *Theme
+)
+
+// GetDefaultTheme returns the theme charts without a theme of their own use.
+// It is nil, i.e. the package defaults, unless it's been set with `SetDefaultTheme`.
+func GetDefaultTheme() *Theme {
+	_defaultThemeLock.RLock()
+	defer _defaultThemeLock.RUnlock()
+	return _defaultTheme
+}
+
+// getTheme returns a chart's own theme, or the default theme if it doesn't have one.
+func getTheme(theme *Theme) *Theme {
+	if theme != nil {
+		return theme
+	}
+	return GetDefaultTheme()
+}
+
+// getColorPalette returns the color palette a chart draws with: its own palette if it has one, otherwise
+// the colors of its theme, with the chart's default palette for the colors the theme doesn't set.
+func getColorPalette(palette ColorPalette, theme *Theme, fallback ColorPalette) ColorPalette {
+	if palette != nil {
+		return palette
+	}
+	if theme != nil {
+		return theme.colorPalette(fallback)
+	}
+	return fallback
+}
+
+// SetDefaultTheme sets the theme used by charts that don't have a theme set.
+// Setting it to nil restores the package defaults.
+func SetDefaultTheme(theme *Theme) {
+	_defaultThemeLock.Lock()
+	defer _defaultThemeLock.Unlock()
+	_defaultTheme = theme
+}
+
+// ThemeDark is a theme with light text and axes on a dark background.
+var ThemeDark = &Theme{
+	BackgroundColor:       drawing.ColorFromHex("1e1e1e"),
+	BackgroundStrokeColor: drawing.ColorFromHex("1e1e1e"),
+	CanvasColor:           drawing.ColorFromHex("1e1e1e"),
+	CanvasStrokeColor:     drawing.ColorFromHex("1e1e1e"),
+	AxisColor:             drawing.ColorFromHex("a0a0a0"),
+	TextColor:             drawing.ColorFromHex("e0e0e0"),
+	SeriesColors: []drawing.Color{
+		drawing.ColorFromHex("4aa8ff"),
+		drawing.ColorFromHex("3ddc97"),
+		drawing.ColorFromHex("ff5c8a"),
+		drawing.ColorFromHex("3ee6e0"),
+		drawing.ColorFromHex("ff9f43"),
+	},
+}
+
+// Theme bundles the colors, font sizes and padding charts fall back to for anything their styles don't set.
+// Fields that are left unset use the package defaults, e.g. `DefaultTextColor` or `DefaultFontSize`.
+// The getters work on a nil theme, which is all package defaults.
+type Theme struct {
+	BackgroundColor       drawing.Color
+	BackgroundStrokeColor drawing.Color
+	CanvasColor           drawing.Color
+	CanvasStrokeColor     drawing.Color
+	AxisColor             drawing.Color
+	TextColor             drawing.Color
+	// SeriesColors are the series colors by series index; the index wraps around.
+	SeriesColors []drawing.Color
+
+	FontSize      float64
+	TitleFontSize float64
+	AxisFontSize  float64
+
+	// BackgroundPadding is the padding between the edge of the chart and its canvas.
+	// Bar charts keep their own padding, which leaves room for their labels.
+	BackgroundPadding Box
+}
+
+// GetBackgroundColor returns the background color or the default value.
+func (t *Theme) GetBackgroundColor() drawing.Color {
+	if t == nil || t.BackgroundColor.IsZero() {
+		return DefaultBackgroundColor
+	}
+	return t.BackgroundColor
+}
+
+// GetBackgroundStrokeColor returns the background stroke color or the default value.
+func (t *Theme) GetBackgroundStrokeColor() drawing.Color {
+	if t == nil || t.BackgroundStrokeColor.IsZero() {
+		return DefaultBackgroundStrokeColor
+	}
+	return t.BackgroundStrokeColor
+}
+
+// GetCanvasColor returns the canvas color or the default value.
+func (t *Theme) GetCanvasColor() drawing.Color {
+	if t == nil || t.CanvasColor.IsZero() {
+		return DefaultCanvasColor
+	}
+	return t.CanvasColor
+}
+
+// GetCanvasStrokeColor returns the canvas stroke color or the default value.
+func (t *Theme) GetCanvasStrokeColor() drawing.Color {
+	if t == nil || t.CanvasStrokeColor.IsZero() {
+		return DefaultCanvasStrokeColor
+	}
+	return t.CanvasStrokeColor
+}
+
+// GetAxisColor returns the axis color or the default value.
+func (t *Theme) GetAxisColor() drawing.Color {
+	if t == nil || t.AxisColor.IsZero() {
+		return DefaultAxisColor
+	}
+	return t.AxisColor
+}
+
+// GetTextColor returns the text color or the default value.
+func (t *Theme) GetTextColor() drawing.Color {
+	if t == nil || t.TextColor.IsZero() {
+		return DefaultTextColor
+	}
+	return t.TextColor
+}
+
+// GetSeriesColor returns the color of a series by index or the default value.
+// NOTE: the index will wrap around (using a modulo).
+func (t *Theme) GetSeriesColor(index int) drawing.Color {
+	if t == nil || len(t.SeriesColors) == 0 {
+		return GetDefaultColor(index)
+	}
+	return getColor(t.SeriesColors, index)
+}
+
+// GetFontSize returns the font size or the default value.
+func (t *Theme) GetFontSize() float64 {
+	if t == nil || t.FontSize == 0 {
+		return DefaultFontSize
+	}
+	return t.FontSize
+}
+
+// GetTitleFontSize returns the title font size or the default value.
+func (t *Theme) GetTitleFontSize() float64 {
+	if t == nil || t.TitleFontSize == 0 {
+		return DefaultTitleFontSize
+	}
+	return t.TitleFontSize
+}
+
+// GetAxisFontSize returns the axis font size or the default value.
+func (t *Theme) GetAxisFontSize() float64 {
+	if t == nil || t.AxisFontSize == 0 {
+		return DefaultAxisFontSize
+	}
+	return t.AxisFontSize
+}
+
+// GetBackgroundPadding returns the background padding or the default value.
+func (t *Theme) GetBackgroundPadding() Box {
+	if t == nil || t.BackgroundPadding.IsZero() {
+		return DefaultBackgroundPadding
+	}
+	return t.BackgroundPadding
+}
+
+// ColorPalette returns the theme colors as a color palette, with `DefaultColorPalette` for the colors it doesn't set.
+func (t *Theme) ColorPalette() ColorPalette {
+	return t.colorPalette(DefaultColorPalette)
+}
+
+// colorPalette returns the theme colors as a color palette, with a fallback palette for the colors it doesn't set.
+func (t *Theme) colorPalette(fallback ColorPalette) ColorPalette {
+	if t == nil {
+		return fallback
+	}
+	return themeColorPalette{theme: t, fallback: fallback}
+}
+
+// themeColorPalette is the color palette of a theme.
+type themeColorPalette struct {
+	theme    *Theme
+	fallback ColorPalette
+}
+
+func (tp themeColorPalette) BackgroundColor() drawing.Color {
+	return themeColor(tp.theme.BackgroundColor, tp.fallback.BackgroundColor)
+}
+
+func (tp themeColorPalette) BackgroundStrokeColor() drawing.Color {
+	return themeColor(tp.theme.BackgroundStrokeColor, tp.fallback.BackgroundStrokeColor)
+}
+
+func (tp themeColorPalette) CanvasColor() drawing.Color {
+	return themeColor(tp.theme.CanvasColor, tp.fallback.CanvasColor)
+}
+
+func (tp themeColorPalette) CanvasStrokeColor() drawing.Color {
+	return themeColor(tp.theme.CanvasStrokeColor, tp.fallback.CanvasStrokeColor)
+}
+
+func (tp themeColorPalette) AxisStrokeColor() drawing.Color {
+	return themeColor(tp.theme.AxisColor, tp.fallback.AxisStrokeColor)
+}
+
+func (tp themeColorPalette) TextColor() drawing.Color {
+	return themeColor(tp.theme.TextColor, tp.fallback.TextColor)
+}
+
+func (tp themeColorPalette) GetSeriesColor(index int) drawing.Color {
+	if len(tp.theme.SeriesColors) == 0 {
+		return tp.fallback.GetSeriesColor(index)
+	}
+	return getColor(tp.theme.SeriesColors, index)
+}
+
+// themeColor returns a theme color, or the fallback color if the theme doesn't set it.
+func themeColor(color drawing.Color, fallback func() drawing.Color) drawing.Color {
+	if color.IsZero() {
+		return fallback()
+	}
+	return color
+}
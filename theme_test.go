@@ -0,0 +1,145 @@
+package chart
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestThemeNilDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	var theme *Theme
+	assert.Equal(DefaultBackgroundColor, theme.GetBackgroundColor())
+	assert.Equal(DefaultCanvasColor, theme.GetCanvasColor())
+	assert.Equal(DefaultAxisColor, theme.GetAxisColor())
+	assert.Equal(DefaultTextColor, theme.GetTextColor())
+	assert.Equal(GetDefaultColor(3), theme.GetSeriesColor(3))
+	assert.Equal(DefaultFontSize, theme.GetFontSize())
+	assert.Equal(DefaultTitleFontSize, theme.GetTitleFontSize())
+	assert.Equal(DefaultAxisFontSize, theme.GetAxisFontSize())
+	assert.Equal(DefaultBackgroundPadding, theme.GetBackgroundPadding())
+}
+
+func TestThemePartial(t *testing.T) {
+	assert := assert.New(t)
+
+	theme := &Theme{
+		TextColor:    drawing.ColorRed,
+		SeriesColors: []drawing.Color{drawing.ColorBlue, drawing.ColorGreen},
+		FontSize:     12,
+	}
+	assert.Equal(drawing.ColorRed, theme.GetTextColor())
+	assert.Equal(DefaultBackgroundColor, theme.GetBackgroundColor())
+	assert.Equal(drawing.ColorGreen, theme.GetSeriesColor(3))
+	assert.Equal(12.0, theme.GetFontSize())
+	assert.Equal(DefaultTitleFontSize, theme.GetTitleFontSize())
+}
+
+func themeTestChart() Chart {
+	return Chart{
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+		},
+	}
+}
+
+func renderThemeTestChart(t *testing.T, c Chart) string {
+	buffer := bytes.NewBuffer(nil)
+	if err := c.Render(SVG, buffer); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.String()
+}
+
+func TestChartTheme(t *testing.T) {
+	assert := assert.New(t)
+
+	plain := renderThemeTestChart(t, themeTestChart())
+	assert.NotContains(plain, ThemeDark.BackgroundColor.Hex())
+
+	c := themeTestChart()
+	c.Theme = ThemeDark
+	dark := renderThemeTestChart(t, c)
+	assert.Contains(dark, "fill:"+ThemeDark.BackgroundColor.Hex())
+	assert.Contains(dark, "stroke:"+ThemeDark.SeriesColors[0].Hex())
+
+	// an explicit color palette wins over the theme colors.
+	c.ColorPalette = DefaultColorPalette
+	assert.Equal(plain, renderThemeTestChart(t, c))
+}
+
+func TestSetDefaultTheme(t *testing.T) {
+	assert := assert.New(t)
+
+	plain := renderThemeTestChart(t, themeTestChart())
+
+	SetDefaultTheme(ThemeDark)
+	defer SetDefaultTheme(nil)
+	assert.Equal(ThemeDark, GetDefaultTheme())
+	assert.Contains(renderThemeTestChart(t, themeTestChart()), "fill:"+ThemeDark.BackgroundColor.Hex())
+
+	SetDefaultTheme(nil)
+	assert.Nil(GetDefaultTheme())
+	assert.Equal(plain, renderThemeTestChart(t, themeTestChart()))
+}
+
+func TestBarChartTheme(t *testing.T) {
+	assert := assert.New(t)
+
+	bc := BarChart{
+		Theme: ThemeDark,
+		Bars:  []Value{{Value: 1, Label: "one"}, {Value: 2, Label: "two"}},
+	}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(bc.Render(SVG, buffer))
+	assert.Contains(buffer.String(), "fill:"+ThemeDark.BackgroundColor.Hex())
+}
+
+func TestThemeKeepsChartColorPalette(t *testing.T) {
+	assert := assert.New(t)
+
+	theme := &Theme{TextColor: drawing.ColorRed}
+	for _, palette := range []ColorPalette{
+		BarChart{Theme: theme}.GetColorPalette(),
+		PieChart{Theme: theme}.GetColorPalette(),
+		DonutChart{Theme: theme}.GetColorPalette(),
+		StackedBarChart{Theme: theme}.GetColorPalette(),
+	} {
+		// a theme without series colors keeps the alternate colors of these charts.
+		assert.Equal(GetAlternateColor(1), palette.GetSeriesColor(1))
+		assert.Equal(drawing.ColorRed, palette.TextColor())
+		assert.Equal(DefaultBackgroundColor, palette.BackgroundColor())
+	}
+	assert.Equal(GetDefaultColor(1), Chart{Theme: theme}.GetColorPalette().GetSeriesColor(1))
+	assert.Equal(GetDefaultColor(1), theme.ColorPalette().GetSeriesColor(1))
+
+	theme.SeriesColors = []drawing.Color{drawing.ColorBlue}
+	assert.Equal(drawing.ColorBlue, BarChart{Theme: theme}.GetColorPalette().GetSeriesColor(1))
+}
+
+func TestChartThemeSeriesDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	series := ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}}
+	c := Chart{
+		Theme: ThemeDark,
+		Series: []Series{
+			series,
+			FillBetweenSeries{
+				Upper: ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{2, 4, 3}},
+				Lower: series,
+			},
+			LastValueAnnotationSeries(series, func(v interface{}) string { return "last" }),
+		},
+	}
+	dark := renderThemeTestChart(t, c)
+	// the band is filled with the theme axis color, and the annotation uses the theme text color.
+	assert.Contains(dark, "fill:"+ThemeDark.AxisColor.Hex()+";fill-opacity:0.125")
+	label := regexp.MustCompile(`fill:(#[0-9a-f]{6});[^"]*">last</text>`).FindStringSubmatch(dark)
+	assert.Len(label, 2)
+	assert.Equal(ThemeDark.TextColor.Hex(), label[1])
+}
@@ -44,15 +44,39 @@ func (t Ticks) String() string {
 	return strings.Join(values, ", ")
 }
 
-// GenerateContinuousTicks generates a set of ticks.
-func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick {
+// getUserTicks returns the user supplied ticks that fall within the range,
+// labeling any ticks that don't have a label with the value formatter.
+func getUserTicks(ticks []Tick, ra Range, vf ValueFormatter) []Tick {
 	if vf == nil {
 		vf = FloatValueFormatter
 	}
 
+	min, max := ra.GetMin(), ra.GetMax()
+	var output []Tick
+	for _, t := range ticks {
+		if t.Value < min || t.Value > max {
+			continue
+		}
+		if len(t.Label) == 0 {
+			t.Label = vf(t.Value)
+		}
+		output = append(output, t)
+	}
+	return output
+}
+
+// GenerateContinuousTicks generates a set of ticks.
+// Without a value formatter the labels get as many decimals as the tick step needs.
+func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick {
 	var ticks []Tick
 	min, max := ra.GetMin(), ra.GetMax()
 
+	stepFormatted := vf == nil
+	if stepFormatted {
+		// labels are measured with the precision of the whole range until the step is known.
+		vf = StepValueFormatter(math.Abs(max - min))
+	}
+
 	if ra.IsDescending() {
 		ticks = append(ticks, Tick{
 			Value: max,
@@ -65,16 +89,7 @@ func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style,
 		})
 	}
 
-	minLabel := vf(min)
-	style.GetTextOptions().WriteToRenderer(r)
-	labelBox := r.MeasureText(minLabel)
-
-	var tickSize float64
-	if isVertical {
-		tickSize = float64(labelBox.Height() + DefaultMinimumTickVerticalSpacing)
-	} else {
-		tickSize = float64(labelBox.Width() + DefaultMinimumTickHorizontalSpacing)
-	}
+	tickSize := getTickSize(r, ra, isVertical, style, vf)
 
 	domain := float64(ra.GetDomain())
 	domainRemainder := domain - (tickSize * 2)
@@ -111,5 +126,153 @@ func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style,
 		})
 	}
 
+	if stepFormatted && intermediateTickCount > 0 {
+		vf = StepValueFormatter(tickStep)
+		for index := range ticks {
+			ticks[index].Label = vf(ticks[index].Value)
+		}
+	}
+	return ticks
+}
+
+// GenerateNiceTicks generates a set of ticks at "nice" values, i.e. multiples of a step of 1, 2 or 5 times a power of ten,
+// between the range min and max. The step is the smallest one that keeps the labels from colliding.
+// The ends of the range only get ticks if they're multiples of the step; ranges the chart works out from the values
+// are extended to the multiples around them first, see `extendRangeToNiceTicks`. A range too narrow to hold two multiples
+// gets ticks at its ends instead. Without a value formatter the labels get as many decimals as the tick step needs.
+func GenerateNiceTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick {
+	min, max := ra.GetMin(), ra.GetMax()
+
+	stepFormatted := vf == nil
+	if stepFormatted {
+		// labels are measured with the precision of the whole range until the step is known.
+		vf = StepValueFormatter(math.Abs(max - min))
+	}
+
+	var values []float64
+	if step := getNiceTickStep(r, ra, isVertical, style, vf, stepFormatted); step > 0 {
+		if stepFormatted {
+			vf = StepValueFormatter(step)
+		}
+		// multiples are compared with a little tolerance, so the ends of an extended range count as multiples.
+		tolerance := step * 1e-9
+		first := math.Ceil((min-tolerance)/step) * step
+		for x := 0; x < DefaultTickCountSanityCheck; x++ {
+			value := first + (float64(x) * step)
+			if value > max+tolerance {
+				break
+			}
+			values = append(values, value)
+		}
+	}
+	if len(values) < 2 {
+		values = []float64{min, max}
+	}
+
+	ticks := make([]Tick, len(values))
+	for index, value := range values {
+		if ra.IsDescending() {
+			value = values[len(values)-1-index]
+		}
+		ticks[index] = Tick{
+			Value: value,
+			Label: vf(value),
+		}
+	}
 	return ticks
 }
+
+// extendRangeToNiceTicks extends a range out to the multiples of the step `GenerateNiceTicks` generates ticks at,
+// so its first and last ticks are at its ends, e.g. a range of 3.7 to 96.2 becomes 0 to 100.
+// The range must have its domain set.
+func extendRangeToNiceTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) {
+	stepFormatted := vf == nil
+	if stepFormatted {
+		vf = StepValueFormatter(math.Abs(ra.GetMax() - ra.GetMin()))
+	}
+	step := getNiceTickStep(r, ra, isVertical, style, vf, stepFormatted)
+	if step <= 0 {
+		return
+	}
+	tolerance := step * 1e-9
+	ra.SetMin(math.Floor((ra.GetMin()+tolerance)/step) * step)
+	ra.SetMax(math.Ceil((ra.GetMax()-tolerance)/step) * step)
+}
+
+// getNiceTickStep returns the smallest nice number that's at least the distance in range units between two labels,
+// or zero if the range has no domain or delta. The step is worked out from the wider of the end labels, and then
+// grown until none of the labels of the ticks it gives collide; labels that are step formatted are measured with
+// the decimals of each step tried.
+func getNiceTickStep(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter, stepFormatted bool) float64 {
+	domain := float64(ra.GetDomain())
+	delta := math.Abs(ra.GetMax() - ra.GetMin())
+	if domain <= 0 || delta <= 0 || !isFinite(delta) {
+		return 0
+	}
+	tickSize := getTickSize(r, ra, isVertical, style, vf)
+	step := NiceNumber(delta * (tickSize / domain))
+	for x := 0; x < DefaultTickCountSanityCheck; x++ {
+		labelFormatter := vf
+		if stepFormatted {
+			labelFormatter = StepValueFormatter(step)
+		}
+		if !niceTickLabelsCollide(r, ra, isVertical, style, labelFormatter, step) {
+			break
+		}
+		// the next nice number up, e.g. 2 from 1, 5 from 2 and 10 from 5.
+		step = NiceNumber(step * 1.5)
+	}
+	return step
+}
+
+// niceTickLabelsCollide returns if the labels of any two neighbouring ticks at the multiples of a step within the range
+// are nearer than the tick label spacing of the style.
+func niceTickLabelsCollide(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter, step float64) bool {
+	style.GetTextOptions().WriteToRenderer(r)
+	spacing := getTickLabelSpacing(isVertical, style)
+
+	min, max := math.Min(ra.GetMin(), ra.GetMax()), math.Max(ra.GetMin(), ra.GetMax())
+	tolerance := step * 1e-9
+	first := math.Ceil((min-tolerance)/step) * step
+
+	var previousPosition, previousSize float64
+	for x := 0; x < DefaultTickCountSanityCheck; x++ {
+		value := first + (float64(x) * step)
+		if value > max+tolerance {
+			break
+		}
+		labelBox := r.MeasureText(vf(value))
+		position, size := translateFloat(ra, value), float64(labelBox.Width())
+		if isVertical {
+			size = float64(labelBox.Height())
+		}
+		if x > 0 && math.Abs(position-previousPosition) < (size+previousSize)/2+float64(spacing) {
+			return true
+		}
+		previousPosition, previousSize = position, size
+	}
+	return false
+}
+
+// getTickSize returns the space one tick label needs along the axis, including spacing,
+// sized off the wider of the two end labels so neither collides.
+func getTickSize(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) float64 {
+	style.GetTextOptions().WriteToRenderer(r)
+	labelBox := r.MeasureText(vf(ra.GetMin()))
+	if maxLabelBox := r.MeasureText(vf(ra.GetMax())); maxLabelBox.Width() > labelBox.Width() {
+		labelBox = maxLabelBox
+	}
+
+	if isVertical {
+		return float64(labelBox.Height() + getTickLabelSpacing(isVertical, style))
+	}
+	return float64(labelBox.Width() + getTickLabelSpacing(isVertical, style))
+}
+
+// getTickLabelSpacing returns the least space between the tick labels of an axis with a style.
+func getTickLabelSpacing(isVertical bool, style Style) int {
+	if isVertical {
+		return style.GetTickLabelSpacing(DefaultMinimumTickVerticalSpacing)
+	}
+	return style.GetTickLabelSpacing(DefaultMinimumTickHorizontalSpacing)
+}
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"math"
 	"testing"
 
 	assert "github.com/blend/go-sdk/assert"
@@ -58,3 +59,262 @@ func TestGenerateContinuousTicksDescending(t *testing.T) {
 	assert.Equal(1.0, ticks[len(ticks)-2].Value)
 	assert.Equal(0.0, ticks[len(ticks)-1].Value)
 }
+
+func TestGenerateContinuousTicksDoNotCollide(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	// the max label is much wider than the min label.
+	ra := &ContinuousRange{
+		Min:    0.0,
+		Max:    1000000.0,
+		Domain: 512,
+	}
+	style := Style{Font: f, FontSize: 10.0}
+
+	ticks := GenerateContinuousTicks(r, ra, false, style, FloatValueFormatter)
+	assert.NotEmpty(ticks)
+
+	style.GetTextOptions().WriteToRenderer(r)
+	for index := 1; index < len(ticks); index++ {
+		previous := r.MeasureText(ticks[index-1].Label)
+		current := r.MeasureText(ticks[index].Label)
+		gap := ra.Translate(ticks[index].Value) - ra.Translate(ticks[index-1].Value)
+		assert.True(gap >= (previous.Width()>>1)+(current.Width()>>1))
+	}
+}
+
+func TestNiceNumber(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(1.0, NiceNumber(1.0))
+	assert.Equal(2.0, NiceNumber(1.2))
+	assert.Equal(5.0, NiceNumber(3.3))
+	assert.Equal(10.0, NiceNumber(7.0))
+	assert.Equal(50.0, NiceNumber(48.0))
+	assert.InDelta(0.2, NiceNumber(0.15), 0.0000001)
+}
+
+func TestGenerateNiceTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	ra := &ContinuousRange{
+		Min:    0.0,
+		Max:    10.0,
+		Domain: 256,
+	}
+
+	ticks := GenerateNiceTicks(r, ra, true, Style{Font: f, FontSize: 10.0}, FloatValueFormatter)
+	assert.NotEmpty(ticks)
+	assert.Equal(0.0, ticks[0].Value)
+	assert.Equal(10.0, ticks[len(ticks)-1].Value)
+	for _, tick := range ticks {
+		assert.Equal(tick.Value, math.Round(tick.Value))
+	}
+
+	// a range that doesn't start on a nice number only gets ticks at nice numbers within it.
+	ra = &ContinuousRange{
+		Min:    0.3,
+		Max:    9.7,
+		Domain: 256,
+	}
+	ticks = GenerateNiceTicks(r, ra, true, Style{Font: f, FontSize: 10.0}, FloatValueFormatter)
+	assert.True(len(ticks) > 2)
+	assert.True(ticks[0].Value >= 0.3)
+	assert.True(ticks[len(ticks)-1].Value <= 9.7)
+	for _, tick := range ticks {
+		assert.Equal(tick.Value, math.Round(tick.Value))
+	}
+
+	// one too narrow to hold two nice numbers gets ticks at its ends.
+	ra = &ContinuousRange{
+		Min:    0.31,
+		Max:    0.33,
+		Domain: 10,
+	}
+	ticks = GenerateNiceTicks(r, ra, true, Style{Font: f, FontSize: 10.0}, FloatValueFormatter)
+	assert.Equal([]float64{0.31, 0.33}, []float64{ticks[0].Value, ticks[1].Value})
+}
+
+func TestExtendRangeToNiceTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: 10.0}
+
+	ra := &ContinuousRange{Min: 3.7, Max: 96.2, Domain: 256}
+	extendRangeToNiceTicks(r, ra, true, style, nil)
+	assert.Equal(0.0, ra.Min)
+	assert.Equal(100.0, ra.Max)
+
+	ticks := GenerateNiceTicks(r, ra, true, style, nil)
+	assert.Equal(0.0, ticks[0].Value)
+	assert.Equal(100.0, ticks[len(ticks)-1].Value)
+
+	// a range at nice numbers already is left as it is.
+	extendRangeToNiceTicks(r, ra, true, style, nil)
+	assert.Equal(0.0, ra.Min)
+	assert.Equal(100.0, ra.Max)
+
+	// as is one without a domain.
+	ra = &ContinuousRange{Min: 3.7, Max: 96.2}
+	extendRangeToNiceTicks(r, ra, true, style, nil)
+	assert.Equal(3.7, ra.Min)
+	assert.Equal(96.2, ra.Max)
+}
+
+func TestChartNiceTicksExtendRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{3.7, 50, 96.2},
+				YValues: []float64{3.7, 50, 96.2},
+			},
+		},
+	}
+	layout, err := c.Measure(PNG)
+	assert.Nil(err)
+	assert.Equal(0.0, layout.XRange.GetMin())
+	assert.Equal(100.0, layout.XRange.GetMax())
+	assert.Equal(0.0, layout.YRange.GetMin())
+	assert.Equal(100.0, layout.YRange.GetMax())
+
+	// ranges that are set are left as they are, and only get ticks at nice numbers within them.
+	c.XAxis.Range = &ContinuousRange{Min: 3.7, Max: 96.2}
+	layout, err = c.Measure(PNG)
+	assert.Nil(err)
+	assert.Equal(3.7, layout.XRange.GetMin())
+	assert.Equal(96.2, layout.XRange.GetMax())
+}
+
+func TestGenerateNiceTicksDescending(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	ra := &ContinuousRange{
+		Min:        0.0,
+		Max:        10.0,
+		Domain:     256,
+		Descending: true,
+	}
+
+	ticks := GenerateNiceTicks(r, ra, true, Style{Font: f, FontSize: 10.0}, FloatValueFormatter)
+	assert.NotEmpty(ticks)
+	assert.Equal(10.0, ticks[0].Value)
+	assert.Equal(0.0, ticks[len(ticks)-1].Value)
+	for index := 1; index < len(ticks); index++ {
+		assert.True(ticks[index].Value < ticks[index-1].Value)
+	}
+}
+
+func TestStepValueFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		Step     float64
+		Value    float64
+		Expected string
+	}{
+		{0.00001, 0.00012, "0.00012"},
+		{0.00002, 0.000186, "0.00019"},
+		{0.5, 1.25, "1.2"},
+		{1, 1.25, "1"},
+		{200000, 1234567.89, "1234568"},
+		{1e-20, 1, "1.0000000000"},
+		{0, 1, "1.00"},
+		{math.NaN(), 1, "1.00"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, StepValueFormatter(tc.Step)(tc.Value), tc.Step)
+	}
+}
+
+func TestGenerateNiceTicksStepPrecision(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: DefaultFontSize}
+
+	small := &ContinuousRange{Min: 0.00012, Max: 0.00019, Domain: 400}
+	ticks := GenerateNiceTicks(r, small, true, style, nil)
+	assert.True(len(ticks) > 2)
+	labels := map[string]bool{}
+	for _, tick := range ticks {
+		assert.False(labels[tick.Label], tick.Label)
+		labels[tick.Label] = true
+	}
+	assert.Equal("0.00012", ticks[0].Label)
+
+	large := &ContinuousRange{Min: 0, Max: 2000000, Domain: 400}
+	ticks = GenerateNiceTicks(r, large, true, style, nil)
+	for _, tick := range ticks {
+		assert.NotContains(tick.Label, ".")
+	}
+
+	// explicit formatters are left alone.
+	ticks = GenerateNiceTicks(r, large, true, style, FloatValueFormatter)
+	assert.Equal("2000000.00", ticks[len(ticks)-1].Label)
+
+	ticks = GenerateContinuousTicks(r, small, true, style, nil)
+	for _, tick := range ticks {
+		assert.NotEqual("0.00", tick.Label)
+	}
+}
+
+func TestGenerateNiceTicksLabelsDoNotCollide(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: DefaultFontSize}
+
+	// the end labels are narrow, so the step worked out from them alone leaves the ones between colliding.
+	vf := func(v interface{}) string {
+		if value := v.(float64); value == 0 || value == 100 {
+			return "0"
+		}
+		return "a much wider label"
+	}
+	ra := &ContinuousRange{Min: 0, Max: 100, Domain: 400}
+	ticks := GenerateNiceTicks(r, ra, false, style, vf)
+	assert.True(len(ticks) > 2)
+	for index := 1; index < len(ticks); index++ {
+		width := Draw.MeasureText(r, ticks[index].Label, style).Width()
+		previousWidth := Draw.MeasureText(r, ticks[index-1].Label, style).Width()
+		distance := ra.Translate(ticks[index].Value) - ra.Translate(ticks[index-1].Value)
+		assert.True(distance >= (width+previousWidth)>>1+DefaultMinimumTickHorizontalSpacing, ticks[index].Label)
+	}
+
+	// the spacing between labels can be set with the style.
+	spaced := GenerateNiceTicks(r, ra, false, Style{Font: f, FontSize: DefaultFontSize, TickLabelSpacing: 200}, vf)
+	assert.True(len(spaced) < len(ticks))
+	for index := 1; index < len(spaced); index++ {
+		assert.True(ra.Translate(spaced[index].Value)-ra.Translate(spaced[index-1].Value) >= 200)
+	}
+}
@@ -0,0 +1,315 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Interface Assertions.
+var (
+	_ Range           = (*TimeRange)(nil)
+	_ FloatTranslator = (*TimeRange)(nil)
+	_ TicksProvider   = (*TimeRange)(nil)
+)
+
+// TimeRange represents a boundary for a set of times, as nanoseconds since the epoch like `TimeToFloat64` returns.
+// Its ticks land on calendar boundaries, i.e. whole seconds, minutes, hours, days, months or years,
+// picked by how much time the range spans and how much room the labels need.
+type TimeRange struct {
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+
+	// Location is the time zone ticks are aligned and labeled in; it defaults to the local time zone.
+	Location *time.Location
+	// ValueFormatter labels the ticks. By default the layout matches the tick step,
+	// e.g. "15:04" for ticks every few minutes or "Jan 02" for ticks every few days.
+	// The value formatter of the axis is not used for a time range.
+	ValueFormatter ValueFormatter
+}
+
+// IsDescending returns if the range is descending.
+func (r TimeRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the TimeRange has been set or not.
+func (r TimeRange) IsZero() bool {
+	return (r.Min == 0 || math.IsNaN(r.Min)) &&
+		(r.Max == 0 || math.IsNaN(r.Max)) &&
+		r.Domain == 0
+}
+
+// IsDegenerate returns if the range has no delta, i.e. min and max are equal.
+// Values translate to the middle of the domain of a degenerate range.
+func (r TimeRange) IsDegenerate() bool {
+	return r.Min == r.Max
+}
+
+// GetMin gets the min value for the time range.
+func (r TimeRange) GetMin() float64 {
+	return r.Min
+}
+
+// SetMin sets the min value for the time range.
+func (r *TimeRange) SetMin(min float64) {
+	r.Min = min
+}
+
+// GetMax returns the max value for the time range.
+func (r TimeRange) GetMax() float64 {
+	return r.Max
+}
+
+// SetMax sets the max value for the time range.
+func (r *TimeRange) SetMax(max float64) {
+	r.Max = max
+}
+
+// GetDelta returns the difference between the min and max value.
+func (r TimeRange) GetDelta() float64 {
+	return r.Max - r.Min
+}
+
+// GetDomain returns the range domain.
+func (r TimeRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *TimeRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// GetLocation returns the location ticks are aligned in or the default value.
+func (r TimeRange) GetLocation() *time.Location {
+	if r.Location == nil {
+		return time.Local
+	}
+	return r.Location
+}
+
+// String returns a simple string for the TimeRange.
+func (r TimeRange) String() string {
+	if r.GetDelta() == 0 {
+		return "TimeRange [empty]"
+	}
+	return fmt.Sprintf("TimeRange [%s,%s] => %d",
+		TimeFromFloat64(r.Min).In(r.GetLocation()).Format(time.RFC3339),
+		TimeFromFloat64(r.Max).In(r.GetLocation()).Format(time.RFC3339),
+		r.Domain)
+}
+
+// Translate maps a given value into the TimeRange space.
+func (r TimeRange) Translate(value float64) int {
+	if r.IsDegenerate() {
+		return r.Domain / 2
+	}
+	normalized := value - r.Min
+	ratio := normalized / r.GetDelta()
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// TranslateFloat maps a given value into the TimeRange space without rounding to a whole pixel.
+func (r TimeRange) TranslateFloat(value float64) float64 {
+	if r.IsDegenerate() {
+		return float64(r.Domain) / 2
+	}
+	ratio := (value - r.Min) / r.GetDelta()
+	if r.IsDescending() {
+		return float64(r.Domain) * (1 - ratio)
+	}
+
+	return ratio * float64(r.Domain)
+}
+
+// GetTicks returns the ticks for the range.
+// The tick step is the smallest calendar step whose labels don't collide; the min and max
+// only get ticks of their own if no step boundary falls within the range.
+func (r TimeRange) GetTicks(rend Renderer, defaults Style, _ ValueFormatter) []Tick {
+	min, max := math.Min(r.Min, r.Max), math.Max(r.Min, r.Max)
+	delta := max - min
+
+	var step timeStep
+	values := []float64{min, max}
+	if r.Domain > 0 && delta > 0 {
+		step = r.getStep(rend, defaults)
+
+		loc := r.GetLocation()
+		start, end := TimeFromFloat64(min).In(loc), TimeFromFloat64(max).In(loc)
+		var stepValues []float64
+		t := step.floor(start)
+		for x := 0; x < DefaultTickCountSanityCheck && !t.After(end); x++ {
+			if !t.Before(start) {
+				stepValues = append(stepValues, TimeToFloat64(t))
+			}
+			t = step.next(t)
+		}
+		if len(stepValues) > 0 {
+			values = stepValues
+		}
+	}
+
+	vf := r.getValueFormatter(step, delta)
+	ticks := make([]Tick, len(values))
+	for index := range values {
+		value := values[index]
+		if r.IsDescending() {
+			value = values[len(values)-1-index]
+		}
+		ticks[index] = Tick{
+			Value: value,
+			Label: vf(value),
+		}
+	}
+	return ticks
+}
+
+// getStep returns the smallest step whose labels fit between its ticks.
+func (r TimeRange) getStep(rend Renderer, style Style) timeStep {
+	delta := math.Abs(r.GetDelta())
+	style.GetTextOptions().WriteToRenderer(rend)
+	for _, step := range timeSteps {
+		labelBox := rend.MeasureText(r.getValueFormatter(step, delta)(r.Min))
+		// the minimum distance in nanoseconds between two labels.
+		minimumStep := delta * float64(labelBox.Width()+style.GetTickLabelSpacing(DefaultMinimumTickHorizontalSpacing)) / float64(r.Domain)
+		if float64(step.approximate()) >= minimumStep {
+			return step
+		}
+	}
+	return timeSteps[len(timeSteps)-1]
+}
+
+// getValueFormatter returns the range value formatter, or one with a layout precise enough for a step.
+func (r TimeRange) getValueFormatter(step timeStep, delta float64) ValueFormatter {
+	if r.ValueFormatter != nil {
+		return r.ValueFormatter
+	}
+	return TimeValueFormatterWithFormatInLocation(step.layout(time.Duration(delta)), r.GetLocation())
+}
+
+type timeUnit int
+
+const (
+	timeUnitSecond timeUnit = iota
+	timeUnitMinute
+	timeUnitHour
+	timeUnitDay
+	timeUnitMonth
+	timeUnitYear
+)
+
+// timeStep is a calendar aligned tick step, e.g. every 15 minutes or every 3 months.
+type timeStep struct {
+	unit  timeUnit
+	count int
+}
+
+// timeSteps are the steps a time range picks from, smallest first.
+var timeSteps = []timeStep{
+	{timeUnitSecond, 1}, {timeUnitSecond, 2}, {timeUnitSecond, 5}, {timeUnitSecond, 15}, {timeUnitSecond, 30},
+	{timeUnitMinute, 1}, {timeUnitMinute, 2}, {timeUnitMinute, 5}, {timeUnitMinute, 15}, {timeUnitMinute, 30},
+	{timeUnitHour, 1}, {timeUnitHour, 2}, {timeUnitHour, 3}, {timeUnitHour, 6}, {timeUnitHour, 12},
+	{timeUnitDay, 1}, {timeUnitDay, 2}, {timeUnitDay, 7}, {timeUnitDay, 14},
+	{timeUnitMonth, 1}, {timeUnitMonth, 2}, {timeUnitMonth, 3}, {timeUnitMonth, 6},
+	{timeUnitYear, 1}, {timeUnitYear, 2}, {timeUnitYear, 5}, {timeUnitYear, 10}, {timeUnitYear, 25},
+	{timeUnitYear, 50}, {timeUnitYear, 100},
+}
+
+// approximate returns the typical duration of the step; months and years vary in length.
+func (ts timeStep) approximate() time.Duration {
+	switch ts.unit {
+	case timeUnitSecond:
+		return time.Duration(ts.count) * time.Second
+	case timeUnitMinute:
+		return time.Duration(ts.count) * time.Minute
+	case timeUnitHour:
+		return time.Duration(ts.count) * time.Hour
+	case timeUnitDay:
+		return time.Duration(ts.count) * 24 * time.Hour
+	case timeUnitMonth:
+		return time.Duration(ts.count) * 730 * time.Hour
+	default:
+		return time.Duration(ts.count) * 8766 * time.Hour
+	}
+}
+
+// layout returns the time layout for labels of the step on a range spanning a given duration.
+func (ts timeStep) layout(span time.Duration) string {
+	switch ts.unit {
+	case timeUnitSecond:
+		return "15:04:05"
+	case timeUnitMinute:
+		return "15:04"
+	case timeUnitHour:
+		if span >= 24*time.Hour {
+			return "Jan 02 15:04"
+		}
+		return "15:04"
+	case timeUnitDay:
+		return "Jan 02"
+	case timeUnitMonth:
+		return "Jan 2006"
+	default:
+		return "2006"
+	}
+}
+
+// floor returns the step boundary at or before a given time.
+// Days are counted from the first of the month, months from January.
+func (ts timeStep) floor(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	loc := t.Location()
+	switch ts.unit {
+	case timeUnitSecond:
+		return time.Date(year, month, day, hour, minute, second-second%ts.count, 0, loc)
+	case timeUnitMinute:
+		return time.Date(year, month, day, hour, minute-minute%ts.count, 0, 0, loc)
+	case timeUnitHour:
+		return time.Date(year, month, day, hour-hour%ts.count, 0, 0, 0, loc)
+	case timeUnitDay:
+		return time.Date(year, month, day-(day-1)%ts.count, 0, 0, 0, 0, loc)
+	case timeUnitMonth:
+		return time.Date(year, month-(month-1)%time.Month(ts.count), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(year-year%ts.count, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// next returns the step boundary after a given step boundary.
+// Day steps restart on the first of each month so every month lines up the same way.
+func (ts timeStep) next(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	loc := t.Location()
+	switch ts.unit {
+	case timeUnitSecond:
+		return t.Add(time.Duration(ts.count) * time.Second)
+	case timeUnitMinute:
+		return t.Add(time.Duration(ts.count) * time.Minute)
+	case timeUnitHour:
+		// skipped or repeated hours around daylight saving changes must not stall the ticks.
+		if next := ts.floor(time.Date(year, month, day, hour+ts.count, minute, second, 0, loc)); next.After(t) {
+			return next
+		}
+		return t.Add(time.Duration(ts.count) * time.Hour)
+	case timeUnitDay:
+		next := time.Date(year, month, day+ts.count, 0, 0, 0, 0, loc)
+		if next.Month() != month {
+			return time.Date(year, month+1, 1, 0, 0, 0, 0, loc)
+		}
+		return next
+	case timeUnitMonth:
+		return time.Date(year, month+time.Month(ts.count), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(year+ts.count, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
@@ -0,0 +1,158 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func getTimeRangeTicks(t *testing.T, tr TimeRange) []Tick {
+	r, err := PNG(1024, 400)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := GetDefaultFont()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tr.GetTicks(r, Style{Font: f, FontSize: DefaultFontSize}, nil)
+}
+
+func timeRangeTicks(t *testing.T, start, end time.Time, domain int) []Tick {
+	return getTimeRangeTicks(t, TimeRange{
+		Min:      TimeToFloat64(start),
+		Max:      TimeToFloat64(end),
+		Domain:   domain,
+		Location: time.UTC,
+	})
+}
+
+func TestTimeRangeGetTicksHour(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2024, 3, 10, 13, 47, 21, 0, time.UTC)
+	ticks := timeRangeTicks(t, start, start.Add(time.Hour), 800)
+	assert.True(len(ticks) > 2)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value).In(time.UTC)
+		assert.Zero(tt.Second())
+		assert.Zero(tt.Minute() % 5)
+		assert.Equal(tt.Format("15:04"), tick.Label)
+	}
+	assert.Equal("13:50", ticks[0].Label)
+}
+
+func TestTimeRangeGetTicksMonth(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2024, 3, 3, 7, 12, 0, 0, time.UTC)
+	ticks := timeRangeTicks(t, start, start.AddDate(0, 1, 0), 800)
+	assert.True(len(ticks) > 2)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value).In(time.UTC)
+		assert.Zero(tt.Hour())
+		assert.Zero(tt.Minute())
+		assert.Equal(tt.Format("Jan 02"), tick.Label)
+	}
+}
+
+func TestTimeRangeGetTicksYears(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2001, 5, 17, 0, 0, 0, 0, time.UTC)
+	ticks := timeRangeTicks(t, start, start.AddDate(20, 0, 0), 400)
+	assert.True(len(ticks) > 2)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value).In(time.UTC)
+		assert.Equal(time.January, tt.Month())
+		assert.Equal(1, tt.Day())
+		assert.Equal(tt.Format("2006"), tick.Label)
+	}
+}
+
+func TestTimeRangeGetTicksLocation(t *testing.T) {
+	assert := assert.New(t)
+
+	// a zone with a half hour offset; hour ticks must land on its whole hours.
+	india := time.FixedZone("IST", 5*60*60+30*60)
+	start := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	tr := TimeRange{
+		Min:      TimeToFloat64(start),
+		Max:      TimeToFloat64(start.Add(12 * time.Hour)),
+		Domain:   800,
+		Location: india,
+	}
+	ticks := getTimeRangeTicks(t, tr)
+	assert.True(len(ticks) > 2)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value).In(india)
+		assert.Zero(tt.Minute())
+		assert.Equal(tt.Format("15:04"), tick.Label)
+	}
+}
+
+func TestTimeRangeGetTicksEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2024, 3, 10, 13, 47, 21, 0, time.UTC)
+	ticks := timeRangeTicks(t, start, start, 800)
+	assert.Len(ticks, 2)
+
+	// no boundary within the range keeps the min and max.
+	start = start.Add(200 * time.Millisecond)
+	ticks = timeRangeTicks(t, start, start.Add(100*time.Millisecond), 800)
+	assert.Len(ticks, 2)
+	assert.Equal(TimeToFloat64(start), ticks[0].Value)
+}
+
+func TestTimeRangeGetTicksDescending(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2024, 3, 10, 13, 47, 21, 0, time.UTC)
+	tr := TimeRange{
+		Min:            TimeToFloat64(start),
+		Max:            TimeToFloat64(start.Add(time.Hour)),
+		Domain:         800,
+		Descending:     true,
+		ValueFormatter: TimeValueFormatterWithFormat("04"),
+	}
+	ticks := getTimeRangeTicks(t, tr)
+	assert.True(len(ticks) > 2)
+	assert.True(ticks[0].Value > ticks[len(ticks)-1].Value)
+	assert.Equal(TimeValueFormatterWithFormat("04")(ticks[0].Value), ticks[0].Label)
+}
+
+func TestTimeStepNextCrossesMonths(t *testing.T) {
+	assert := assert.New(t)
+
+	step := timeStep{timeUnitDay, 7}
+	day := step.floor(time.Date(2024, 1, 30, 12, 0, 0, 0, time.UTC))
+	assert.Equal(29, day.Day())
+	next := step.next(day)
+	assert.Equal(time.February, next.Month())
+	assert.Equal(1, next.Day())
+	assert.Equal(8, step.next(next).Day())
+}
+
+func TestChartRenderTimeRange(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2024, 3, 10, 13, 47, 21, 0, time.UTC)
+	var times []time.Time
+	var values []float64
+	for index := 0; index < 100; index++ {
+		times = append(times, start.Add(time.Duration(index)*time.Minute))
+		values = append(values, float64(index))
+	}
+	c := Chart{
+		XAxis: XAxis{
+			Range: &TimeRange{Location: time.UTC},
+		},
+		Series: []Series{TimeSeries{XValues: times, YValues: values}},
+	}
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(SVG, buffer))
+	assert.Contains(buffer.String(), "14:00")
+}
@@ -2,6 +2,7 @@ package chart
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -11,8 +12,20 @@ var (
 	_ FirstValuesProvider    = (*TimeSeries)(nil)
 	_ LastValuesProvider     = (*TimeSeries)(nil)
 	_ ValueFormatterProvider = (*TimeSeries)(nil)
+	_ BoundsProvider         = (*TimeSeries)(nil)
 )
 
+// NewTimeSeries returns a new time series with the given name and values.
+// The series remembers its bounds between renders; see `ResetBounds`.
+func NewTimeSeries(name string, xvalues []time.Time, yvalues []float64) TimeSeries {
+	return TimeSeries{
+		Name:    name,
+		XValues: xvalues,
+		YValues: yvalues,
+		bounds:  new(boundsCache),
+	}
+}
+
 // TimeSeries is a line on a chart.
 type TimeSeries struct {
 	Name  string
@@ -20,8 +33,13 @@ type TimeSeries struct {
 
 	YAxis YAxisType
 
+	XValueFormatter ValueFormatter
+	YValueFormatter ValueFormatter
+
 	XValues []time.Time
 	YValues []float64
+
+	bounds *boundsCache
 }
 
 // GetName returns the name of the time series.
@@ -46,6 +64,54 @@ func (ts TimeSeries) GetValues(index int) (x, y float64) {
 	return
 }
 
+// GetBounds returns the bounds of the values of the series with a finite y value.
+// Series made with `NewTimeSeries` only scan their values again when `XValues` or `YValues`
+// is replaced or changes length.
+func (ts TimeSeries) GetBounds() (minx, maxx, miny, maxy float64) {
+	key := boundsCacheKey{xlen: len(ts.XValues), ylen: len(ts.YValues)}
+	if key.xlen > 0 {
+		key.x = &ts.XValues[0]
+	}
+	if key.ylen > 0 {
+		key.y = &ts.YValues[0]
+	}
+	return ts.bounds.get(key, ts.scanBounds)
+}
+
+// ResetBounds makes the series scan its values for its bounds again.
+// Call it after changing values in place.
+func (ts TimeSeries) ResetBounds() {
+	ts.bounds.reset()
+}
+
+// scanBounds returns the bounds of the values of the series with a finite y value.
+func (ts TimeSeries) scanBounds() (minx, maxx, miny, maxy float64) {
+	var first, last time.Time
+	miny, maxy = math.NaN(), math.NaN()
+	for index := 0; index < len(ts.XValues) && index < len(ts.YValues); index++ {
+		vx, vy := ts.XValues[index], ts.YValues[index]
+		if !isFinite(vy) {
+			continue
+		}
+		if math.IsNaN(miny) {
+			first, last, miny, maxy = vx, vx, vy, vy
+			continue
+		}
+		if vx.Before(first) {
+			first = vx
+		}
+		if vx.After(last) {
+			last = vx
+		}
+		miny = math.Min(miny, vy)
+		maxy = math.Max(maxy, vy)
+	}
+	if math.IsNaN(miny) {
+		return math.NaN(), math.NaN(), miny, maxy
+	}
+	return TimeToFloat64(first), TimeToFloat64(last), miny, maxy
+}
+
 // GetFirstValues gets the first values.
 func (ts TimeSeries) GetFirstValues() (x, y float64) {
 	x = TimeToFloat64(ts.XValues[0])
@@ -62,8 +128,16 @@ func (ts TimeSeries) GetLastValues() (x, y float64) {
 
 // GetValueFormatters returns value formatter defaults for the series.
 func (ts TimeSeries) GetValueFormatters() (x, y ValueFormatter) {
-	x = TimeValueFormatter
-	y = FloatValueFormatter
+	if ts.XValueFormatter != nil {
+		x = ts.XValueFormatter
+	} else {
+		x = TimeValueFormatter
+	}
+	if ts.YValueFormatter != nil {
+		y = ts.YValueFormatter
+	} else {
+		y = defaultValueFormatter
+	}
 	return
 }
 
@@ -87,5 +161,9 @@ func (ts TimeSeries) Validate() error {
 	if len(ts.YValues) == 0 {
 		return fmt.Errorf("time series must have yvalues set")
 	}
+
+	if len(ts.XValues) != len(ts.YValues) {
+		return fmt.Errorf("time series must have same length xvalues as yvalues")
+	}
 	return nil
 }
@@ -1,6 +1,8 @@
 package chart
 
 import (
+	"bytes"
+	"math"
 	"testing"
 	"time"
 
@@ -67,3 +69,119 @@ func TestTimeSeriesValidate(t *testing.T) {
 	}
 	assert.NotNil(cs.Validate())
 }
+
+func TestTimeSeriesValidateMismatchedLengths(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := TimeSeries{
+		XValues: []time.Time{
+			time.Now().AddDate(0, 0, -2),
+			time.Now().AddDate(0, 0, -1),
+		},
+		YValues: []float64{1.0, 2.0, 3.0},
+	}
+	assert.NotNil(ts.Validate())
+}
+
+func TestTimeSeriesValueFormatters(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := TimeSeries{}
+	xf, yf := ts.GetValueFormatters()
+	assert.NotNil(xf)
+	assert.NotNil(yf)
+
+	ts = TimeSeries{
+		XValueFormatter: TimeHourValueFormatter,
+		YValueFormatter: PercentValueFormatter,
+	}
+	xf, yf = ts.GetValueFormatters()
+	assert.Equal("01-02 3PM", xf(time.Date(2019, 01, 02, 15, 0, 0, 0, time.UTC)))
+	assert.Equal("50.00%", yf(0.5))
+}
+
+func TestTimeSeriesChartRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2019, 01, 02, 12, 0, 0, 0, time.UTC)
+	c := Chart{
+		Series: []Series{
+			TimeSeries{
+				XValues: []time.Time{
+					start.Add(2 * time.Hour),
+					start,
+					start.Add(time.Hour),
+					start.Add(time.Hour),
+				},
+				YValues: []float64{3.0, 1.0, 2.0, 2.5},
+			},
+		},
+	}
+
+	xr, _, _ := c.getRanges()
+	assert.Equal(TimeToFloat64(start), xr.GetMin())
+	assert.Equal(TimeToFloat64(start.Add(2*time.Hour)), xr.GetMax())
+}
+
+func TestTimeSeriesRenderSubSecond(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2019, 01, 02, 12, 0, 0, 0, time.UTC)
+	c := Chart{
+		Series: []Series{
+			TimeSeries{
+				XValues: []time.Time{
+					start,
+					start.Add(250 * time.Millisecond),
+					start.Add(500 * time.Millisecond),
+				},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestTimeSeriesGetBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	ts := TimeSeries{
+		XValues: []time.Time{now, now.Add(-time.Hour), now.Add(2 * time.Hour), now.Add(time.Hour)},
+		YValues: []float64{2, 1, math.NaN(), 4},
+	}
+	minx, maxx, miny, maxy := ts.GetBounds()
+	assert.Equal(TimeToFloat64(now.Add(-time.Hour)), minx)
+	assert.Equal(TimeToFloat64(now.Add(time.Hour)), maxx)
+	assert.Equal(1.0, miny)
+	assert.Equal(4.0, maxy)
+
+	minx, _, miny, _ = TimeSeries{}.GetBounds()
+	assert.True(math.IsNaN(minx))
+	assert.True(math.IsNaN(miny))
+}
+
+func TestTimeSeriesCachedBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	ts := NewTimeSeries("cached", []time.Time{now, now.Add(time.Hour)}, []float64{1, 2})
+	_, maxx, _, _ := ts.GetBounds()
+	assert.Equal(TimeToFloat64(now.Add(time.Hour)), maxx)
+
+	ts.XValues[1] = now.Add(2 * time.Hour)
+	_, maxx, _, _ = ts.GetBounds()
+	assert.Equal(TimeToFloat64(now.Add(time.Hour)), maxx)
+	ts.ResetBounds()
+	_, maxx, _, _ = ts.GetBounds()
+	assert.Equal(TimeToFloat64(now.Add(2*time.Hour)), maxx)
+
+	ts.XValues = append(ts.XValues, now.Add(3*time.Hour))
+	ts.YValues = append(ts.YValues, 3)
+	_, maxx, _, maxy := ts.GetBounds()
+	assert.Equal(TimeToFloat64(now.Add(3*time.Hour)), maxx)
+	assert.Equal(3.0, maxy)
+}
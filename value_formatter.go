@@ -2,55 +2,139 @@ package chart
 
 import (
 	"fmt"
+	"math"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
 // ValueFormatter is a function that takes a value and produces a string.
 type ValueFormatter func(v interface{}) string
 
+var (
+	_registeredValueFormattersLock sync.RWMutex
+	_registeredValueFormatters     = map[string]ValueFormatter{
+		"time":            TimeValueFormatter,
+		"time_hour":       TimeHourValueFormatter,
+		"time_minute":     TimeMinuteValueFormatter,
+		"time_date":       TimeDateValueFormatter,
+		"int":             IntValueFormatter,
+		"grouped_int":     GroupedIntValueFormatter,
+		"float":           FloatValueFormatter,
+		"percent":         PercentValueFormatter,
+		"compact_percent": CompactPercentValueFormatter,
+		"si":              SIValueFormatter,
+		"bytes":           BytesValueFormatter,
+	}
+)
+
+// RegisterValueFormatter registers a value formatter under a name, so chart configurations can refer to it;
+// see `ChartFromJSON`. The formatters of this package are registered already, e.g. as "time" and "percent".
+// Registering a name again replaces the formatter.
+func RegisterValueFormatter(name string, vf ValueFormatter) error {
+	if len(name) == 0 {
+		return fmt.Errorf("value formatter; please provide a name")
+	}
+	if vf == nil {
+		return fmt.Errorf("value formatter %q; please provide a formatter", name)
+	}
+	_registeredValueFormattersLock.Lock()
+	_registeredValueFormatters[name] = vf
+	_registeredValueFormattersLock.Unlock()
+	return nil
+}
+
+// GetRegisteredValueFormatter returns the value formatter registered under a name, or nil if there isn't one.
+func GetRegisteredValueFormatter(name string) ValueFormatter {
+	_registeredValueFormattersLock.RLock()
+	defer _registeredValueFormattersLock.RUnlock()
+	return _registeredValueFormatters[name]
+}
+
+// getValueFormatterName returns the name a value formatter is registered under, if it is.
+// Formatters are compared by their code, so closures made by the same function, like `StepValueFormatter(1)`
+// and `StepValueFormatter(2)`, can't be told apart.
+func getValueFormatterName(vf ValueFormatter) (string, bool) {
+	pointer := reflect.ValueOf(vf).Pointer()
+	_registeredValueFormattersLock.RLock()
+	defer _registeredValueFormattersLock.RUnlock()
+	names := make([]string, 0, len(_registeredValueFormatters))
+	for name, registered := range _registeredValueFormatters {
+		if reflect.ValueOf(registered).Pointer() == pointer {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+	// a formatter registered twice gets the same name every time.
+	sort.Strings(names)
+	return names[0], true
+}
+
 // TimeValueFormatter is a ValueFormatter for timestamps.
+// Like the other time formatters it takes `time.Time` values, or nanoseconds since the epoch
+// as produced by `TimeToFloat64`, and formats numeric values in the local time zone.
 func TimeValueFormatter(v interface{}) string {
-	return formatTime(v, DefaultDateFormat)
+	return formatTime(v, DefaultDateFormat, nil)
 }
 
 // TimeHourValueFormatter is a ValueFormatter for timestamps.
 func TimeHourValueFormatter(v interface{}) string {
-	return formatTime(v, DefaultDateHourFormat)
+	return formatTime(v, DefaultDateHourFormat, nil)
 }
 
 // TimeMinuteValueFormatter is a ValueFormatter for timestamps.
 func TimeMinuteValueFormatter(v interface{}) string {
-	return formatTime(v, DefaultDateMinuteFormat)
+	return formatTime(v, DefaultDateMinuteFormat, nil)
 }
 
 // TimeDateValueFormatter is a ValueFormatter for timestamps.
 func TimeDateValueFormatter(v interface{}) string {
-	return formatTime(v, "2006-01-02")
+	return formatTime(v, "2006-01-02", nil)
 }
 
 // TimeValueFormatterWithFormat returns a time formatter with a given format.
 func TimeValueFormatterWithFormat(format string) ValueFormatter {
 	return func(v interface{}) string {
-		return formatTime(v, format)
+		return formatTime(v, format, nil)
 	}
 }
 
-// TimeValueFormatterWithFormat is a ValueFormatter for timestamps with a given format.
-func formatTime(v interface{}, dateFormat string) string {
-	if typed, isTyped := v.(time.Time); isTyped {
-		return typed.Format(dateFormat)
+// TimeValueFormatterWithFormatInLocation returns a time formatter with a given format
+// that formats every timestamp, including `time.Time` values, in a given location.
+// A nil location behaves like `TimeValueFormatterWithFormat`.
+func TimeValueFormatterWithFormatInLocation(format string, loc *time.Location) ValueFormatter {
+	return func(v interface{}) string {
+		return formatTime(v, format, loc)
 	}
-	if typed, isTyped := v.(int64); isTyped {
-		return time.Unix(0, typed).Format(dateFormat)
+}
+
+// formatTime formats a timestamp with a given format, in a given location if it is set.
+// Without a location `time.Time` values keep their own and numeric values use the local time zone.
+func formatTime(v interface{}, dateFormat string, loc *time.Location) string {
+	var t time.Time
+	switch typed := v.(type) {
+	case time.Time:
+		t = typed
+	case int64:
+		t = time.Unix(0, typed)
+	case float64:
+		t = TimeFromFloat64(typed)
+	default:
+		return ""
 	}
-	if typed, isTyped := v.(float64); isTyped {
-		return time.Unix(0, int64(typed)).Format(dateFormat)
+	if loc != nil {
+		t = t.In(loc)
 	}
-	return ""
+	return t.Format(dateFormat)
 }
 
 // IntValueFormatter is a ValueFormatter for float64.
+// It writes the digits alone, e.g. 1234567 is "1234567"; `GroupedIntValueFormatter` separates the thousands.
 func IntValueFormatter(v interface{}) string {
 	switch v.(type) {
 	case int:
@@ -66,12 +150,43 @@ func IntValueFormatter(v interface{}) string {
 	}
 }
 
+// GroupedIntValueFormatter is a ValueFormatter for whole numbers that separates thousands with commas,
+// e.g. 1234567 is "1,234,567". Like `IntValueFormatter` it truncates.
+func GroupedIntValueFormatter(v interface{}) string {
+	if value := IntValueFormatter(v); len(value) > 0 {
+		return groupThousands(value)
+	}
+	return ""
+}
+
 // FloatValueFormatter is a ValueFormatter for float64.
 func FloatValueFormatter(v interface{}) string {
 	return FloatValueFormatterWithFormat(v, DefaultFloatFormat)
 }
 
+// StepValueFormatter returns a ValueFormatter with as many decimals as values a given step apart need,
+// i.e. none for steps of 1 or more and one for every power of ten below that, up to `DefaultMaxStepPrecision`.
+func StepValueFormatter(step float64) ValueFormatter {
+	if step <= 0 || math.IsNaN(step) || math.IsInf(step, 0) {
+		return FloatValueFormatter
+	}
+	precision := MinInt(MaxInt(-int(math.Floor(math.Log10(step))), 0), DefaultMaxStepPrecision)
+	return FloatValueFormatterWithPrecision(precision)
+}
+
+// defaultValueFormatter is the value formatter of series that don't have one set.
+// It formats like FloatValueFormatter, but axes label their ticks with a StepValueFormatter instead.
+func defaultValueFormatter(v interface{}) string {
+	return FloatValueFormatter(v)
+}
+
+// isDefaultValueFormatter returns if a value formatter is unset or the series default.
+func isDefaultValueFormatter(vf ValueFormatter) bool {
+	return vf == nil || reflect.ValueOf(vf).Pointer() == reflect.ValueOf(defaultValueFormatter).Pointer()
+}
+
 // PercentValueFormatter is a formatter for percent values.
+// It keeps two decimals, e.g. 0.123 is "12.30%"; `CompactPercentValueFormatter` trims the zeros, "12.3%".
 // NOTE: it normalizes the values, i.e. multiplies by 100.0.
 func PercentValueFormatter(v interface{}) string {
 	if typed, isTyped := v.(float64); isTyped {
@@ -80,6 +195,106 @@ func PercentValueFormatter(v interface{}) string {
 	return ""
 }
 
+// CompactPercentValueFormatter is a formatter for percent values with up to two decimals, e.g. 0.123 is "12.3%"
+// where `PercentValueFormatter` gives "12.30%".
+// NOTE: it normalizes the values, i.e. multiplies by 100.0.
+func CompactPercentValueFormatter(v interface{}) string {
+	if typed, isTyped := v.(float64); isTyped {
+		return trimZeros(strconv.FormatFloat(typed*100.0, 'f', 2, 64)) + "%"
+	}
+	return ""
+}
+
+// FloatValueFormatterWithPrecision returns a ValueFormatter for numbers with a given number of decimals.
+func FloatValueFormatterWithPrecision(precision int) ValueFormatter {
+	floatFormat := fmt.Sprintf("%%.%df", MaxInt(precision, 0))
+	return func(v interface{}) string {
+		return FloatValueFormatterWithFormat(v, floatFormat)
+	}
+}
+
+// SIValueFormatter is a ValueFormatter that scales numbers by their SI prefix with up to one decimal,
+// e.g. 12400 is "12.4k" and 0.0025 is "2.5m".
+func SIValueFormatter(v interface{}) string {
+	return formatPrefixed(v, 1000, siPrefixes, siPrefixBase, "")
+}
+
+// BytesValueFormatter is a ValueFormatter for byte counts with binary prefixes and up to one decimal,
+// e.g. 1536 is "1.5 KiB".
+func BytesValueFormatter(v interface{}) string {
+	return formatPrefixed(v, 1024, bytePrefixes, 0, " ")
+}
+
+var (
+	siPrefixes   = []string{"p", "n", "µ", "m", "", "k", "M", "G", "T", "P", "E", "Z", "Y"}
+	siPrefixBase = 4
+	bytePrefixes = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB", "ZiB", "YiB"}
+)
+
+// formatPrefixed formats a number as a multiple of the largest power of base below it, followed by its prefix.
+// prefixes[unit] is the prefix of base^0; values beyond the prefixes use the first or last of them.
+func formatPrefixed(v interface{}, base float64, prefixes []string, unit int, separator string) string {
+	value, ok := toFloat64(v)
+	if !ok {
+		return ""
+	}
+	if value == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.FormatFloat(value, 'f', -1, 64) + separator + prefixes[unit]
+	}
+
+	index := unit + int(math.Floor(math.Log(math.Abs(value))/math.Log(base)))
+	index = MinInt(MaxInt(index, 0), len(prefixes)-1)
+	scaled := math.Round(value/math.Pow(base, float64(index-unit))*10) / 10
+	// rounding can carry into the next prefix, e.g. 999.95 is "1k" and not "1000k".
+	if math.Abs(scaled) >= base && index < len(prefixes)-1 {
+		index++
+		scaled = math.Round(value/math.Pow(base, float64(index-unit))*10) / 10
+	}
+	if scaled == 0 {
+		return "0" + separator + prefixes[unit]
+	}
+	return strconv.FormatFloat(scaled, 'f', -1, 64) + separator + prefixes[index]
+}
+
+// toFloat64 returns a numeric value as a float64.
+func toFloat64(v interface{}) (float64, bool) {
+	switch typed := v.(type) {
+	case int:
+		return float64(typed), true
+	case int64:
+		return float64(typed), true
+	case float32:
+		return float64(typed), true
+	case float64:
+		return typed, true
+	default:
+		return 0, false
+	}
+}
+
+// trimZeros removes trailing zeros after the decimal point of a formatted number.
+func trimZeros(value string) string {
+	if strings.Contains(value, ".") {
+		value = strings.TrimRight(strings.TrimRight(value, "0"), ".")
+	}
+	if value == "-0" {
+		return "0"
+	}
+	return value
+}
+
+// groupThousands separates the thousands of a formatted integer with commas.
+func groupThousands(value string) string {
+	var sign string
+	if strings.HasPrefix(value, "-") {
+		sign, value = "-", value[1:]
+	}
+	for index := len(value) - 3; index > 0; index -= 3 {
+		value = value[:index] + "," + value[index:]
+	}
+	return sign + value
+}
+
 // FloatValueFormatterWithFormat is a ValueFormatter for float64 with a given format.
 func FloatValueFormatterWithFormat(v interface{}, floatFormat string) string {
 	if typed, isTyped := v.(int); isTyped {
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -14,9 +15,9 @@ func TestTimeValueFormatterWithFormat(t *testing.T) {
 	di := TimeToFloat64(d)
 	df := float64(di)
 
-	s := formatTime(d, DefaultDateFormat)
-	si := formatTime(di, DefaultDateFormat)
-	sf := formatTime(df, DefaultDateFormat)
+	s := formatTime(d, DefaultDateFormat, nil)
+	si := formatTime(di, DefaultDateFormat, nil)
+	sf := formatTime(df, DefaultDateFormat, nil)
 	assert.Equal(s, si)
 	assert.Equal(s, sf)
 
@@ -28,6 +29,26 @@ func TestTimeValueFormatterWithFormat(t *testing.T) {
 	assert.Equal(s, sdf)
 }
 
+func TestTimeValueFormatterWithFormatInLocation(t *testing.T) {
+	assert := assert.New(t)
+
+	d := time.Date(2024, 3, 10, 23, 30, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	vf := TimeValueFormatterWithFormatInLocation("2006-01-02 15:04", tokyo)
+	assert.Equal("2024-03-11 08:30", vf(d))
+	assert.Equal("2024-03-11 08:30", vf(TimeToFloat64(d)))
+	assert.Equal("2024-03-11 08:30", vf(d.UnixNano()))
+	assert.Empty(vf("not a time"))
+
+	utc := TimeValueFormatterWithFormatInLocation(DefaultDateMinuteFormat, time.UTC)
+	assert.Equal("03-10 11:30PM", utc(TimeToFloat64(d)))
+
+	// without a location times keep their own.
+	assert.Equal("2024-03-10 23:30", TimeValueFormatterWithFormatInLocation("2006-01-02 15:04", nil)(d))
+	assert.Equal("2024-03-10 23:30", TimeValueFormatterWithFormat("2006-01-02 15:04")(d))
+}
+
 func TestFloatValueFormatter(t *testing.T) {
 	assert := assert.New(t)
 	assert.Equal("1234.00", FloatValueFormatter(1234.00))
@@ -56,3 +77,109 @@ func TestFloatValueFormatterWithFormat(t *testing.T) {
 	assert.Equal("123.456", sv)
 	assert.Equal("123.000", FloatValueFormatterWithFormat(123, "%.3f"))
 }
+
+func TestGroupedIntValueFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		Input    interface{}
+		Expected string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1,000"},
+		{int64(-1234567), "-1,234,567"},
+		{12.7, "12"},
+		{float32(-999.9), "-999"},
+		{1e15, "1,000,000,000,000,000"},
+		{"12", ""},
+	}
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, GroupedIntValueFormatter(tc.Input), tc.Input)
+		// the plain formatter is the same without the commas.
+		assert.Equal(strings.Replace(tc.Expected, ",", "", -1), IntValueFormatter(tc.Input), tc.Input)
+	}
+}
+
+func TestCompactPercentValueFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		Input    interface{}
+		Expected string
+	}{
+		{0.0, "0%"},
+		{0.123, "12.3%"},
+		{0.12345, "12.35%"},
+		{-0.5, "-50%"},
+		{-0.00001, "0%"},
+		{12.5, "1250%"},
+		{1, ""},
+	}
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, CompactPercentValueFormatter(tc.Input), tc.Input)
+	}
+	assert.Equal("12.30%", PercentValueFormatter(0.123))
+}
+
+func TestFloatValueFormatterWithPrecision(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("1000.0", FloatValueFormatterWithPrecision(1)(999.95))
+	assert.Equal("999.9", FloatValueFormatterWithPrecision(1)(999.94))
+	assert.Equal("-0.125", FloatValueFormatterWithPrecision(3)(-0.125))
+	assert.Equal("2", FloatValueFormatterWithPrecision(0)(2.4))
+	assert.Equal("2", FloatValueFormatterWithPrecision(-1)(2.4))
+	assert.Equal("12.00", FloatValueFormatterWithPrecision(2)(12))
+}
+
+func TestSIValueFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		Input    interface{}
+		Expected string
+	}{
+		{0, "0"},
+		{5, "5"},
+		{12400.0, "12.4k"},
+		{-12400.0, "-12.4k"},
+		{999.94, "999.9"},
+		{999.95, "1k"},
+		{999949.0, "999.9k"},
+		{999950.0, "1M"},
+		{1000000.0, "1M"},
+		{1500000.0, "1.5M"},
+		{0.0025, "2.5m"},
+		{-0.5, "-500m"},
+		{1e30, "1000000Y"},
+		{1e-20, "0"},
+		{"12", ""},
+	}
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, SIValueFormatter(tc.Input), tc.Input)
+	}
+}
+
+func TestBytesValueFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		Input    interface{}
+		Expected string
+	}{
+		{0, "0 B"},
+		{500, "500 B"},
+		{1023, "1023 B"},
+		{1024, "1 KiB"},
+		{1536, "1.5 KiB"},
+		{-1536, "-1.5 KiB"},
+		{int64(1048575), "1 MiB"},
+		{float64(1 << 40), "1 TiB"},
+		{0.5, "0.5 B"},
+		{"12", ""},
+	}
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, BytesValueFormatter(tc.Input), tc.Input)
+	}
+}
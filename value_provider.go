@@ -14,6 +14,25 @@ type BoundedValuesProvider interface {
 	GetBoundedValues(index int) (x, y1, y2 float64)
 }
 
+// OHLCValuesProvider is a type that produces open, high, low and close values, for example daily prices.
+type OHLCValuesProvider interface {
+	Len() int
+	GetOHLCValues(index int) (x, open, high, low, close float64)
+}
+
+// BoxPlotValuesProvider is a type that produces the five number summary of a distribution per x value.
+type BoxPlotValuesProvider interface {
+	Len() int
+	GetBoxPlotValues(index int) (x, min, lowerQuartile, median, upperQuartile, max float64)
+}
+
+// BoundsProvider is a type that can return the bounds of its values without them being scanned
+// one at a time, e.g. because they are precomputed or expensive to fetch.
+// Non-finite values are left out of the bounds; a provider without finite values returns NaNs.
+type BoundsProvider interface {
+	GetBounds() (minx, maxx, miny, maxy float64)
+}
+
 // FirstValuesProvider is a special type of value provider that can return it's (potentially computed) first value.
 type FirstValuesProvider interface {
 	GetFirstValues() (x, y float64)
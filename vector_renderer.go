@@ -2,17 +2,35 @@ package chart
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
+	"html"
+	"image"
+	"image/png"
 	"io"
 	"math"
+	"strconv"
 	"strings"
 
 	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
 
 	"github.com/golang/freetype/truetype"
 	"github.com/wcharczuk/go-chart/drawing"
 )
 
+// Interface Assertions.
+var (
+	_ FloatPathRenderer   = (*vectorRenderer)(nil)
+	_ FloatCircleRenderer = (*vectorRenderer)(nil)
+	_ ImageRenderer       = (*vectorRenderer)(nil)
+	_ GroupRenderer       = (*vectorRenderer)(nil)
+	_ TooltipRenderer     = (*vectorRenderer)(nil)
+	_ ClipRenderer        = (*vectorRenderer)(nil)
+
+	_ scopedGroupRenderer = (*vectorRenderer)(nil)
+)
+
 // SVG returns a new png/raster renderer.
 func SVG(width, height int) (Renderer, error) {
 	buffer := bytes.NewBuffer([]byte{})
@@ -46,6 +64,25 @@ func SVGWithCSS(css string, nonce string) func(width, height int) (Renderer, err
 	}
 }
 
+// SVGWithClassPrefix returns a new svg renderer that groups the parts of a chart, like its canvas, axes and
+// series, so they can be styled and animated on a page; see `GroupRenderer`. The groups have an id and classes,
+// e.g. `<g id="sales-series-0" class="sales-series sales-series-0">` with the prefix "sales-", so charts
+// with different prefixes can share a page. The prefix can be empty. The last value annotations are in a
+// `final-label` group within the `annotations`. Charts drawn into one image, like the cells of a `Grid`,
+// have ids of their own, e.g. "sales-chart-1-series-0", and the same classes.
+func SVGWithClassPrefix(prefix string) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		r, err := SVG(width, height)
+		if err != nil {
+			return nil, err
+		}
+		vr := r.(*vectorRenderer)
+		vr.c.groups = true
+		vr.c.classPrefix = prefix
+		return vr, nil
+	}
+}
+
 // vectorRenderer renders chart commands to a bitmap.
 type vectorRenderer struct {
 	dpi float64
@@ -72,6 +109,38 @@ func (vr *vectorRenderer) SetDPI(dpi float64) {
 	vr.c.dpi = dpi
 }
 
+// BeginGroup implements the interface method. Groups are only written by renderers from `SVGWithClassPrefix`.
+func (vr *vectorRenderer) BeginGroup(name string, classes ...string) {
+	vr.c.BeginGroup("", name, classes)
+}
+
+// beginScopedGroup implements the interface method.
+func (vr *vectorRenderer) beginScopedGroup(scope, name string, classes ...string) {
+	vr.c.BeginGroup(scope, name, classes)
+}
+
+// EndGroup implements the interface method.
+func (vr *vectorRenderer) EndGroup() {
+	vr.c.EndGroup()
+}
+
+// SetTooltip implements the interface method.
+// Shapes with a tooltip are written in a group with a `<title>`, which browsers show on hover.
+func (vr *vectorRenderer) SetTooltip(text string) {
+	vr.c.tooltip = text
+}
+
+// BeginClip implements the interface method.
+// What's drawn next is written in a group with a `clip-path` of the box.
+func (vr *vectorRenderer) BeginClip(box Box) {
+	vr.c.BeginClip(box)
+}
+
+// EndClip implements the interface method.
+func (vr *vectorRenderer) EndClip() {
+	vr.c.EndClip()
+}
+
 // SetClassName implements the interface method.
 func (vr *vectorRenderer) SetClassName(classname string) {
 	vr.s.ClassName = classname
@@ -107,6 +176,16 @@ func (vr *vectorRenderer) LineTo(x, y int) {
 	vr.p = append(vr.p, fmt.Sprintf("L %d %d", x, y))
 }
 
+// MoveToFloat implements the interface method.
+func (vr *vectorRenderer) MoveToFloat(x, y float64) {
+	vr.p = append(vr.p, fmt.Sprintf("M %s %s", formatCoordinate(x), formatCoordinate(y)))
+}
+
+// LineToFloat implements the interface method.
+func (vr *vectorRenderer) LineToFloat(x, y float64) {
+	vr.p = append(vr.p, fmt.Sprintf("L %s %s", formatCoordinate(x), formatCoordinate(y)))
+}
+
 // QuadCurveTo draws a quad curve.
 func (vr *vectorRenderer) QuadCurveTo(cx, cy, x, y int) {
 	vr.p = append(vr.p, fmt.Sprintf("Q%d,%d %d,%d", cx, cy, x, y))
@@ -125,17 +204,31 @@ func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 		vr.p = append(vr.p, fmt.Sprintf("M %d %d", startx, starty))
 	}
 
+	// svg draws nothing for an arc that ends where it starts, so split full turns in two.
+	if math.Abs(delta) >= _2pi {
+		delta = delta / 2.0
+		vr.arc(cx, cy, rx, ry, RadianAdd(startAngle, delta), delta)
+	}
+	vr.arc(cx, cy, rx, ry, endAngle, delta)
+}
+
+// arc appends an elliptical arc command ending at the given angle.
+func (vr *vectorRenderer) arc(cx, cy int, rx, ry, endAngle, delta float64) {
 	endx := cx + int(rx*math.Sin(endAngle))
 	endy := cy - int(ry*math.Cos(endAngle))
 
 	dd := RadiansToDegrees(delta)
 
 	largeArcFlag := 0
-	if delta > _pi {
+	if math.Abs(delta) > _pi {
 		largeArcFlag = 1
 	}
+	sweepFlag := 1
+	if delta < 0 {
+		sweepFlag = 0
+	}
 
-	vr.p = append(vr.p, fmt.Sprintf("A %d %d %0.2f %d 1 %d %d", int(rx), int(ry), dd, largeArcFlag, endx, endy))
+	vr.p = append(vr.p, fmt.Sprintf("A %d %d %0.2f %d %d %d %d", int(rx), int(ry), dd, largeArcFlag, sweepFlag, endx, endy))
 }
 
 // Close closes a shape.
@@ -160,13 +253,22 @@ func (vr *vectorRenderer) FillStroke() {
 
 // drawPath draws a path.
 func (vr *vectorRenderer) drawPath(s Style) {
+	// shapes like circles are written directly, so there may be no path to draw.
+	if len(vr.p) == 0 {
+		return
+	}
 	vr.c.Path(strings.Join(vr.p, "\n"), vr.s.GetFillAndStrokeOptions())
 	vr.p = []string{} // clear the path
 }
 
 // Circle implements the interface method.
 func (vr *vectorRenderer) Circle(radius float64, x, y int) {
-	vr.c.Circle(x, y, int(radius), vr.s.GetFillAndStrokeOptions())
+	vr.CircleFloat(radius, float64(x), float64(y))
+}
+
+// CircleFloat implements the interface method.
+func (vr *vectorRenderer) CircleFloat(radius, x, y float64) {
+	vr.c.Circle(x, y, radius, vr.s.GetFillAndStrokeOptions())
 }
 
 // SetFont implements the interface method.
@@ -192,16 +294,15 @@ func (vr *vectorRenderer) Text(body string, x, y int) {
 // MeasureText uses the truetype font drawer to measure the width of text.
 func (vr *vectorRenderer) MeasureText(body string) (box Box) {
 	if vr.s.GetFont() != nil {
-		vr.fc = &font.Drawer{
-			Face: truetype.NewFace(vr.s.GetFont(), &truetype.Options{
-				DPI:  vr.dpi,
-				Size: vr.s.FontSize,
-			}),
-		}
-		w := vr.fc.MeasureString(body).Ceil()
-
-		box.Right = w
-		box.Bottom = int(drawing.PointsToPixels(vr.dpi, vr.s.FontSize))
+		box = _textMeasureCache.measure(textMeasureKey{
+			vector: true,
+			font:   vr.s.GetFont(),
+			size:   vr.s.FontSize,
+			dpi:    vr.dpi,
+			text:   body,
+		}, func() Box {
+			return vr.measureText(body)
+		})
 		if vr.c.textTheta == nil {
 			return
 		}
@@ -210,6 +311,55 @@ func (vr *vectorRenderer) MeasureText(body string) (box Box) {
 	return
 }
 
+// measureText measures the unrotated bounds of a string with the current font.
+func (vr *vectorRenderer) measureText(body string) (box Box) {
+	vr.fc = &font.Drawer{
+		Face: truetype.NewFace(vr.s.GetFont(), &truetype.Options{
+			DPI:  vr.dpi,
+			Size: vr.s.FontSize,
+		}),
+	}
+	if fallbacks := getFallbackFonts(); len(fallbacks) > 0 {
+		box.Right = measureStringWithFallbacks(append([]*truetype.Font{vr.s.GetFont()}, fallbacks...), vr.dpi, vr.s.FontSize, body).Ceil()
+	} else {
+		box.Right = vr.fc.MeasureString(body).Ceil()
+	}
+	box.Bottom = int(drawing.PointsToPixels(vr.dpi, vr.s.FontSize))
+	return
+}
+
+// measureStringWithFallbacks measures the advance of a string, taking each character from the font or, if the font
+// is missing it, from the first fallback font that has it; the way the raster renderer draws it.
+func measureStringWithFallbacks(fonts []*truetype.Font, dpi, size float64, body string) (advance fixed.Int26_6) {
+	faces := make([]font.Face, len(fonts))
+	faceFor := func(r rune) font.Face {
+		index := 0
+		for fontIndex, f := range fonts {
+			if f.Index(r) != 0 {
+				index = fontIndex
+				break
+			}
+		}
+		if faces[index] == nil {
+			faces[index] = truetype.NewFace(fonts[index], &truetype.Options{DPI: dpi, Size: size})
+		}
+		return faces[index]
+	}
+
+	var prevFace font.Face
+	prev := rune(-1)
+	for _, r := range body {
+		face := faceFor(r)
+		if face == prevFace {
+			advance += face.Kern(prev, r)
+		}
+		glyphAdvance, _ := face.GlyphAdvance(r)
+		advance += glyphAdvance
+		prevFace, prev = face, r
+	}
+	return
+}
+
 // SetTextRotation sets the text rotation.
 func (vr *vectorRenderer) SetTextRotation(radians float64) {
 	vr.c.textTheta = &radians
@@ -220,6 +370,20 @@ func (vr *vectorRenderer) ClearTextRotation() {
 	vr.c.textTheta = nil
 }
 
+// DrawImage implements the interface method.
+// The image is embedded as a png; images that can't be encoded, e.g. empty ones, are skipped.
+func (vr *vectorRenderer) DrawImage(img image.Image, box Box) {
+	buffer := bytes.NewBuffer(nil)
+	if err := png.Encode(buffer, img); err != nil {
+		return
+	}
+	vr.c.Image(box, "data:image/png;base64,"+base64.StdEncoding.EncodeToString(buffer.Bytes()))
+}
+
+func (vr *vectorRenderer) contentType() string {
+	return ContentTypeSVG
+}
+
 // Save saves the renderer's contents to a writer.
 func (vr *vectorRenderer) Save(w io.Writer) error {
 	vr.c.End()
@@ -242,12 +406,20 @@ type canvas struct {
 	height    int
 	css       string
 	nonce     string
+
+	// groups is set if the parts of a chart are grouped, with ids and classes starting with the class prefix.
+	groups      bool
+	classPrefix string
+	// tooltip is the title of the shapes written next.
+	tooltip string
+	// clips is the number of clip paths written, which numbers their ids.
+	clips int
 }
 
 func (c *canvas) Start(width, height int) {
 	c.width = width
 	c.height = height
-	c.w.Write([]byte(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%d" height="%d">\n`, c.width, c.height)))
+	c.w.Write([]byte(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%d" height="%d">`+"\n", c.width, c.height)))
 	if c.css != "" {
 		c.w.Write([]byte(`<style type="text/css"`))
 		if c.nonce != "" {
@@ -264,10 +436,11 @@ func (c *canvas) Path(d string, style Style) {
 	if len(style.StrokeDashArray) > 0 {
 		strokeDashArrayProperty = c.getStrokeDashArray(style)
 	}
-	c.w.Write([]byte(fmt.Sprintf(`<path %s d="%s" %s/>`, strokeDashArrayProperty, d, c.styleAsSVG(style))))
+	c.writeShape(fmt.Sprintf(`<path %s d="%s" %s/>`, strokeDashArrayProperty, d, c.styleAsSVG(style)))
 }
 
 func (c *canvas) Text(x, y int, body string, style Style) {
+	body = html.EscapeString(body)
 	if c.textTheta == nil {
 		c.w.Write([]byte(fmt.Sprintf(`<text x="%d" y="%d" %s>%s</text>`, x, y, c.styleAsSVG(style), body)))
 	} else {
@@ -276,8 +449,52 @@ func (c *canvas) Text(x, y int, body string, style Style) {
 	}
 }
 
-func (c *canvas) Circle(x, y, r int, style Style) {
-	c.w.Write([]byte(fmt.Sprintf(`<circle cx="%d" cy="%d" r="%d" %s/>`, x, y, r, c.styleAsSVG(style))))
+func (c *canvas) Circle(x, y, r float64, style Style) {
+	c.writeShape(fmt.Sprintf(`<circle cx="%s" cy="%s" r="%0.2f" %s/>`, formatCoordinate(x), formatCoordinate(y), r, c.styleAsSVG(style)))
+}
+
+// writeShape writes a shape, in a group with a title if there's a tooltip.
+func (c *canvas) writeShape(shape string) {
+	if c.tooltip == "" {
+		c.w.Write([]byte(shape))
+		return
+	}
+	c.w.Write([]byte(fmt.Sprintf(`<g><title>%s</title>%s</g>`, html.EscapeString(c.tooltip), shape)))
+}
+
+func (c *canvas) Image(box Box, href string) {
+	c.w.Write([]byte(fmt.Sprintf(`<image x="%d" y="%d" width="%d" height="%d" preserveAspectRatio="none" xlink:href="%s"/>`, box.Left, box.Top, box.Width(), box.Height(), href)))
+}
+
+// BeginGroup writes the start of a group; its id is the name within the scope, and its classes are
+// the classes and the name, so the parts of charts in different scopes share their classes.
+func (c *canvas) BeginGroup(scope, name string, classes []string) {
+	if !c.groups {
+		return
+	}
+	var names []string
+	for _, class := range append(classes, name) {
+		names = append(names, html.EscapeString(c.classPrefix+class))
+	}
+	c.w.Write([]byte(fmt.Sprintf(`<g id="%s" class="%s">`, html.EscapeString(c.classPrefix+scope+name), strings.Join(names, " "))))
+}
+
+func (c *canvas) EndGroup() {
+	if !c.groups {
+		return
+	}
+	c.w.Write([]byte("</g>"))
+}
+
+func (c *canvas) BeginClip(box Box) {
+	c.clips++
+	id := html.EscapeString(fmt.Sprintf("%sclip-%d", c.classPrefix, c.clips))
+	c.w.Write([]byte(fmt.Sprintf(`<clipPath id="%s"><rect x="%d" y="%d" width="%d" height="%d"/></clipPath>`, id, box.Left, box.Top, box.Width(), box.Height())))
+	c.w.Write([]byte(fmt.Sprintf(`<g clip-path="url(#%s)">`, id)))
+}
+
+func (c *canvas) EndClip() {
+	c.w.Write([]byte("</g>"))
 }
 
 func (c *canvas) End() {
@@ -298,14 +515,17 @@ func (c *canvas) getStrokeDashArray(s Style) string {
 
 // GetFontFace returns the font face for the style.
 func (c *canvas) getFontFace(s Style) string {
-	family := "sans-serif"
-	if s.GetFont() != nil {
-		name := s.GetFont().Name(truetype.NameIDFontFamily)
-		if len(name) != 0 {
-			family = fmt.Sprintf(`'%s',%s`, name, family)
+	var families []string
+	for _, f := range append([]*truetype.Font{s.GetFont()}, getFallbackFonts()...) {
+		if f == nil {
+			continue
+		}
+		if name := f.Name(truetype.NameIDFontFamily); len(name) != 0 {
+			families = append(families, fmt.Sprintf(`'%s'`, name))
 		}
 	}
-	return fmt.Sprintf("font-family:%s", family)
+	families = append(families, "sans-serif")
+	return fmt.Sprintf("font-family:%s", strings.Join(families, ","))
 }
 
 // styleAsSVG returns the style as a svg style or class string.
@@ -319,10 +539,10 @@ func (c *canvas) styleAsSVG(s Style) string {
 	if s.ClassName != "" {
 		var classes []string
 		classes = append(classes, s.ClassName)
-		if !sc.IsZero() {
+		if !sc.IsTransparent() {
 			classes = append(classes, "stroke")
 		}
-		if !fc.IsZero() {
+		if !fc.IsTransparent() {
 			classes = append(classes, "fill")
 		}
 		if fs != 0 || s.Font != nil {
@@ -340,18 +560,11 @@ func (c *canvas) styleAsSVG(s Style) string {
 		pieces = append(pieces, "stroke-width:0")
 	}
 
-	if !sc.IsZero() {
-		pieces = append(pieces, "stroke:"+sc.String())
+	pieces = append(pieces, svgColor("stroke", sc)...)
+	if !fnc.IsTransparent() {
+		pieces = append(pieces, svgColor("fill", fnc)...)
 	} else {
-		pieces = append(pieces, "stroke:none")
-	}
-
-	if !fnc.IsZero() {
-		pieces = append(pieces, "fill:"+fnc.String())
-	} else if !fc.IsZero() {
-		pieces = append(pieces, "fill:"+fc.String())
-	} else {
-		pieces = append(pieces, "fill:none")
+		pieces = append(pieces, svgColor("fill", fc)...)
 	}
 
 	if fs != 0 {
@@ -363,3 +576,26 @@ func (c *canvas) styleAsSVG(s Style) string {
 	}
 	return fmt.Sprintf("style=\"%s\"", strings.Join(pieces, ";"))
 }
+
+// svgColor returns the svg style properties for a color, with the alpha as a separate opacity.
+// Fully transparent colors are none.
+func svgColor(property string, c drawing.Color) []string {
+	if c.IsTransparent() {
+		return []string{property + ":none"}
+	}
+	pieces := []string{property + ":" + c.Hex()}
+	if c.A < 255 {
+		pieces = append(pieces, fmt.Sprintf("%s-opacity:%.3g", property, float64(c.A)/255.0))
+	}
+	return pieces
+}
+
+// formatCoordinate formats a path coordinate to a hundredth of a pixel, without trailing zeros.
+func formatCoordinate(value float64) string {
+	rounded := math.Round(value*100) / 100
+	if rounded == 0 {
+		// negative zero would print as "-0".
+		rounded = 0
+	}
+	return strconv.FormatFloat(rounded, 'f', -1, 64)
+}
@@ -2,7 +2,9 @@ package chart
 
 import (
 	"bytes"
+	"encoding/xml"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 
@@ -35,6 +37,43 @@ func TestVectorRendererPath(t *testing.T) {
 	assert.True(strings.HasSuffix(raw, "</svg>"))
 }
 
+func TestVectorRendererFloatPath(t *testing.T) {
+	assert := assert.New(t)
+
+	vr, err := SVG(100, 100)
+	assert.Nil(err)
+
+	typed, isTyped := vr.(FloatPathRenderer)
+	assert.True(isTyped)
+
+	typed.MoveToFloat(0, 10)
+	typed.LineToFloat(12.5, 3.333333)
+	typed.LineToFloat(20, -0.001)
+	vr.Stroke()
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(vr.Save(buffer))
+	assert.Contains(buffer.String(), "M 0 10\nL 12.5 3.33\nL 20 0\"")
+}
+
+func TestVectorRendererFloatCircle(t *testing.T) {
+	assert := assert.New(t)
+
+	vr, err := SVG(100, 100)
+	assert.Nil(err)
+
+	typed, isTyped := vr.(FloatCircleRenderer)
+	assert.True(isTyped)
+
+	typed.CircleFloat(2, 12.5, 3.333333)
+	vr.Circle(2, 20, 30)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(vr.Save(buffer))
+	assert.Contains(buffer.String(), `<circle cx="12.5" cy="3.33" r="2.00"`)
+	assert.Contains(buffer.String(), `<circle cx="20" cy="30" r="2.00"`)
+}
+
 func TestVectorRendererMeasureText(t *testing.T) {
 	assert := assert.New(t)
 
@@ -73,12 +112,35 @@ func TestCanvasStyleSVG(t *testing.T) {
 	svgString := canvas.styleAsSVG(set)
 	assert.NotEmpty(svgString)
 	assert.True(strings.HasPrefix(svgString, "style=\""))
-	assert.True(strings.Contains(svgString, "stroke:rgba(255,255,255,1.0)"))
+	assert.True(strings.Contains(svgString, "stroke:#ffffff"))
 	assert.True(strings.Contains(svgString, "stroke-width:5"))
-	assert.True(strings.Contains(svgString, "fill:rgba(255,255,255,1.0)"))
+	assert.True(strings.Contains(svgString, "fill:#ffffff"))
+	assert.False(strings.Contains(svgString, "opacity"))
 	assert.True(strings.HasSuffix(svgString, "\""))
 }
 
+func TestCanvasStyleSVGAlpha(t *testing.T) {
+	assert := assert.New(t)
+
+	canvas := &canvas{dpi: DefaultDPI}
+
+	svgString := canvas.styleAsSVG(Style{
+		StrokeColor: drawing.ColorFromHex("2e86de").WithAlpha(128),
+		StrokeWidth: 1.0,
+		FillColor:   drawing.ColorFromHex("2e86de").WithAlpha(64),
+	})
+	assert.True(strings.Contains(svgString, "stroke:#2e86de;stroke-opacity:0.502"))
+	assert.True(strings.Contains(svgString, "fill:#2e86de;fill-opacity:0.251"))
+
+	// fully transparent colors aren't drawn at all.
+	svgString = canvas.styleAsSVG(Style{
+		StrokeColor: ColorTransparent,
+		FillColor:   drawing.ColorFromHex("2e86de").WithAlpha(0),
+	})
+	assert.True(strings.Contains(svgString, "stroke:none"))
+	assert.True(strings.Contains(svgString, "fill:none"))
+}
+
 func TestCanvasClassSVG(t *testing.T) {
 	as := assert.New(t)
 
@@ -116,4 +178,175 @@ func TestCanvasCustomInlineStylesheetWithNonce(t *testing.T) {
 	canvas.Start(200, 200)
 
 	assert.New(t).Contains(b.String(), fmt.Sprintf(`<style type="text/css" nonce="%s"><![CDATA[%s]]></style>`, canvas.nonce, canvas.css))
-}
\ No newline at end of file
+}
+func TestVectorRendererChartIsWellFormed(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Title: "Requests & <Errors>",
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "requests",
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+			},
+			ContinuousSeries{
+				Name:    "errors",
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{5.0, 4.0, 3.0, 2.0, 1.0},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(SVG, buffer))
+
+	elements := map[string]int{}
+	var titles []string
+	decoder := xml.NewDecoder(buffer)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(err)
+		switch typed := token.(type) {
+		case xml.StartElement:
+			elements[typed.Name.Local]++
+		case xml.CharData:
+			if strings.Contains(string(typed), "Requests") {
+				titles = append(titles, string(typed))
+			}
+		}
+	}
+
+	assert.Equal(1, elements["svg"])
+	assert.True(elements["path"] >= 2)
+	assert.NotZero(elements["text"])
+	assert.Equal([]string{"Requests & <Errors>"}, titles)
+}
+
+func TestVectorRendererDots(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		XAxis:          HideXAxis(),
+		YAxis:          HideYAxis(),
+		YAxisSecondary: HideYAxis(),
+		Series: []Series{
+			ContinuousSeries{
+				Style: Style{
+					StrokeWidth: 1,
+					DotWidth:    2.5,
+				},
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{1.0, 3.0, 2.0, 5.0, 4.0},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(SVG, buffer))
+
+	raw := buffer.String()
+	assert.Equal(5, strings.Count(raw, "<circle"))
+	assert.Equal(5, strings.Count(raw, `r="2.50"`))
+	assert.False(strings.Contains(raw, `d=""`))
+}
+
+func TestVectorRendererArcTo(t *testing.T) {
+	assert := assert.New(t)
+
+	vr, err := SVG(100, 100)
+	assert.Nil(err)
+	typed := vr.(*vectorRenderer)
+
+	typed.ArcTo(50, 50, 10, 10, 0, _pi2)
+	assert.Len(typed.p, 2)
+	assert.True(strings.HasSuffix(typed.p[1], " 0 1 50 60"))
+
+	typed.p = nil
+	typed.ArcTo(50, 50, 10, 10, _pi2, -_pi2)
+	assert.True(strings.HasSuffix(typed.p[1], " 0 0 60 50"))
+
+	// a full turn is split in two so it does not collapse to nothing.
+	typed.p = nil
+	typed.ArcTo(50, 50, 10, 10, 0, _2pi)
+	assert.Len(typed.p, 3)
+	assert.True(strings.HasSuffix(typed.p[1], " 40 50"))
+	assert.True(strings.HasSuffix(typed.p[2], " 60 50"))
+}
+
+func TestVectorRendererClassPrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Title:          "Sales",
+		YAxisSecondary: YAxis{Style: Shown()},
+		Series: []Series{
+			ContinuousSeries{XValues: LinearRange(0, 10), YValues: LinearRange(0, 10)},
+			ContinuousSeries{YAxis: YAxisSecondary, XValues: LinearRange(0, 10), YValues: LinearRange(10, 0)},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(SVGWithClassPrefix("sales-"), buffer))
+	svg := buffer.String()
+	for _, group := range []string{
+		`<g id="sales-chart-background" class="sales-chart-background">`,
+		`<g id="sales-chart-canvas" class="sales-chart-canvas">`,
+		`<g id="sales-x-axis" class="sales-axis sales-x-axis">`,
+		`<g id="sales-y-axis" class="sales-axis sales-y-axis">`,
+		`<g id="sales-y-axis-secondary" class="sales-axis sales-y-axis-secondary">`,
+		`<g id="sales-series-0" class="sales-series sales-series-0">`,
+		`<g id="sales-series-1" class="sales-series sales-series-1">`,
+		`<g id="sales-title" class="sales-title">`,
+	} {
+		assert.Equal(1, strings.Count(svg, group), group)
+	}
+	assert.Equal(strings.Count(svg, "<g "), strings.Count(svg, "</g>"))
+	assert.NotContains(svg, "sales-frame")
+
+	// the series are drawn within their groups.
+	series := svg[strings.Index(svg, `<g id="sales-series-0"`):]
+	assert.True(strings.HasPrefix(series[strings.Index(series, ">")+1:], "<path"))
+
+	// the last value of a series is a final label.
+	graph.Series = append(graph.Series, LastValueAnnotationSeries(graph.Series[0].(ValuesProvider)))
+	buffer.Reset()
+	assert.Nil(graph.Render(SVGWithClassPrefix("sales-"), buffer))
+	assert.Contains(buffer.String(), `<g id="sales-annotations" class="sales-annotations"><g id="sales-final-labels" class="sales-final-label sales-final-labels">`)
+
+	// plain svgs aren't grouped.
+	buffer.Reset()
+	assert.Nil(graph.Render(SVG, buffer))
+	assert.NotContains(buffer.String(), "<g ")
+}
+
+func TestVectorRendererTooltips(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				Style:           Style{DotWidth: 3, ShowTooltips: true},
+				XValues:         []float64{1, 2, 3},
+				YValues:         []float64{10, 20, 30},
+				YValueFormatter: func(v interface{}) string { return fmt.Sprintf("%v <units>", v) },
+			},
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{5, 15, 25}},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(graph.Render(SVG, buffer))
+	svg := buffer.String()
+	assert.Equal(3, strings.Count(svg, "<title>"))
+	assert.Contains(svg, "<g><title>x: 2.00, y: 20 &lt;units&gt;</title><circle ")
+	// only the dots have tooltips.
+	assert.NotContains(svg, "</title><path")
+
+	// raster renderers ignore them.
+	_, err := graph.RenderImage(PNG)
+	assert.Nil(err)
+}
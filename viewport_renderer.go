@@ -0,0 +1,153 @@
+package chart
+
+import (
+	"image"
+	"io"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Renderer            = (*viewportRenderer)(nil)
+	_ FloatPathRenderer   = (*viewportRenderer)(nil)
+	_ FloatCircleRenderer = (*viewportRenderer)(nil)
+	_ ImageRenderer       = (*viewportRenderer)(nil)
+	_ GroupRenderer       = (*viewportRenderer)(nil)
+	_ TooltipRenderer     = (*viewportRenderer)(nil)
+	_ ClipRenderer        = (*viewportRenderer)(nil)
+
+	_ scopedGroupRenderer = (*viewportRenderer)(nil)
+)
+
+// viewportRenderer draws onto a part of another renderer, i.e. its viewport.
+// Coordinates are relative to the top left corner of the viewport. What's drawn between `clipToViewport`
+// and `EndClip` is clipped to the viewport, if the renderer it draws onto can clip.
+// Groups are scoped to the viewport, if the renderer it draws onto can scope them.
+// Saving does nothing, the renderer it draws onto is saved once everything is drawn.
+type viewportRenderer struct {
+	Renderer
+	viewport Box
+	// scope is the start of the ids of the groups drawn onto the viewport, e.g. "chart-0-".
+	scope string
+}
+
+// MoveTo implements the interface method.
+func (vr viewportRenderer) MoveTo(x, y int) {
+	vr.Renderer.MoveTo(vr.viewport.Left+x, vr.viewport.Top+y)
+}
+
+// LineTo implements the interface method.
+func (vr viewportRenderer) LineTo(x, y int) {
+	vr.Renderer.LineTo(vr.viewport.Left+x, vr.viewport.Top+y)
+}
+
+// MoveToFloat implements the interface method.
+func (vr viewportRenderer) MoveToFloat(x, y float64) {
+	x, y = float64(vr.viewport.Left)+x, float64(vr.viewport.Top)+y
+	if typed, isTyped := vr.Renderer.(FloatPathRenderer); isTyped {
+		typed.MoveToFloat(x, y)
+		return
+	}
+	vr.Renderer.MoveTo(int(math.Round(x)), int(math.Round(y)))
+}
+
+// LineToFloat implements the interface method.
+func (vr viewportRenderer) LineToFloat(x, y float64) {
+	x, y = float64(vr.viewport.Left)+x, float64(vr.viewport.Top)+y
+	if typed, isTyped := vr.Renderer.(FloatPathRenderer); isTyped {
+		typed.LineToFloat(x, y)
+		return
+	}
+	vr.Renderer.LineTo(int(math.Round(x)), int(math.Round(y)))
+}
+
+// QuadCurveTo implements the interface method.
+func (vr viewportRenderer) QuadCurveTo(cx, cy, x, y int) {
+	vr.Renderer.QuadCurveTo(vr.viewport.Left+cx, vr.viewport.Top+cy, vr.viewport.Left+x, vr.viewport.Top+y)
+}
+
+// ArcTo implements the interface method.
+func (vr viewportRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	vr.Renderer.ArcTo(vr.viewport.Left+cx, vr.viewport.Top+cy, rx, ry, startAngle, delta)
+}
+
+// Circle implements the interface method.
+func (vr viewportRenderer) Circle(radius float64, x, y int) {
+	vr.Renderer.Circle(radius, vr.viewport.Left+x, vr.viewport.Top+y)
+}
+
+// CircleFloat implements the interface method.
+func (vr viewportRenderer) CircleFloat(radius, x, y float64) {
+	x, y = float64(vr.viewport.Left)+x, float64(vr.viewport.Top)+y
+	if typed, isTyped := vr.Renderer.(FloatCircleRenderer); isTyped {
+		typed.CircleFloat(radius, x, y)
+		return
+	}
+	vr.Renderer.Circle(radius, int(math.Round(x)), int(math.Round(y)))
+}
+
+// Text implements the interface method.
+func (vr viewportRenderer) Text(body string, x, y int) {
+	vr.Renderer.Text(body, vr.viewport.Left+x, vr.viewport.Top+y)
+}
+
+// DrawImage implements the interface method; the image is skipped if the renderer it draws onto can't draw images.
+func (vr viewportRenderer) DrawImage(img image.Image, box Box) {
+	if typed, isTyped := vr.Renderer.(ImageRenderer); isTyped {
+		typed.DrawImage(img, box.Shift(vr.viewport.Left, vr.viewport.Top))
+	}
+}
+
+// BeginGroup implements the interface method; nothing is grouped if the renderer it draws onto can't group.
+func (vr viewportRenderer) BeginGroup(name string, classes ...string) {
+	vr.beginScopedGroup("", name, classes...)
+}
+
+// beginScopedGroup implements the interface method; the scope is within the scope of the viewport.
+func (vr viewportRenderer) beginScopedGroup(scope, name string, classes ...string) {
+	if typed, isTyped := vr.Renderer.(scopedGroupRenderer); isTyped {
+		typed.beginScopedGroup(vr.scope+scope, name, classes...)
+		return
+	}
+	if typed, isTyped := vr.Renderer.(GroupRenderer); isTyped {
+		typed.BeginGroup(name, classes...)
+	}
+}
+
+// EndGroup implements the interface method.
+func (vr viewportRenderer) EndGroup() {
+	if typed, isTyped := vr.Renderer.(GroupRenderer); isTyped {
+		typed.EndGroup()
+	}
+}
+
+// SetTooltip implements the interface method; nothing has a tooltip if the renderer it draws onto can't show them.
+func (vr viewportRenderer) SetTooltip(text string) {
+	if typed, isTyped := vr.Renderer.(TooltipRenderer); isTyped {
+		typed.SetTooltip(text)
+	}
+}
+
+// clipToViewport clips what's drawn next to the viewport, until `EndClip`.
+func (vr viewportRenderer) clipToViewport() {
+	vr.BeginClip(Box{Right: vr.viewport.Width(), Bottom: vr.viewport.Height()})
+}
+
+// BeginClip implements the interface method; nothing is clipped if the renderer it draws onto can't clip.
+func (vr viewportRenderer) BeginClip(box Box) {
+	if typed, isTyped := vr.Renderer.(ClipRenderer); isTyped {
+		typed.BeginClip(box.Shift(vr.viewport.Left, vr.viewport.Top))
+	}
+}
+
+// EndClip implements the interface method.
+func (vr viewportRenderer) EndClip() {
+	if typed, isTyped := vr.Renderer.(ClipRenderer); isTyped {
+		typed.EndClip()
+	}
+}
+
+// Save implements the interface method; it does nothing.
+func (vr viewportRenderer) Save(_ io.Writer) error {
+	return nil
+}
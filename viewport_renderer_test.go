@@ -0,0 +1,43 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+// wholePixelRenderer hides the optional methods of a renderer, so it only draws at whole pixels.
+type wholePixelRenderer struct {
+	Renderer
+}
+
+func TestViewportRendererFloat(t *testing.T) {
+	assert := assert.New(t)
+
+	draw := func(r Renderer) string {
+		vr := viewportRenderer{Renderer: r, viewport: Box{Top: 10, Left: 20}}
+		vr.MoveToFloat(0.25, 0.5)
+		vr.LineToFloat(5.75, 2)
+		vr.Stroke()
+		vr.CircleFloat(2, 1.5, 1.25)
+		vr.FillStroke()
+
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(r.Save(buffer))
+		return buffer.String()
+	}
+
+	r, err := SVG(100, 100)
+	assert.Nil(err)
+	svg := draw(r)
+	assert.Contains(svg, "M 20.25 10.5\nL 25.75 12\"")
+	assert.Contains(svg, `<circle cx="21.5" cy="11.25"`)
+
+	// renderers without float coordinates get the nearest pixel.
+	r, err = SVG(100, 100)
+	assert.Nil(err)
+	svg = draw(wholePixelRenderer{r})
+	assert.Contains(svg, "M 20 11\nL 26 12\"")
+	assert.Contains(svg, `<circle cx="22" cy="11"`)
+}
@@ -0,0 +1,79 @@
+package chart
+
+import "math"
+
+// WatermarkPlacement is how watermark text is laid out across the canvas.
+type WatermarkPlacement int
+
+const (
+	// WatermarkPlacementCenter draws the text once, in the middle of the canvas.
+	WatermarkPlacementCenter WatermarkPlacement = 0
+	// WatermarkPlacementTiled repeats the text to cover the canvas.
+	WatermarkPlacementTiled WatermarkPlacement = 1
+)
+
+// Watermark is faint text drawn across the canvas beneath the series, e.g. "CONFIDENTIAL" or where the data comes from.
+type Watermark struct {
+	Text string
+	// Style is the text style; the font color and size default to `DefaultWatermarkColor` and `DefaultWatermarkFontSize`.
+	Style Style
+	// Rotation is the angle of the text in degrees, clockwise; zero is horizontal, and e.g. -30 runs up to the right.
+	Rotation float64
+	// Placement draws the text once in the center of the canvas, or tiled across it.
+	Placement WatermarkPlacement
+	// Gap is the space between tiles of text; it defaults to `DefaultWatermarkGap`.
+	Gap int
+}
+
+// GetGap returns the gap between tiles or the default value.
+func (wm Watermark) GetGap() int {
+	if wm.Gap == 0 {
+		return DefaultWatermarkGap
+	}
+	return wm.Gap
+}
+
+// Render renders the watermark centered on, or tiled across, the canvas box.
+// Tiles are laid out from the center of the canvas, and only the ones that fit within it are drawn.
+func (wm Watermark) Render(r Renderer, canvasBox Box, defaults Style) {
+	style := wm.Style.InheritFrom(defaults)
+	if len(wm.Text) == 0 || style.Hidden {
+		return
+	}
+	// the text is measured without rotation; it's rotated around its center below.
+	style.TextRotationDegrees = 0
+	textBox := Draw.MeasureText(r, wm.Text, style)
+	w, h := float64(textBox.Width()), float64(textBox.Height())
+
+	theta := DegreesToRadians(wm.Rotation)
+	sin, cos := math.Sin(theta), math.Cos(theta)
+	// the text starts at its baseline; this is where that is from its center once it's rotated.
+	dx := -(w/2*cos + h/2*sin)
+	dy := -(w/2*sin - h/2*cos)
+	if wm.Rotation != 0 {
+		style.TextRotationDegrees = wm.Rotation
+	}
+
+	cx, cy := canvasBox.Center()
+	if wm.Placement != WatermarkPlacementTiled {
+		Draw.Text(r, wm.Text, cx+int(math.Round(dx)), cy+int(math.Round(dy)), style)
+		return
+	}
+
+	// tiles run in rows along the text, every other row shifted by half a tile; only whole tiles are drawn.
+	along, across := w+float64(wm.GetGap()), h+float64(wm.GetGap())
+	extentX, extentY := math.Abs(w/2*cos)+math.Abs(h/2*sin), math.Abs(w/2*sin)+math.Abs(h/2*cos)
+	n := int(math.Ceil(math.Hypot(float64(canvasBox.Width()), float64(canvasBox.Height())) / math.Min(along, across)))
+	for row := -n; row <= n; row++ {
+		shift := float64(row&1) / 2
+		for column := -n; column <= n; column++ {
+			x := float64(cx) + (float64(column)+shift)*along*cos - float64(row)*across*sin
+			y := float64(cy) + (float64(column)+shift)*along*sin + float64(row)*across*cos
+			if x-extentX < float64(canvasBox.Left) || x+extentX > float64(canvasBox.Right) ||
+				y-extentY < float64(canvasBox.Top) || y+extentY > float64(canvasBox.Bottom) {
+				continue
+			}
+			Draw.Text(r, wm.Text, int(math.Round(x+dx)), int(math.Round(y+dy)), style)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package chart
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func watermarkTestChart(wm Watermark) Chart {
+	return Chart{
+		Width:     600,
+		Height:    300,
+		Watermark: wm,
+		Series: []Series{
+			ContinuousSeries{XValues: LinearRange(0, 10), YValues: LinearRange(0, 10)},
+		},
+	}
+}
+
+// watermarkTestPositions renders the watermark alone and returns where each of its texts starts.
+func watermarkTestPositions(t *testing.T, wm Watermark, canvasBox Box) (positions [][2]int) {
+	font, err := GetDefaultFont()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := SVG(600, 300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wm.Render(r, canvasBox, Style{Font: font, FontColor: DefaultWatermarkColor, FontSize: DefaultWatermarkFontSize})
+	buffer := bytes.NewBuffer(nil)
+	if err := r.Save(buffer); err != nil {
+		t.Fatal(err)
+	}
+	for _, match := range regexp.MustCompile(`<text x="(-?\d+)" y="(-?\d+)"[^>]*>`+regexp.QuoteMeta(wm.Text)+`</text>`).FindAllStringSubmatch(buffer.String(), -1) {
+		x, _ := strconv.Atoi(match[1])
+		y, _ := strconv.Atoi(match[2])
+		positions = append(positions, [2]int{x, y})
+	}
+	return
+}
+
+func TestWatermarkGetGap(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(DefaultWatermarkGap, Watermark{}.GetGap())
+	assert.Equal(10, Watermark{Gap: 10}.GetGap())
+}
+
+func TestWatermarkHidden(t *testing.T) {
+	assert := assert.New(t)
+
+	plain := renderFrameTestChart(t, watermarkTestChart(Watermark{}))
+	assert.Equal(plain, renderFrameTestChart(t, watermarkTestChart(Watermark{Rotation: -30, Placement: WatermarkPlacementTiled})))
+	assert.Equal(plain, renderFrameTestChart(t, watermarkTestChart(Watermark{Text: "CONFIDENTIAL", Style: Hidden()})))
+}
+
+func TestWatermarkCenter(t *testing.T) {
+	assert := assert.New(t)
+
+	svg := renderFrameTestChart(t, watermarkTestChart(Watermark{Text: "CONFIDENTIAL", Rotation: -30}))
+	assert.Equal(1, strings.Count(svg, ">CONFIDENTIAL</text>"))
+	assert.Contains(svg, "rotate(-30.00")
+	assert.Contains(svg, "fill:"+DefaultWatermarkColor.Hex())
+
+	// the watermark is beneath the series.
+	assert.True(strings.Index(svg, ">CONFIDENTIAL</text>") < strings.Index(svg, "stroke:"+DefaultColors[0].Hex()))
+
+	// unrotated, the text is centered on the canvas.
+	canvasBox := Box{Top: 0, Left: 0, Right: 600, Bottom: 300}
+	positions := watermarkTestPositions(t, Watermark{Text: "CONFIDENTIAL"}, canvasBox)
+	assert.Len(positions, 1)
+	assert.True(positions[0][0] > 0 && positions[0][0] < 300)
+	assert.True(positions[0][1] > 150 && positions[0][1] < 300)
+}
+
+func TestWatermarkTiled(t *testing.T) {
+	assert := assert.New(t)
+
+	canvasBox := Box{Top: 20, Left: 20, Right: 580, Bottom: 280}
+	wm := Watermark{Text: "example.com", Style: Style{FontSize: 12}, Placement: WatermarkPlacementTiled}
+	positions := watermarkTestPositions(t, wm, canvasBox)
+	assert.True(len(positions) > 4)
+
+	// unrotated, every tile is whole within the canvas, and no two overlap.
+	for index, position := range positions {
+		assert.True(position[0] >= canvasBox.Left && position[0] < canvasBox.Right)
+		assert.True(position[1] > canvasBox.Top && position[1] <= canvasBox.Bottom)
+		for _, other := range positions[index+1:] {
+			assert.NotEqual(position, other)
+		}
+	}
+
+	wm.Rotation = -30
+	assert.True(len(watermarkTestPositions(t, wm, canvasBox)) > 1)
+}
@@ -19,10 +19,25 @@ type XAxis struct {
 	Style          Style
 	ValueFormatter ValueFormatter
 	Range          Range
+	// RangePaddingPercent widens the range computed from the series on each side,
+	// as a fraction of the range, i.e. 0.05 is 5%. It is ignored if `Range` is set.
+	RangePaddingPercent float64
+	// Window makes a user supplied `Range` or `Ticks` a window onto the series rather than just a scale:
+	// the y ranges are computed from the values within the window, and annotations outside it are left out.
+	// Lines are clipped where they leave the canvas either way.
+	Window bool
 
 	TickStyle    Style
 	Ticks        []Tick
 	TickPosition TickPosition
+	// TickMarkLength is the length of the tick marks; it defaults to `DefaultVerticalTickHeight`.
+	// Set it to `Disabled` to draw no tick marks.
+	TickMarkLength int
+	// TickMarkDirection is the side of the axis line the tick marks are drawn on; it defaults to outside.
+	TickMarkDirection TickMarkDirection
+	// EvenTicks divides the range evenly for ticks instead of using nice numbers,
+	// without extending a range worked out from the values to the nice numbers around it.
+	EvenTicks bool
 
 	GridLines      []GridLine
 	GridMajorStyle Style
@@ -60,18 +75,47 @@ func (xa XAxis) GetTickPosition(defaults ...TickPosition) TickPosition {
 
 // GetTicks returns the ticks for a series.
 // The coalesce priority is:
-// 	- User Supplied Ticks (i.e. Ticks array on the axis itself).
+// 	- User Supplied Ticks (i.e. Ticks array on the axis itself), limited to the range.
 // 	- Range ticks (i.e. if the range provides ticks).
-//	- Generating continuous ticks based on minimum spacing and canvas width.
+//	- Generating nice ticks based on minimum spacing and canvas width, or
+//	  evenly divided continuous ticks if `EvenTicks` is set.
 func (xa XAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter) []Tick {
 	if len(xa.Ticks) > 0 {
-		return xa.Ticks
+		return getUserTicks(xa.Ticks, ra, vf)
 	}
+	// the labels are measured with the style they're drawn with.
+	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
 	if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
-		return tp.GetTicks(r, defaults, vf)
+		return tp.GetTicks(r, tickStyle, vf)
+	}
+	if xa.EvenTicks {
+		return GenerateContinuousTicks(r, ra, false, tickStyle, vf)
 	}
-	tickStyle := xa.Style.InheritFrom(defaults)
-	return GenerateContinuousTicks(r, ra, false, tickStyle, vf)
+	return GenerateNiceTicks(r, ra, false, tickStyle, vf)
+}
+
+// extendRange extends a range worked out from the values out to nice numbers, so the axis starts and ends on a tick.
+// Ranges set on the axis, and axes with ticks of their own or evenly divided ticks, are left as they are.
+func (xa XAxis) extendRange(r Renderer, ra Range, defaults Style, vf ValueFormatter) {
+	if len(xa.Ticks) > 0 || xa.EvenTicks || (xa.Range != nil && !xa.Range.IsZero()) {
+		return
+	}
+	if _, isTickProvider := ra.(TicksProvider); isTickProvider {
+		return
+	}
+	extendRangeToNiceTicks(r, ra, false, xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults)), vf)
+}
+
+// getTickMarkExtent returns how far the tick marks reach below and above the bottom of the canvas.
+func (xa XAxis) getTickMarkExtent() (outside, inside int) {
+	return getTickMarkExtent(xa.TickMarkLength, DefaultVerticalTickHeight, xa.TickMarkDirection)
+}
+
+// getLabelMargin returns the distance between the canvas and the labels,
+// which grows if outside tick marks are longer than the default.
+func (xa XAxis) getLabelMargin() int {
+	outside, _ := xa.getTickMarkExtent()
+	return DefaultXAxisMargin + MaxInt(outside-DefaultVerticalTickHeight, 0)
 }
 
 // GetGridLines returns the gridlines for the axis.
@@ -87,6 +131,7 @@ func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
 
 	tp := xa.GetTickPosition()
+	margin := xa.getLabelMargin()
 
 	var ltx, rtx int
 	var tx, ty int
@@ -96,11 +141,22 @@ func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 		tb := Draw.MeasureText(r, t.Label, tickStyle.GetTextOptions())
 
 		tx = canvasBox.Left + ra.Translate(v)
-		ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height()
+		ty = canvasBox.Bottom + margin + tb.Height()
 		switch tp {
 		case TickPositionUnderTick, TickPositionUnset:
-			ltx = tx - tb.Width()>>1
-			rtx = tx + tb.Width()>>1
+			if t.Label == "" {
+				break
+			}
+			if tickStyle.TextRotationDegrees == 0 {
+				ltx = tx - tb.Width()>>1
+				rtx = tx + tb.Width()>>1
+			} else {
+				// rotated labels are drawn from the tick rather than centered on it,
+				// and start an extra margin below the canvas (see `Render`).
+				ltx = tx
+				rtx = tx + tb.Width()
+				ty = canvasBox.Bottom + margin + DefaultXAxisMargin + tb.Height()
+			}
 			break
 		case TickPositionBetweenTicks:
 			if index > 0 {
@@ -119,6 +175,8 @@ func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 		tb := Draw.MeasureText(r, xa.Name, xa.NameStyle.InheritFrom(defaults))
 		bottom += DefaultXAxisMargin + tb.Height()
 	}
+	outside, _ := xa.getTickMarkExtent()
+	bottom = MaxInt(bottom, canvasBox.Bottom+outside)
 
 	return Box{
 		Top:    canvasBox.Bottom,
@@ -128,6 +186,34 @@ func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 	}
 }
 
+// dropOverflowingLabels returns the ticks with the labels at either end left out if they would cross the sides of
+// the bounds, e.g. the chart box. Only labels under their ticks are checked; labels between ticks fit between them.
+func (xa XAxis) dropOverflowingLabels(r Renderer, bounds, canvasBox Box, ra Range, defaults Style, ticks []Tick) []Tick {
+	tp := xa.GetTickPosition()
+	if len(ticks) == 0 || (tp != TickPositionUnderTick && tp != TickPositionUnset) {
+		return ticks
+	}
+	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
+
+	output := append([]Tick(nil), ticks...)
+	for _, index := range []int{0, len(output) - 1} {
+		t := output[index]
+		if t.Label == "" {
+			continue
+		}
+		tb := Draw.MeasureText(r, t.Label, tickStyle.GetTextOptions())
+		tx := canvasBox.Left + ra.Translate(t.Value)
+		ltx, rtx := tx-tb.Width()>>1, tx+tb.Width()>>1
+		if tickStyle.TextRotationDegrees != 0 {
+			ltx, rtx = tx, tx+tb.Width()
+		}
+		if ltx < bounds.Left || rtx > bounds.Right {
+			output[index].Label = ""
+		}
+	}
+	return output
+}
+
 // Render renders the axis
 func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) {
 	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
@@ -138,8 +224,10 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	r.Stroke()
 
 	tp := xa.GetTickPosition()
+	margin := xa.getLabelMargin()
+	outside, inside := xa.getTickMarkExtent()
 
-	var tx, ty int
+	var tx int
 	var maxTextHeight int
 	for index, t := range ticks {
 		v := t.Value
@@ -147,23 +235,29 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 
 		tx = canvasBox.Left + lx
 
-		tickStyle.GetStrokeOptions().WriteToRenderer(r)
-		r.MoveTo(tx, canvasBox.Bottom)
-		r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
-		r.Stroke()
+		if outside > 0 || inside > 0 {
+			// tick marks are drawn between pixels like the series lines, so the lines meet them.
+			tickStyle.GetStrokeOptions().WriteToRenderer(r)
+			ftx := float64(canvasBox.Left) + translateFloat(ra, v)
+			Draw.path(r, []floatPoint{{X: ftx, Y: float64(canvasBox.Bottom - inside)}, {X: ftx, Y: float64(canvasBox.Bottom + outside)}})
+			r.Stroke()
+		}
 
 		tickWithAxisStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
 		tb := Draw.MeasureText(r, t.Label, tickWithAxisStyle)
 
 		switch tp {
 		case TickPositionUnderTick, TickPositionUnset:
+			if t.Label == "" {
+				break
+			}
 			if tickStyle.TextRotationDegrees == 0 {
-				tx = tx - tb.Width()>>1
-				ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height()
+				labelStyle := tickWithAxisStyle
+				labelStyle.TextHorizontalAlign, labelStyle.TextVerticalAlign = TextHorizontalAlignCenter, TextVerticalAlignTop
+				Draw.TextAligned(r, t.Label, tx, canvasBox.Bottom+margin, labelStyle)
 			} else {
-				ty = canvasBox.Bottom + (2 * DefaultXAxisMargin)
+				Draw.Text(r, t.Label, tx, canvasBox.Bottom+margin+DefaultXAxisMargin, tickWithAxisStyle)
 			}
-			Draw.Text(r, t.Label, tx, ty, tickWithAxisStyle)
 			maxTextHeight = MaxInt(maxTextHeight, tb.Height())
 			break
 		case TickPositionBetweenTicks:
@@ -175,8 +269,8 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 				Draw.TextWithin(r, t.Label, Box{
 					Left:   ltx,
 					Right:  tx,
-					Top:    canvasBox.Bottom + DefaultXAxisMargin,
-					Bottom: canvasBox.Bottom + DefaultXAxisMargin,
+					Top:    canvasBox.Bottom + margin,
+					Bottom: canvasBox.Bottom + margin,
 				}, finalTickStyle)
 
 				ftb := Text.MeasureLines(r, Text.WrapFit(r, t.Label, tx-ltx, finalTickStyle), finalTickStyle)
@@ -190,7 +284,7 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	if !xa.NameStyle.Hidden && len(xa.Name) > 0 {
 		tb := Draw.MeasureText(r, xa.Name, nameStyle)
 		tx := canvasBox.Right - (canvasBox.Width()>>1 + tb.Width()>>1)
-		ty := canvasBox.Bottom + DefaultXAxisMargin + maxTextHeight + DefaultXAxisMargin + tb.Height()
+		ty := canvasBox.Bottom + margin + maxTextHeight + DefaultXAxisMargin + tb.Height()
 		Draw.Text(r, xa.Name, tx, ty, nameStyle)
 	}
 
@@ -199,7 +293,9 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 			if (gl.IsMinor && !xa.GridMinorStyle.Hidden) || (!gl.IsMinor && !xa.GridMajorStyle.Hidden) {
 				defaults := xa.GridMajorStyle
 				if gl.IsMinor {
-					defaults = xa.GridMinorStyle
+					// minor lines fall back to the major style so setting
+					// only the major style grids every tick.
+					defaults = xa.GridMinorStyle.InheritFrom(xa.GridMajorStyle)
 				}
 				gl.Render(r, canvasBox, ra, true, gl.Style.InheritFrom(defaults))
 			}
@@ -1,6 +1,8 @@
 package chart
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/blend/go-sdk/assert"
@@ -15,7 +17,7 @@ func TestXAxisGetTicks(t *testing.T) {
 	f, err := GetDefaultFont()
 	assert.Nil(err)
 
-	xa := XAxis{}
+	xa := XAxis{EvenTicks: true}
 	xr := &ContinuousRange{Min: 10, Max: 100, Domain: 1024}
 	styleDefaults := Style{
 		Font:     f,
@@ -23,7 +25,7 @@ func TestXAxisGetTicks(t *testing.T) {
 	}
 	vf := FloatValueFormatter
 	ticks := xa.GetTicks(r, xr, styleDefaults, vf)
-	assert.Len(ticks, 16)
+	assert.Len(ticks, 14)
 }
 
 func TestXAxisGetTicksWithUserDefaults(t *testing.T) {
@@ -36,7 +38,7 @@ func TestXAxisGetTicksWithUserDefaults(t *testing.T) {
 	assert.Nil(err)
 
 	xa := XAxis{
-		Ticks: []Tick{{Value: 1.0, Label: "1.0"}},
+		Ticks: []Tick{{Value: 10.0, Label: "10.0"}},
 	}
 	xr := &ContinuousRange{Min: 10, Max: 100, Domain: 1024}
 	styleDefaults := Style{
@@ -65,3 +67,121 @@ func TestXAxisMeasure(t *testing.T) {
 	assert.Equal(122, xab.Width())
 	assert.Equal(21, xab.Height())
 }
+
+func TestXAxisMeasureTickMarks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	measure := func(xa XAxis) Box {
+		return xa.Measure(r, NewBox(0, 0, 100, 100), &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}, style, ticks)
+	}
+
+	// up to the default length, tick marks fit in the margin above the labels.
+	assert.Equal(21, measure(XAxis{TickMarkLength: Disabled}).Height())
+	assert.Equal(21, measure(XAxis{TickMarkLength: DefaultVerticalTickHeight, TickMarkDirection: TickMarkDirectionInside}).Height())
+	assert.Equal(21+20-DefaultVerticalTickHeight, measure(XAxis{TickMarkLength: 20}).Height())
+	assert.Equal(21, measure(XAxis{TickMarkLength: 20, TickMarkDirection: TickMarkDirectionInside}).Height())
+}
+
+func TestXAxisMeasureRotated(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+	ticks := []Tick{{Value: 1.0, Label: "2006-01-02 15:04"}, {Value: 2.0, Label: "2006-01-02 16:04"}, {Value: 3.0, Label: "2006-01-02 17:04"}}
+	tickStyle := Style{TextRotationDegrees: 90}
+	labelBox := Draw.MeasureText(r, ticks[0].Label, tickStyle.InheritFrom(style))
+
+	xa := XAxis{TickStyle: tickStyle}
+	xab := xa.Measure(r, NewBox(0, 0, 100, 100), &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}, style, ticks)
+	assert.Equal(100, xab.Top)
+	assert.Equal(100+(2*DefaultXAxisMargin)+labelBox.Height(), xab.Bottom)
+	assert.Equal(0, xab.Left)
+	assert.Equal(100+labelBox.Width(), xab.Right)
+}
+
+func TestXAxisGetTicksUserTicksOutsideRange(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	xa := XAxis{
+		Ticks: []Tick{{Value: 1.0, Label: "1.0"}, {Value: 50.0}, {Value: 100.0, Label: "max"}, {Value: 101.0, Label: "101.0"}},
+	}
+	xr := &ContinuousRange{Min: 10, Max: 100, Domain: 1024}
+	ticks := xa.GetTicks(r, xr, Style{}, FloatValueFormatter)
+	assert.Len(ticks, 2)
+	assert.Equal(50.0, ticks[0].Value)
+	assert.Equal("50.00", ticks[0].Label)
+	assert.Equal("max", ticks[1].Label)
+}
+
+func TestXAxisRenderFractionalPositions(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: 10.0, StrokeColor: ColorBlack, StrokeWidth: 1}
+	r, err := SVG(100, 100)
+	assert.Nil(err)
+
+	// 1 translates to 26.67, which `Translate` rounds up to 27; tick marks, grid lines and markers
+	// are drawn at the fractional position, where the series lines go through it.
+	canvasBox := NewBox(10, 10, 90, 90)
+	ra := &ContinuousRange{Min: 0, Max: 3, Domain: canvasBox.Width()}
+	XAxis{}.Render(r, canvasBox, ra, style, []Tick{{Value: 1, Label: "1"}})
+	GridLine{Value: 1}.Render(r, canvasBox, ra, true, style)
+	XMarker{Value: 1}.Render(r, canvasBox, ra, style)
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(r.Save(buffer))
+	assert.Equal(3, strings.Count(buffer.String(), "M 36.67 "), buffer.String())
+}
+
+func TestXAxisDropOverflowingLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: 10.0}
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+
+	ra := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	ticks := []Tick{{Value: 0, Label: "0.0"}, {Value: 5, Label: "5.0"}, {Value: 10, Label: "the last label"}}
+
+	// the last label is centered on the right edge of the canvas, and crosses the side of the bounds.
+	fitted := XAxis{}.dropOverflowingLabels(r, Box{Right: 140, Bottom: 100}, Box{Left: 30, Right: 130, Bottom: 80}, ra, style, ticks)
+	assert.Len(fitted, 3)
+	assert.Equal("0.0", fitted[0].Label)
+	assert.Equal("5.0", fitted[1].Label)
+	assert.Empty(fitted[2].Label)
+	assert.Equal("the last label", ticks[2].Label)
+
+	// labels between ticks are left alone.
+	fitted = XAxis{TickPosition: TickPositionBetweenTicks}.dropOverflowingLabels(r, Box{Right: 140, Bottom: 100}, Box{Left: 30, Right: 130, Bottom: 80}, ra, style, ticks)
+	assert.Equal("the last label", fitted[2].Label)
+
+	// and labels left out aren't drawn.
+	vr, err := SVG(140, 100)
+	assert.Nil(err)
+	XAxis{}.Render(vr, Box{Left: 30, Right: 130, Bottom: 80}, ra, style, []Tick{{Value: 0, Label: "0.0"}, {Value: 10}})
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(vr.Save(buffer))
+	assert.Equal(1, strings.Count(buffer.String(), "<text"))
+}
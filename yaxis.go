@@ -19,16 +19,32 @@ type YAxis struct {
 
 	Style Style
 
+	// Zero is the line drawn across the canvas at zero, when zero is within the range and not at its edge.
+	// The primary axis draws it by default; set its style to `Hidden()` to turn it off.
 	Zero GridLine
 
 	AxisType  YAxisType
+	Position  YAxisPosition
 	Ascending bool
 
 	ValueFormatter ValueFormatter
 	Range          Range
+	// RangePaddingPercent widens the range computed from the series on each side,
+	// as a fraction of the range, i.e. 0.05 is 5%. It is ignored if `Range` is set.
+	RangePaddingPercent float64
+	// IncludeZero extends the range computed from the series to zero. It is ignored if `Range` is set.
+	IncludeZero bool
 
 	TickStyle Style
 	Ticks     []Tick
+	// TickMarkLength is the length of the tick marks; it defaults to `DefaultHorizontalTickWidth`.
+	// Set it to `Disabled` to draw no tick marks.
+	TickMarkLength int
+	// TickMarkDirection is the side of the axis line the tick marks are drawn on; it defaults to outside.
+	TickMarkDirection TickMarkDirection
+	// EvenTicks divides the range evenly for ticks instead of using nice numbers,
+	// without extending a range worked out from the values to the nice numbers around it.
+	EvenTicks bool
 
 	GridLines      []GridLine
 	GridMajorStyle Style
@@ -58,6 +74,18 @@ func (ya YAxis) GetValueFormatter() ValueFormatter {
 	return FloatValueFormatter
 }
 
+// GetPosition returns the side of the canvas the axis is drawn on.
+// If unset, the primary axis is drawn on the right and the secondary axis on the left.
+func (ya YAxis) GetPosition() YAxisPosition {
+	if ya.Position == YAxisPositionUnset {
+		if ya.AxisType == YAxisSecondary {
+			return YAxisPositionLeft
+		}
+		return YAxisPositionRight
+	}
+	return ya.Position
+}
+
 // GetTickStyle returns the tick style.
 func (ya YAxis) GetTickStyle() Style {
 	return ya.TickStyle
@@ -65,18 +93,58 @@ func (ya YAxis) GetTickStyle() Style {
 
 // GetTicks returns the ticks for a series.
 // The coalesce priority is:
-// 	- User Supplied Ticks (i.e. Ticks array on the axis itself).
+// 	- User Supplied Ticks (i.e. Ticks array on the axis itself), limited to the range.
 // 	- Range ticks (i.e. if the range provides ticks).
-//	- Generating continuous ticks based on minimum spacing and canvas width.
+//	- Generating nice ticks based on minimum spacing and canvas width, or
+//	  evenly divided continuous ticks if `EvenTicks` is set.
 func (ya YAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter) []Tick {
 	if len(ya.Ticks) > 0 {
-		return ya.Ticks
+		return getUserTicks(ya.Ticks, ra, vf)
 	}
+	// the labels are measured with the style they're drawn with.
+	tickStyle := ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults))
 	if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
-		return tp.GetTicks(r, defaults, vf)
+		return tp.GetTicks(r, tickStyle, vf)
+	}
+	if ya.EvenTicks {
+		return GenerateContinuousTicks(r, ra, true, tickStyle, vf)
+	}
+	return GenerateNiceTicks(r, ra, true, tickStyle, vf)
+}
+
+// extendRange extends a range worked out from the values out to nice numbers, so the axis starts and ends on a tick.
+// Ranges set on the axis, and axes with ticks of their own or evenly divided ticks, are left as they are.
+func (ya YAxis) extendRange(r Renderer, ra Range, defaults Style, vf ValueFormatter) {
+	if len(ya.Ticks) > 0 || ya.EvenTicks || (ya.Range != nil && !ya.Range.IsZero()) {
+		return
+	}
+	if _, isTickProvider := ra.(TicksProvider); isTickProvider {
+		return
+	}
+	extendRangeToNiceTicks(r, ra, true, ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults)), vf)
+}
+
+// getTickMarkExtent returns how far the tick marks reach away from and into the canvas.
+func (ya YAxis) getTickMarkExtent() (outside, inside int) {
+	return getTickMarkExtent(ya.TickMarkLength, DefaultHorizontalTickWidth, ya.TickMarkDirection)
+}
+
+// getLabelMargin returns the distance between the axis line and the labels,
+// which grows if outside tick marks are longer than the default.
+func (ya YAxis) getLabelMargin() int {
+	outside, _ := ya.getTickMarkExtent()
+	return DefaultYAxisMargin + MaxInt(outside-DefaultHorizontalTickWidth, 0)
+}
+
+// styleDefaultsZero returns the defaults of the zero line; only the primary axis draws one unless it's styled.
+func (ya YAxis) styleDefaultsZero() Style {
+	if ya.AxisType == YAxisSecondary {
+		return Style{}
+	}
+	return Style{
+		StrokeColor: DefaultZeroLineColor,
+		StrokeWidth: DefaultZeroLineWidth,
 	}
-	tickStyle := ya.Style.InheritFrom(defaults)
-	return GenerateContinuousTicks(r, ra, true, tickStyle, vf)
 }
 
 // GetGridLines returns the gridlines for the axis.
@@ -89,16 +157,18 @@ func (ya YAxis) GetGridLines(ticks []Tick) []GridLine {
 
 // Measure returns the bounds of the axis.
 func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
+	position := ya.GetPosition()
+
+	margin := ya.getLabelMargin()
 	var tx int
-	if ya.AxisType == YAxisPrimary {
-		tx = canvasBox.Right + DefaultYAxisMargin
-	} else if ya.AxisType == YAxisSecondary {
-		tx = canvasBox.Left - DefaultYAxisMargin
+	if position == YAxisPositionRight {
+		tx = canvasBox.Right + margin
+	} else {
+		tx = canvasBox.Left - margin
 	}
 
 	ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults)).WriteToRenderer(r)
 	var minx, maxx, miny, maxy = math.MaxInt32, 0, math.MaxInt32, 0
-	var maxTextHeight int
 	for _, t := range ticks {
 		v := t.Value
 		ly := canvasBox.Bottom - ra.Translate(v)
@@ -106,18 +176,16 @@ func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 		tb := r.MeasureText(t.Label)
 		tbh2 := tb.Height() >> 1
 		finalTextX := tx
-		if ya.AxisType == YAxisSecondary {
+		if position == YAxisPositionLeft {
 			finalTextX = tx - tb.Width()
 		}
 
-		maxTextHeight = MaxInt(tb.Height(), maxTextHeight)
-
-		if ya.AxisType == YAxisPrimary {
+		if position == YAxisPositionRight {
 			minx = canvasBox.Right
 			maxx = MaxInt(maxx, tx+tb.Width())
-		} else if ya.AxisType == YAxisSecondary {
+		} else {
 			minx = MinInt(minx, finalTextX)
-			maxx = MaxInt(maxx, tx)
+			maxx = canvasBox.Left
 		}
 
 		miny = MinInt(miny, ly-tbh2)
@@ -125,7 +193,13 @@ func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 	}
 
 	if !ya.NameStyle.Hidden && len(ya.Name) > 0 {
-		maxx += (DefaultYAxisMargin + maxTextHeight)
+		// measure the name as it's drawn, i.e. rotated, so the space reserved matches.
+		tb := Draw.MeasureText(r, ya.Name, ya.NameStyle.InheritFrom(defaults.InheritFrom(Style{TextRotationDegrees: 90})))
+		if position == YAxisPositionRight {
+			maxx += (DefaultYAxisMargin + tb.Width())
+		} else {
+			minx -= (DefaultYAxisMargin + tb.Width())
+		}
 	}
 
 	return Box{
@@ -142,15 +216,19 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	tickStyle.WriteToRenderer(r)
 
 	sw := tickStyle.GetStrokeWidth(defaults.StrokeWidth)
+	position := ya.GetPosition()
+
+	margin := ya.getLabelMargin()
+	outside, inside := ya.getTickMarkExtent()
 
 	var lx int
 	var tx int
-	if ya.AxisType == YAxisPrimary {
+	if position == YAxisPositionRight {
 		lx = canvasBox.Right + int(sw)
-		tx = lx + DefaultYAxisMargin
-	} else if ya.AxisType == YAxisSecondary {
+		tx = lx + margin
+	} else {
 		lx = canvasBox.Left - int(sw)
-		tx = lx - DefaultYAxisMargin
+		tx = lx - margin
 	}
 
 	r.MoveTo(lx, canvasBox.Bottom)
@@ -158,7 +236,13 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	r.Stroke()
 
 	var maxTextWidth int
-	var finalTextX, finalTextY int
+	// unrotated labels are aligned to the middle of the tick, on the side of the canvas the axis is on.
+	labelStyle := tickStyle
+	labelStyle.TextHorizontalAlign, labelStyle.TextVerticalAlign = TextHorizontalAlignLeft, TextVerticalAlignMiddle
+	if position == YAxisPositionLeft {
+		labelStyle.TextHorizontalAlign = TextHorizontalAlignRight
+	}
+
 	for _, t := range ticks {
 		v := t.Value
 		ly := canvasBox.Bottom - ra.Translate(v)
@@ -169,29 +253,26 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 			maxTextWidth = tb.Width()
 		}
 
-		if ya.AxisType == YAxisSecondary {
-			finalTextX = tx - tb.Width()
-		} else {
-			finalTextX = tx
+		tickStyle.WriteToRenderer(r)
+
+		if outside > 0 || inside > 0 {
+			// tick marks are drawn between pixels like the series lines, so the lines meet them.
+			fly := float64(canvasBox.Bottom) - translateFloat(ra, v)
+			if position == YAxisPositionRight {
+				Draw.path(r, []floatPoint{{X: float64(lx - inside), Y: fly}, {X: float64(lx + outside), Y: fly}})
+			} else {
+				Draw.path(r, []floatPoint{{X: float64(lx + inside), Y: fly}, {X: float64(lx - outside), Y: fly}})
+			}
+			r.Stroke()
 		}
 
 		if tickStyle.TextRotationDegrees == 0 {
-			finalTextY = ly + tb.Height()>>1
+			Draw.TextAligned(r, t.Label, tx, ly, labelStyle)
+		} else if position == YAxisPositionLeft {
+			Draw.Text(r, t.Label, tx-tb.Width(), ly, tickStyle)
 		} else {
-			finalTextY = ly
-		}
-
-		tickStyle.WriteToRenderer(r)
-
-		r.MoveTo(lx, ly)
-		if ya.AxisType == YAxisPrimary {
-			r.LineTo(lx+DefaultHorizontalTickWidth, ly)
-		} else if ya.AxisType == YAxisSecondary {
-			r.LineTo(lx-DefaultHorizontalTickWidth, ly)
+			Draw.Text(r, t.Label, tx, ly, tickStyle)
 		}
-		r.Stroke()
-
-		Draw.Text(r, t.Label, finalTextX, finalTextY, tickStyle)
 	}
 
 	nameStyle := ya.NameStyle.InheritFrom(defaults.InheritFrom(Style{TextRotationDegrees: 90}))
@@ -200,10 +281,11 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		tb := Draw.MeasureText(r, ya.Name, nameStyle)
 
 		var tx int
-		if ya.AxisType == YAxisPrimary {
-			tx = canvasBox.Right + int(sw) + DefaultYAxisMargin + maxTextWidth + DefaultYAxisMargin
-		} else if ya.AxisType == YAxisSecondary {
-			tx = canvasBox.Left - (DefaultYAxisMargin + int(sw) + maxTextWidth + DefaultYAxisMargin)
+		if position == YAxisPositionRight {
+			tx = canvasBox.Right + int(sw) + margin + maxTextWidth + DefaultYAxisMargin
+		} else {
+			// rotated text extends to the right of the origin, so leave room for it.
+			tx = canvasBox.Left - (margin + int(sw) + maxTextWidth + DefaultYAxisMargin + tb.Width())
 		}
 
 		var ty int
@@ -216,8 +298,8 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		Draw.Text(r, ya.Name, tx, ty, nameStyle)
 	}
 
-	if !ya.Zero.Style.Hidden {
-		ya.Zero.Render(r, canvasBox, ra, false, Style{})
+	if !ya.Zero.Style.Hidden && ra.GetMin() < ya.Zero.Value && ya.Zero.Value < ra.GetMax() {
+		ya.Zero.Render(r, canvasBox, ra, false, ya.styleDefaultsZero())
 	}
 
 	if !ya.GridMajorStyle.Hidden || !ya.GridMinorStyle.Hidden {
@@ -225,7 +307,9 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 			if (gl.IsMinor && !ya.GridMinorStyle.Hidden) || (!gl.IsMinor && !ya.GridMajorStyle.Hidden) {
 				defaults := ya.GridMajorStyle
 				if gl.IsMinor {
-					defaults = ya.GridMinorStyle
+					// minor lines fall back to the major style so setting
+					// only the major style grids every tick.
+					defaults = ya.GridMinorStyle.InheritFrom(ya.GridMajorStyle)
 				}
 				gl.Render(r, canvasBox, ra, false, gl.Style.InheritFrom(defaults))
 			}
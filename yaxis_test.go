@@ -1,9 +1,15 @@
 package chart
 
 import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/blend/go-sdk/assert"
+	"github.com/wcharczuk/go-chart/drawing"
 )
 
 func TestYAxisGetTicks(t *testing.T) {
@@ -15,7 +21,7 @@ func TestYAxisGetTicks(t *testing.T) {
 	f, err := GetDefaultFont()
 	assert.Nil(err)
 
-	ya := YAxis{}
+	ya := YAxis{EvenTicks: true}
 	yr := &ContinuousRange{Min: 10, Max: 100, Domain: 1024}
 	styleDefaults := Style{
 		Font:     f,
@@ -36,7 +42,7 @@ func TestYAxisGetTicksWithUserDefaults(t *testing.T) {
 	assert.Nil(err)
 
 	ya := YAxis{
-		Ticks: []Tick{{Value: 1.0, Label: "1.0"}},
+		Ticks: []Tick{{Value: 10.0, Label: "10.0"}},
 	}
 	yr := &ContinuousRange{Min: 10, Max: 100, Domain: 1024}
 	styleDefaults := Style{
@@ -66,6 +72,60 @@ func TestYAxisMeasure(t *testing.T) {
 	assert.Equal(110, yab.Height())
 }
 
+func TestYAxisMeasureTickMarks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	measure := func(ya YAxis) Box {
+		return ya.Measure(r, NewBox(0, 0, 100, 100), &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}, style, ticks)
+	}
+
+	assert.Equal(32, measure(YAxis{TickMarkLength: Disabled}).Width())
+	assert.Equal(32+20-DefaultHorizontalTickWidth, measure(YAxis{TickMarkLength: 20}).Width())
+	assert.Equal(32, measure(YAxis{TickMarkLength: 20, TickMarkDirection: TickMarkDirectionInside}).Width())
+	left := measure(YAxis{Position: YAxisPositionLeft, TickMarkLength: 20})
+	assert.Equal(32+20-DefaultHorizontalTickWidth, left.Width())
+}
+
+func TestAxisTickMarksRender(t *testing.T) {
+	assert := assert.New(t)
+
+	chart := func(xa XAxis, ya YAxis) Chart {
+		return Chart{
+			Width:  300,
+			Height: 200,
+			XAxis:  xa,
+			YAxis:  ya,
+			Series: []Series{
+				ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+			},
+		}
+	}
+
+	plain := renderFrameTestChart(t, chart(XAxis{}, YAxis{}))
+	inside := renderFrameTestChart(t, chart(XAxis{TickMarkLength: 8, TickMarkDirection: TickMarkDirectionInside}, YAxis{}))
+
+	// the second path is the canvas; the first x tick sits on its bottom left corner.
+	var left, bottom int
+	canvas := strings.Split(strings.Split(plain, `<path  d="`)[2], "\n")
+	_, err := fmt.Sscanf(canvas[3], "L %d %d", &left, &bottom)
+	assert.Nil(err)
+	assert.Contains(plain, fmt.Sprintf("M %d %d\nL %d %d", left, bottom, left, bottom+DefaultVerticalTickHeight))
+	assert.Contains(inside, fmt.Sprintf("M %d %d\nL %d %d", left, bottom-8, left, bottom))
+
+	disabled := renderFrameTestChart(t, chart(XAxis{TickMarkLength: Disabled}, YAxis{TickMarkLength: Disabled}))
+	assert.NotContains(disabled, fmt.Sprintf("L %d %d", left, bottom+DefaultVerticalTickHeight))
+	assert.True(strings.Count(disabled, "<path") < strings.Count(plain, "<path"))
+}
+
 func TestYAxisSecondaryMeasure(t *testing.T) {
 	assert := assert.New(t)
 
@@ -83,3 +143,91 @@ func TestYAxisSecondaryMeasure(t *testing.T) {
 	assert.Equal(32, yab.Width())
 	assert.Equal(110, yab.Height())
 }
+
+func TestYAxisGetPosition(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(YAxisPositionRight, YAxis{}.GetPosition())
+	assert.Equal(YAxisPositionLeft, YAxis{AxisType: YAxisSecondary}.GetPosition())
+	assert.Equal(YAxisPositionLeft, YAxis{Position: YAxisPositionLeft}.GetPosition())
+	assert.Equal(YAxisPositionRight, YAxis{AxisType: YAxisSecondary, Position: YAxisPositionRight}.GetPosition())
+}
+
+func TestYAxisMeasureLeft(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	ya := YAxis{Position: YAxisPositionLeft}
+	yab := ya.Measure(r, NewBox(0, 50, 150, 100), &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}, style, ticks)
+	assert.Equal(32, yab.Width())
+	assert.Equal(50, yab.Right)
+}
+
+func TestYAxisMeasureWithName(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	ra := &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}
+
+	nameBox := Draw.MeasureText(r, "Requests/sec", style.InheritFrom(Style{TextRotationDegrees: 90}))
+	assert.True(nameBox.Width() < nameBox.Height())
+
+	unnamed := YAxis{}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	named := YAxis{Name: "Requests/sec"}.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	assert.Equal(unnamed.Width()+DefaultYAxisMargin+nameBox.Width(), named.Width())
+
+	unnamed = YAxis{Position: YAxisPositionLeft}.Measure(r, NewBox(0, 50, 150, 100), ra, style, ticks)
+	named = YAxis{Position: YAxisPositionLeft, Name: "Requests/sec"}.Measure(r, NewBox(0, 50, 150, 100), ra, style, ticks)
+	assert.Equal(unnamed.Left-DefaultYAxisMargin-nameBox.Width(), named.Left)
+	assert.Equal(unnamed.Right, named.Right)
+}
+
+func TestYAxisZeroLine(t *testing.T) {
+	assert := assert.New(t)
+
+	chart := func(ya YAxis, yvalues ...float64) Chart {
+		return Chart{
+			Width:  300,
+			Height: 200,
+			YAxis:  ya,
+			Series: []Series{
+				ContinuousSeries{XValues: LinearRange(0, float64(len(yvalues)-1)), YValues: yvalues},
+			},
+		}
+	}
+	zeroLine := "stroke-width:2;stroke:" + DefaultZeroLineColor.Hex()
+
+	svg := renderFrameTestChart(t, chart(YAxis{}, -3, 0, 7))
+	line := regexp.MustCompile(`M \d+ (\d+)\nL \d+ (\d+)" style="` + zeroLine).FindStringSubmatch(svg)
+	assert.Len(line, 3)
+	assert.Equal(line[1], line[2])
+	// the line lands on the pixel where the series crosses zero.
+	series := regexp.MustCompile(`M [\d.]+ [\d.]+\nL [\d.]+ ([\d.]+)\nL [\d.]+ [\d.]+" style="stroke-width:1;stroke:` + GetDefaultColor(0).Hex()).FindStringSubmatch(svg)
+	assert.Len(series, 2)
+	lineY, _ := strconv.ParseFloat(line[1], 64)
+	seriesY, _ := strconv.ParseFloat(series[1], 64)
+	assert.True(math.Abs(seriesY-lineY) < 1)
+
+	assert.NotContains(renderFrameTestChart(t, chart(YAxis{}, 1, 3, 7)), zeroLine)
+	assert.NotContains(renderFrameTestChart(t, chart(YAxis{}, 0, 3, 7)), zeroLine)
+	assert.NotContains(renderFrameTestChart(t, chart(YAxis{Zero: GridLine{Style: Hidden()}}, -3, 0, 7)), zeroLine)
+
+	styled := renderFrameTestChart(t, chart(YAxis{Zero: GridLine{Style: Style{StrokeColor: drawing.ColorRed}}}, -3, 0, 7))
+	assert.Contains(styled, "stroke-width:2;stroke:"+drawing.ColorRed.Hex())
+}